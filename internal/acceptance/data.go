@@ -17,6 +17,10 @@ import (
 const (
 	// charSetAlphaNum is the alphanumeric character set for use with randStringFromCharSet
 	charSetAlphaNum = "abcdefghijklmnopqrstuvwxyz012346789"
+
+	// ResourcePrefix is the prefix used for the names of the resources created by the Acceptance Tests,
+	// which is used by the Sweepers to identify any resources which have been leaked
+	ResourcePrefix = "acctest"
 )
 
 func init() {
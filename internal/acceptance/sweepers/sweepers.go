@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweepers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+const (
+	// defaultMinimumAge is the minimum age of a resource before it's swept when `ARM_SWEEP_MINIMUM_AGE` isn't set,
+	// which is intentionally long enough to leave the resources belonging to any in-progress test runs alone
+	defaultMinimumAge = 24 * time.Hour
+
+	sweeperTimeout = 60 * time.Minute
+)
+
+// Register registers the Sweepers exposed by each Service Registration with the Plugin SDK's test framework
+func Register() {
+	sweepers := make([]sdk.Sweeper, 0)
+	for _, service := range provider.SupportedTypedServices() {
+		if v, ok := service.(sdk.TypedServiceRegistrationWithSweepers); ok {
+			sweepers = append(sweepers, v.Sweepers()...)
+		}
+	}
+	for _, service := range provider.SupportedUntypedServices() {
+		if v, ok := service.(sdk.UntypedServiceRegistrationWithSweepers); ok {
+			sweepers = append(sweepers, v.Sweepers()...)
+		}
+	}
+
+	// a Service Registration can be both Typed and Untyped, in which case the same Sweepers are returned twice
+	registered := make(map[string]struct{})
+	for _, v := range sweepers {
+		sweeper := v
+		if _, exists := registered[sweeper.Name]; exists {
+			continue
+		}
+		registered[sweeper.Name] = struct{}{}
+
+		resource.AddTestSweepers(sweeper.Name, &resource.Sweeper{
+			Name:         sweeper.Name,
+			Dependencies: sweeper.Dependencies,
+			F: func(_ string) error {
+				return run(sweeper)
+			},
+		})
+	}
+}
+
+func run(sweeper sdk.Sweeper) error {
+	client, err := testclient.Build()
+	if err != nil {
+		return err
+	}
+
+	options, err := optionsFromEnvironment()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sweeperTimeout)
+	defer cancel()
+
+	log.Printf("[DEBUG] Running Sweeper %q (Dry Run %t / Minimum Age %s / Purge Soft-Deleted Items %t)", sweeper.Name, options.DryRun, options.MinimumAge, options.PurgeSoftDeletedItems)
+	if err := sweeper.Func(ctx, client, *options); err != nil {
+		return fmt.Errorf("running Sweeper %q: %+v", sweeper.Name, err)
+	}
+
+	return nil
+}
+
+func optionsFromEnvironment() (*sdk.SweeperOptions, error) {
+	options := sdk.SweeperOptions{
+		DryRun:                strings.EqualFold(os.Getenv("ARM_SWEEP_DRY_RUN"), "true"),
+		MinimumAge:            defaultMinimumAge,
+		Prefix:                acceptance.ResourcePrefix,
+		PurgeSoftDeletedItems: strings.EqualFold(os.Getenv("ARM_SWEEP_PURGE_SOFT_DELETED_ITEMS"), "true"),
+	}
+
+	if v := os.Getenv("ARM_SWEEP_MINIMUM_AGE"); v != "" {
+		minimumAge, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `ARM_SWEEP_MINIMUM_AGE` %q: %+v", v, err)
+		}
+		options.MinimumAge = minimumAge
+	}
+
+	return &options, nil
+}
@@ -4,16 +4,21 @@
 package acceptance
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 )
 
 func (td TestData) DataSourceTest(t *testing.T, steps []TestStep) {
@@ -41,6 +46,26 @@ func (td TestData) DataSourceTestInSequence(t *testing.T, steps []TestStep) {
 	td.runAcceptanceSequentialTest(t, testCase)
 }
 
+// EphemeralResourceTest runs the Test Steps for an Ephemeral Resource. Since the values of an Ephemeral Resource
+// are never persisted to the state, these are checked by passing them into the `echo` provider.
+func (td TestData) EphemeralResourceTest(t *testing.T, steps []TestStep) {
+	// Ephemeral Resources don't need a check destroy - however since this is a wrapper function
+	// and not matching the ignore pattern `XXX_data_source_test.go`, this needs to be explicitly opted out
+
+	//lintignore:AT001
+	testCase := resource.TestCase{
+		PreCheck: func() { PreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: steps,
+	}
+	td.runAcceptanceTest(t, testCase)
+}
+
 func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, steps []TestStep) {
 	testCase := resource.TestCase{
 		PreCheck: func() { PreCheck(t) },
@@ -56,6 +81,26 @@ func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, s
 	td.runAcceptanceTest(t, testCase)
 }
 
+// ResourceTestWriteOnly runs the Test Steps for a Resource which uses Write-Only arguments, which are only
+// supported in Terraform 1.11 and later.
+func (td TestData) ResourceTestWriteOnly(t *testing.T, testResource types.TestResource, steps []TestStep) {
+	testCase := resource.TestCase{
+		PreCheck: func() { PreCheck(t) },
+		CheckDestroy: func(s *terraform.State) error {
+			client, err := testclient.Build()
+			if err != nil {
+				return fmt.Errorf("building client: %+v", err)
+			}
+			return helpers.CheckDestroyedFunc(client, testResource, td.ResourceType, td.ResourceName)(s)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: steps,
+	}
+	td.runAcceptanceTest(t, testCase)
+}
+
 // ResourceTestIgnoreCheckDestroyed skips the check to confirm the resource test has been destroyed.
 // This is done because certain resources can't actually be deleted.
 func (td TestData) ResourceTestSkipCheckDestroyed(t *testing.T, steps []TestStep) {
@@ -108,31 +153,36 @@ func RunTestsInSequence(t *testing.T, tests map[string]map[string]func(t *testin
 
 func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 	testCase.ExternalProviders = td.externalProviders()
-	testCase.ProviderFactories = td.providers()
+	testCase.ProtoV5ProviderFactories = td.providers()
 
 	resource.ParallelTest(t, testCase)
 }
 
 func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.TestCase) {
 	testCase.ExternalProviders = td.externalProviders()
-	testCase.ProviderFactories = td.providers()
+	testCase.ProtoV5ProviderFactories = td.providers()
 
 	resource.Test(t, testCase)
 }
 
-func (td TestData) providers() map[string]func() (*schema.Provider, error) {
-	return map[string]func() (*schema.Provider, error){
-		"azurerm": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
-			return azurerm, nil
-		},
-		"azurerm-alt": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
-			return azurerm, nil
-		},
+// providers returns the muxed Provider Server (rather than only the Plugin SDKv2 provider) so that the
+// Ephemeral Resources and Provider Functions exposed by the Plugin Framework provider can be tested
+func (td TestData) providers() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"azurerm":     testProviderServer,
+		"azurerm-alt": testProviderServer,
 	}
 }
 
+func testProviderServer() (tfprotov5.ProviderServer, error) {
+	serverFactory, err := framework.ProtoV5ProviderServerFactory(context.Background(), provider.TestAzureProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	return serverFactory(), nil
+}
+
 func (td TestData) externalProviders() map[string]resource.ExternalProvider {
 	return map[string]resource.ExternalProvider{
 		"azuread": {
@@ -25,6 +25,7 @@ type ClientBuilder struct {
 
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
+	ReadOnly                    bool
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 
@@ -33,6 +34,9 @@ type ClientBuilder struct {
 	PartnerID                  string
 	SubscriptionID             string
 	TerraformVersion           string
+
+	// AllowedSubscriptionIDs optionally restricts which other Subscriptions clients can be built for
+	AllowedSubscriptionIDs []string
 }
 
 const azureStackEnvironmentError = `
@@ -59,6 +63,12 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("unable to build authorizer for Resource Manager API: %+v", err)
 	}
 
+	// only the tokens for the auxiliary tenants owning the Subscriptions referenced within each request are attached, for
+	// both the go-azure-sdk and the autorest based clients
+	if len(builder.AuthConfig.AuxiliaryTenantIDs) > 0 {
+		resourceManagerAuth = common.NewAuxiliaryTenantAuthorizer(resourceManagerAuth, *builder.AuthConfig, builder.AuthConfig.AuxiliaryTenantIDs)
+	}
+
 	storageAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
@@ -150,6 +160,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		CustomCorrelationRequestID:  builder.CustomCorrelationRequestID,
 		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
+		ReadOnly:                    builder.ReadOnly,
 		SkipProviderReg:             builder.SkipProviderRegistration,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
 
@@ -160,6 +171,10 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
 
+	client.subscriptionClients = newSubscriptionClients(&client, o, builder.AllowedSubscriptionIDs)
+
+	resourceproviders.ConfigureRequiredFeatures(builder.Features.PreviewFeatures.RegisterRequiredFeatures, builder.Features.PreviewFeatures.ErrorOnUnregisteredFeatures)
+
 	if features.EnhancedValidationEnabled() {
 		subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
 
@@ -170,6 +185,9 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		if err := resourceproviders.CacheSupportedProviders(ctx2, client.Resource.ResourceProvidersClient, subscriptionId); err != nil {
 			log.Printf("[DEBUG] error retrieving providers: %s. Enhanced validation will be unavailable", err)
 		}
+		if err := resourceproviders.CacheRegisteredFeatures(ctx2, client.Resource.FeaturesClient, subscriptionId); err != nil {
+			log.Printf("[DEBUG] error retrieving registered features: %s. Enhanced validation for features will be unavailable", err)
+		}
 	}
 
 	return &client, nil
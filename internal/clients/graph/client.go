@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
@@ -149,3 +150,67 @@ func UserPrincipalObjectID(ctx context.Context, authorizer auth.Authorizer, envi
 
 	return model.ID, nil
 }
+
+// ExistingDirectoryObjectIDs returns the (lower-cased) Object IDs from `objectIds` which exist within the directory,
+// since Microsoft Graph omits any Object IDs which don't exist (e.g. a deleted Principal) from the response
+func ExistingDirectoryObjectIDs(ctx context.Context, authorizer auth.Authorizer, environment environments.Environment, objectIds []string) (map[string]struct{}, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, time.Now().Add(5*time.Minute))
+		defer cancel()
+	}
+
+	c, err := graphClient(authorizer, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]struct{})
+
+	// Microsoft Graph accepts at most 1000 Object IDs in a single request
+	const batchSize = 1000
+	for start := 0; start < len(objectIds); start += batchSize {
+		end := min(start+batchSize, len(objectIds))
+
+		opts := client.RequestOptions{
+			ContentType: "application/json",
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod:    http.MethodPost,
+			OptionsObject: nil,
+			Path:          "/directoryObjects/getByIds",
+		}
+
+		req, err := c.NewRequest(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("building new request: %+v", err)
+		}
+
+		if err := req.Marshal(map[string]interface{}{
+			"ids": objectIds[start:end],
+		}); err != nil {
+			return nil, fmt.Errorf("marshaling request: %+v", err)
+		}
+
+		resp, err := req.Execute(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("executing request: %+v", err)
+		}
+
+		model := struct {
+			DirectoryObjects []directoryObjectModel `json:"value"`
+		}{}
+		if err := resp.Unmarshal(&model); err != nil {
+			return nil, fmt.Errorf("unmarshaling response: %+v", err)
+		}
+
+		for _, v := range model.DirectoryObjects {
+			if v.ID != nil {
+				existing[strings.ToLower(*v.ID)] = struct{}{}
+			}
+		}
+	}
+
+	return existing, nil
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// subscriptionClients is a cache of the Clients for each Subscription, which is shared between the Client built for
+// the Subscription configured in the Provider block and any Clients built from it for other Subscriptions
+type subscriptionClients struct {
+	sync.Mutex
+
+	// allowedSubscriptionIds is an optional list of the (additional) Subscription IDs which Clients can be built for
+	allowedSubscriptionIds []string
+
+	// clients is a map of the (lower-cased) Subscription ID to the Client for that Subscription
+	clients map[string]*Client
+
+	// pending is a map of the (lower-cased) Subscription ID to the Client which is currently being built for that
+	// Subscription, so that concurrent requests for the same Subscription only build the Client once
+	pending map[string]*pendingSubscriptionClient
+
+	// build builds the Client for the specified Subscription, which is called without holding the lock
+	build func(subscriptionId string) (*Client, error)
+
+	// options are the ClientOptions used to build the Client for the Subscription configured in the Provider block
+	options *common.ClientOptions
+}
+
+type pendingSubscriptionClient struct {
+	// done is closed once the Client has been built (or failed to build)
+	done chan struct{}
+
+	client *Client
+	err    error
+}
+
+func newSubscriptionClients(client *Client, o *common.ClientOptions, allowedSubscriptionIds []string) *subscriptionClients {
+	c := &subscriptionClients{
+		allowedSubscriptionIds: allowedSubscriptionIds,
+		clients: map[string]*Client{
+			strings.ToLower(client.Account.SubscriptionId): client,
+		},
+		pending: make(map[string]*pendingSubscriptionClient),
+		options: o,
+	}
+	c.build = c.buildClient
+	return c
+}
+
+// ForSubscription returns the Client for the specified Subscription, which is built (and cached) on first use by
+// reusing the Authorizers and ClientOptions from the Client for the Subscription configured in the Provider block.
+//
+// NOTE: Resource Provider Registration is not performed for these additional Subscriptions.
+func (client *Client) ForSubscription(subscriptionId string) (*Client, error) {
+	if client.Account != nil && strings.EqualFold(client.Account.SubscriptionId, subscriptionId) {
+		return client, nil
+	}
+
+	if client.subscriptionClients == nil {
+		return nil, fmt.Errorf("building the clients for Subscription %q: the clients for other Subscriptions are only available when the Client is built using `clients.Build`", subscriptionId)
+	}
+
+	return client.subscriptionClients.forSubscription(subscriptionId)
+}
+
+// ForResourceId returns the Client for the Subscription that the Resource ID `id` exists within - or the current
+// Client when the Resource ID isn't scoped to a Subscription (for example a Management Group or Tenant level ID).
+func (client *Client) ForResourceId(id string) (*Client, error) {
+	subscriptionId, ok := SubscriptionIdFromResourceId(id)
+	if !ok {
+		return client, nil
+	}
+
+	return client.ForSubscription(subscriptionId)
+}
+
+func (c *subscriptionClients) forSubscription(subscriptionId string) (*Client, error) {
+	key := strings.ToLower(subscriptionId)
+
+	c.Lock()
+	if existing, ok := c.clients[key]; ok {
+		c.Unlock()
+		return existing, nil
+	}
+
+	if !c.isAllowed(subscriptionId) {
+		c.Unlock()
+		return nil, fmt.Errorf("the Subscription %q is not allowed, since it's not listed in `allowed_subscription_ids` within the Provider block", subscriptionId)
+	}
+
+	if pending, ok := c.pending[key]; ok {
+		c.Unlock()
+		<-pending.done
+		return pending.client, pending.err
+	}
+
+	pending := &pendingSubscriptionClient{
+		done: make(chan struct{}),
+	}
+	c.pending[key] = pending
+	c.Unlock()
+
+	// building the Client can take a while, so this is done without holding the lock to avoid blocking the requests
+	// for the other Subscriptions
+	pending.client, pending.err = c.build(subscriptionId)
+
+	c.Lock()
+	delete(c.pending, key)
+	if pending.err == nil {
+		c.clients[key] = pending.client
+	}
+	c.Unlock()
+	close(pending.done)
+
+	return pending.client, pending.err
+}
+
+func (c *subscriptionClients) buildClient(subscriptionId string) (*Client, error) {
+	log.Printf("[DEBUG] Building the clients for Subscription %q..", subscriptionId)
+
+	o := *c.options
+	o.SubscriptionId = subscriptionId
+
+	c.Lock()
+	defaultClient := c.clients[strings.ToLower(c.options.SubscriptionId)]
+	c.Unlock()
+
+	account := *defaultClient.Account
+	account.SubscriptionId = subscriptionId
+
+	client := &Client{
+		Account:             &account,
+		subscriptionClients: c,
+	}
+	if err := client.Build(defaultClient.StopContext, &o); err != nil {
+		return nil, fmt.Errorf("building the clients for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	return client, nil
+}
+
+func (c *subscriptionClients) isAllowed(subscriptionId string) bool {
+	if len(c.allowedSubscriptionIds) == 0 {
+		return true
+	}
+
+	for _, v := range c.allowedSubscriptionIds {
+		if strings.EqualFold(v, subscriptionId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SubscriptionIdFromResourceId returns the Subscription ID from a Resource Manager ID which is scoped to a
+// Subscription (e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}`).
+func SubscriptionIdFromResourceId(id string) (string, bool) {
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		return "", false
+	}
+
+	if _, err := uuid.ParseUUID(segments[1]); err != nil {
+		return "", false
+	}
+
+	return segments[1], true
+}
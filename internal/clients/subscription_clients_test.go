@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+const (
+	testDefaultSubscriptionId    = "12345678-1234-9876-4563-123456789012"
+	testAllowedSubscriptionId    = "22222222-1234-9876-4563-123456789012"
+	testNotAllowedSubscriptionId = "33333333-1234-9876-4563-123456789012"
+)
+
+func testClientWithSubscriptionClients(allowedSubscriptionIds []string, built *int32) *Client {
+	client := &Client{
+		Account: &ResourceManagerAccount{
+			SubscriptionId: testDefaultSubscriptionId,
+		},
+	}
+	client.subscriptionClients = newSubscriptionClients(client, &common.ClientOptions{SubscriptionId: testDefaultSubscriptionId}, allowedSubscriptionIds)
+	client.subscriptionClients.build = func(subscriptionId string) (*Client, error) {
+		atomic.AddInt32(built, 1)
+		// simulate building the clients taking a while
+		time.Sleep(10 * time.Millisecond)
+		return &Client{
+			Account: &ResourceManagerAccount{
+				SubscriptionId: subscriptionId,
+			},
+			subscriptionClients: client.subscriptionClients,
+		}, nil
+	}
+	return client
+}
+
+func TestForResourceIdAllowedSubscriptionIds(t *testing.T) {
+	testData := []struct {
+		Name                   string
+		Id                     string
+		ExpectedSubscriptionId string
+		Error                  bool
+	}{
+		{
+			Name:                   "Default Subscription",
+			Id:                     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			ExpectedSubscriptionId: testDefaultSubscriptionId,
+		},
+		{
+			Name:                   "Not Scoped to a Subscription",
+			Id:                     "/providers/Microsoft.Management/managementGroups/example",
+			ExpectedSubscriptionId: testDefaultSubscriptionId,
+		},
+		{
+			Name:                   "Allowed Subscription",
+			Id:                     "/subscriptions/22222222-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/virtualNetworkPeerings/example",
+			ExpectedSubscriptionId: testAllowedSubscriptionId,
+		},
+		{
+			Name:                   "Subscription Scoped Extension Resource within an Allowed Subscription",
+			Id:                     "/SUBSCRIPTIONS/22222222-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			ExpectedSubscriptionId: testAllowedSubscriptionId,
+		},
+		{
+			Name:  "Subscription Scoped Extension Resource within a Subscription which isn't Allowed",
+			Id:    "/subscriptions/33333333-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/example",
+			Error: true,
+		},
+		{
+			Name:  "Subscription which isn't Allowed",
+			Id:    "/subscriptions/33333333-1234-9876-4563-123456789012/resourceGroups/example",
+			Error: true,
+		},
+	}
+
+	var built int32
+	client := testClientWithSubscriptionClients([]string{strings.ToUpper(testAllowedSubscriptionId)}, &built)
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := client.ForResourceId(v.Id)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !strings.Contains(err.Error(), "allowed_subscription_ids") {
+				t.Fatalf("expected the error to reference `allowed_subscription_ids` but got: %+v", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual.Account.SubscriptionId != v.ExpectedSubscriptionId {
+			t.Fatalf("expected the Client for Subscription %q but got %q", v.ExpectedSubscriptionId, actual.Account.SubscriptionId)
+		}
+	}
+
+	if built != 1 {
+		t.Fatalf("expected the clients to be built once (for the allowed Subscription) but got %d", built)
+	}
+}
+
+func TestForSubscriptionBuildsOnce(t *testing.T) {
+	var built int32
+	client := testClientWithSubscriptionClients(nil, &built)
+
+	var wg sync.WaitGroup
+	results := make([]*Client, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			result, err := client.ForSubscription(testAllowedSubscriptionId)
+			if err != nil {
+				t.Errorf("unexpected error: %+v", err)
+				return
+			}
+			results[i] = result
+		}(i)
+	}
+	wg.Wait()
+
+	if built != 1 {
+		t.Fatalf("expected the clients to be built once but got %d", built)
+	}
+	for _, result := range results {
+		if result != results[0] {
+			t.Fatalf("expected the same Client to be returned for each request")
+		}
+	}
+
+	// the default Subscription shouldn't need to wait on (or build) anything
+	result, err := client.ForSubscription(strings.ToUpper(testDefaultSubscriptionId))
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if result != client {
+		t.Fatalf("expected the default Client to be returned")
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-12-01/subscriptions"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"golang.org/x/oauth2"
+)
+
+// maxAuxiliaryTenantsPerRequest is the maximum number of auxiliary tokens which Resource Manager accepts within the
+// `x-ms-authorization-auxiliary` header for a single request
+const maxAuxiliaryTenantsPerRequest = 3
+
+// auxiliaryTenantResolutionTimeout is how long listing the Subscriptions within each tenant can take in total
+const auxiliaryTenantResolutionTimeout = 5 * time.Minute
+
+var subscriptionIdWithinRequestRegex = regexp.MustCompile(`(?i)/subscriptions/([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})`)
+
+var _ auth.Authorizer = &auxiliaryTenantAuthorizer{}
+
+// auxiliaryTenantAuthorizer is an Authorizer for Resource Manager which obtains the access token for the primary tenant
+// from the wrapped Authorizer. When more auxiliary tenants are configured than Resource Manager accepts for a single
+// request, auxiliary tokens are only attached for the auxiliary tenants which own the Subscriptions referenced within
+// each request (for example the remote Virtual Network for a cross-tenant Virtual Network Peering), rather than for
+// every auxiliary tenant.
+type auxiliaryTenantAuthorizer struct {
+	auth.Authorizer
+
+	pool *auxiliaryTenantPool
+}
+
+// NewAuxiliaryTenantAuthorizer returns an Authorizer for Resource Manager which wraps the Authorizer for the primary
+// tenant, and attaches auxiliary tokens for the auxiliary tenants which own the Subscriptions referenced in each request.
+// The credentials are used to build an Authorizer for each auxiliary tenant as needed.
+//
+// The auxiliary tokens are obtained for each request, so this can be used for both the go-azure-sdk based clients and
+// (when wrapped using the autorest Authorizer) the autorest based clients.
+func NewAuxiliaryTenantAuthorizer(primary auth.Authorizer, credentials auth.Credentials, auxiliaryTenantIds []string) auth.Authorizer {
+	tenantIds := make([]string, 0)
+	for _, tenantId := range auxiliaryTenantIds {
+		if tenantId = strings.ToLower(strings.TrimSpace(tenantId)); tenantId != "" {
+			tenantIds = append(tenantIds, tenantId)
+		}
+	}
+
+	return &auxiliaryTenantAuthorizer{
+		Authorizer: primary,
+		pool: &auxiliaryTenantPool{
+			credentials: credentials,
+			primary:     primary,
+			tenantIds:   tenantIds,
+		},
+	}
+}
+
+// AuxiliaryTokens returns the access tokens for the auxiliary tenants owning the Subscriptions referenced within the
+// request
+func (a *auxiliaryTenantAuthorizer) AuxiliaryTokens(ctx context.Context, req *http.Request) ([]*oauth2.Token, error) {
+	if len(a.pool.tenantIds) <= maxAuxiliaryTenantsPerRequest {
+		// every auxiliary tenant can be included, so there's no need to inspect the request
+		return a.Authorizer.AuxiliaryTokens(ctx, req)
+	}
+
+	tenantIds, err := a.pool.tenantsForRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*oauth2.Token, 0)
+	for _, tenantId := range tenantIds {
+		authorizer, err := a.pool.authorizerForTenant(tenantId)
+		if err != nil {
+			return nil, err
+		}
+
+		token, err := authorizer.Token(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("obtaining an access token for the auxiliary tenant %q: %+v", tenantId, err)
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+// auxiliaryTenantPool holds an Authorizer for each auxiliary tenant and the tenant which owns each Subscription
+// accessible by the authenticated principal. These are resolved once, on the first request which needs them, and
+// are read-only afterwards - any failures are cached rather than being retried for each request.
+type auxiliaryTenantPool struct {
+	// credentials are the credentials configured in the Provider block, which are used to build the Authorizers for
+	// each auxiliary tenant
+	credentials auth.Credentials
+	primary     auth.Authorizer
+	tenantIds   []string
+
+	resolve sync.Once
+
+	// authorizers is keyed by the (lower-cased) auxiliary tenant ID
+	authorizers map[string]auth.Authorizer
+
+	// authorizerErrors is keyed by the (lower-cased) auxiliary tenant ID, for any tenant where the Authorizer couldn't
+	// be built
+	authorizerErrors map[string]error
+
+	// subscriptionTenants is a map of the (lower-cased) Subscription ID to the (lower-cased) auxiliary tenant ID which
+	// owns it, where an empty tenant ID means the Subscription is within the primary tenant
+	subscriptionTenants map[string]string
+
+	// unresolvedSubscriptions are the Subscriptions which weren't found within any tenant, which are tracked to only
+	// log these once
+	unresolvedSubscriptions sync.Map
+}
+
+func (p *auxiliaryTenantPool) authorizerForTenant(tenantId string) (auth.Authorizer, error) {
+	if err, ok := p.authorizerErrors[tenantId]; ok {
+		return nil, err
+	}
+	if authorizer, ok := p.authorizers[tenantId]; ok {
+		return authorizer, nil
+	}
+
+	return nil, fmt.Errorf("internal-error: no authorizer was built for the auxiliary tenant %q", tenantId)
+}
+
+// tenantsForRequest returns the auxiliary tenants which own the Subscriptions referenced within the URI or the body of
+// the request.
+func (p *auxiliaryTenantPool) tenantsForRequest(ctx context.Context, req *http.Request) ([]string, error) {
+	subscriptionIds, err := subscriptionIdsWithinRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(subscriptionIds) == 0 {
+		return nil, nil
+	}
+
+	p.resolve.Do(func() {
+		// the Subscriptions are listed using a separate context, since these are shared by all subsequent requests and
+		// so shouldn't be affected by the cancellation of the request which happened to trigger this
+		resolveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auxiliaryTenantResolutionTimeout)
+		defer cancel()
+
+		p.resolveSubscriptions(resolveCtx)
+	})
+
+	tenantIds := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, subscriptionId := range subscriptionIds {
+		tenantId, ok := p.subscriptionTenants[subscriptionId]
+		if !ok {
+			if _, logged := p.unresolvedSubscriptions.LoadOrStore(subscriptionId, struct{}{}); !logged {
+				log.Printf("[DEBUG] Unable to determine the tenant for the Subscription %q referenced within the request", subscriptionId)
+			}
+			continue
+		}
+		if _, exists := seen[tenantId]; tenantId == "" || exists {
+			continue
+		}
+
+		seen[tenantId] = struct{}{}
+		tenantIds = append(tenantIds, tenantId)
+	}
+
+	if len(tenantIds) > maxAuxiliaryTenantsPerRequest {
+		return nil, fmt.Errorf("the request to %q references Subscriptions within %d auxiliary tenants (%s), however Resource Manager supports at most %d auxiliary tenants per request", req.URL.Path, len(tenantIds), strings.Join(tenantIds, ", "), maxAuxiliaryTenantsPerRequest)
+	}
+
+	return tenantIds, nil
+}
+
+// resolveSubscriptions builds the Authorizer for each auxiliary tenant and lists the Subscriptions within it, and then
+// lists the Subscriptions within the primary tenant
+func (p *auxiliaryTenantPool) resolveSubscriptions(ctx context.Context) {
+	p.authorizers = make(map[string]auth.Authorizer)
+	p.authorizerErrors = make(map[string]error)
+	p.subscriptionTenants = make(map[string]string)
+
+	for _, tenantId := range p.tenantIds {
+		credentials := p.credentials
+		credentials.TenantID = tenantId
+		credentials.AuxiliaryTenantIDs = nil
+		authorizer, err := auth.NewAuthorizerFromCredentials(ctx, credentials, credentials.Environment.ResourceManager)
+		if err != nil {
+			err = fmt.Errorf("building authorizer for the auxiliary tenant %q: %+v", tenantId, err)
+			log.Printf("[DEBUG] %+v", err)
+			p.authorizerErrors[tenantId] = err
+			continue
+		}
+		p.authorizers[tenantId] = authorizer
+
+		subscriptionIds, err := listSubscriptions(ctx, p.credentials, authorizer)
+		if err != nil {
+			log.Printf("[DEBUG] listing the Subscriptions within the auxiliary tenant %q: %+v", tenantId, err)
+			continue
+		}
+		for _, subscriptionId := range subscriptionIds {
+			p.subscriptionTenants[subscriptionId] = tenantId
+		}
+	}
+
+	// the primary tenant is listed last, so that it takes precedence should it also be specified as an auxiliary tenant
+	subscriptionIds, err := listSubscriptions(ctx, p.credentials, p.primary)
+	if err != nil {
+		log.Printf("[DEBUG] listing the Subscriptions within the primary tenant: %+v", err)
+		return
+	}
+	for _, subscriptionId := range subscriptionIds {
+		p.subscriptionTenants[subscriptionId] = ""
+	}
+}
+
+// withoutAuxiliaryTokens wraps an Authorizer so that no auxiliary tokens are sent, which is used when listing the
+// Subscriptions within a single tenant
+type withoutAuxiliaryTokens struct {
+	auth.Authorizer
+}
+
+func (withoutAuxiliaryTokens) AuxiliaryTokens(context.Context, *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+// listSubscriptions returns the (lower-cased) IDs of the Subscriptions accessible using the Authorizer
+func listSubscriptions(ctx context.Context, credentials auth.Credentials, authorizer auth.Authorizer) ([]string, error) {
+	client, err := subscriptions.NewSubscriptionsClientWithBaseURI(credentials.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Subscriptions client: %+v", err)
+	}
+	client.Client.Authorizer = withoutAuxiliaryTokens{Authorizer: authorizer}
+
+	result, err := client.ListComplete(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]string, 0)
+	for _, item := range result.Items {
+		if item.SubscriptionId != nil {
+			out = append(out, strings.ToLower(*item.SubscriptionId))
+		}
+	}
+	return out, nil
+}
+
+// subscriptionIdsWithinRequest returns the (lower-cased) IDs of the Subscriptions referenced within the URI or the body
+// of the request. The body is read from a copy obtained via `GetBody` where possible, otherwise both `Body` and
+// `GetBody` are restored after the body has been read so that it can still be sent (and retried).
+func subscriptionIdsWithinRequest(req *http.Request) ([]string, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	content := ""
+	if req.URL != nil {
+		content = req.URL.Path
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := readRequestBody(req)
+		if err != nil {
+			return nil, fmt.Errorf("reading the request body: %+v", err)
+		}
+		content += "\n" + string(body)
+	}
+
+	out := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, match := range subscriptionIdWithinRequestRegex.FindAllStringSubmatch(content, -1) {
+		subscriptionId := strings.ToLower(match[1])
+		if _, ok := seen[subscriptionId]; ok {
+			continue
+		}
+		seen[subscriptionId] = struct{}{}
+		out = append(out, subscriptionId)
+	}
+	return out, nil
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = req.Body.Close()
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return body, nil
+}
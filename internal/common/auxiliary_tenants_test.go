@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	authWrapper "github.com/hashicorp/go-azure-sdk/sdk/auth/autorest"
+	"golang.org/x/oauth2"
+)
+
+const (
+	testPrimarySubscriptionId = "11111111-1111-1111-1111-111111111111"
+	testFirstSubscriptionId   = "22222222-2222-2222-2222-222222222222"
+	testSecondSubscriptionId  = "33333333-3333-3333-3333-333333333333"
+	testThirdSubscriptionId   = "44444444-4444-4444-4444-444444444444"
+	testFourthSubscriptionId  = "55555555-5555-5555-5555-555555555555"
+	testUnknownSubscriptionId = "66666666-6666-6666-6666-666666666666"
+)
+
+const testPeeringBody = `{"properties":{"remoteVirtualNetwork":{"id":"/subscriptions/22222222-2222-2222-2222-222222222222/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example"}}}`
+
+const testPeeringUrl = "https://management.azure.com/SUBSCRIPTIONS/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/virtualNetworkPeerings/example?api-version=2023-11-01"
+
+func TestSubscriptionIdsWithinRequest(t *testing.T) {
+	expected := []string{testPrimarySubscriptionId, testFirstSubscriptionId}
+
+	t.Log("[DEBUG] Testing a request with GetBody..")
+	req, err := http.NewRequest(http.MethodPut, testPeeringUrl, strings.NewReader(testPeeringBody))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	actual, err := subscriptionIdsWithinRequest(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+	testRequestBodyIsAvailable(t, req)
+
+	t.Log("[DEBUG] Testing a request without GetBody..")
+	req, err = http.NewRequest(http.MethodPut, testPeeringUrl, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	// a body which can only be read once, as would be the case for a stream
+	req.Body = io.NopCloser(io.MultiReader(strings.NewReader(testPeeringBody)))
+	actual, err = subscriptionIdsWithinRequest(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+	if req.GetBody == nil {
+		t.Fatalf("expected GetBody to be restored")
+	}
+	testRequestBodyIsAvailable(t, req)
+	body, err := req.GetBody()
+	if err != nil {
+		t.Fatalf("unexpected error from GetBody: %+v", err)
+	}
+	if remaining, _ := io.ReadAll(body); string(remaining) != testPeeringBody {
+		t.Fatalf("expected GetBody to return the request body but got %q", string(remaining))
+	}
+}
+
+func testRequestBodyIsAvailable(t *testing.T, req *http.Request) {
+	remaining, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("reading the request body: %+v", err)
+	}
+	if string(remaining) != testPeeringBody {
+		t.Fatalf("expected the request body to still be available but got %q", string(remaining))
+	}
+}
+
+type testAuthorizer struct {
+	auxiliaryTokens []*oauth2.Token
+}
+
+func (a testAuthorizer) Token(context.Context, *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: "primary"}, nil
+}
+
+func (a testAuthorizer) AuxiliaryTokens(context.Context, *http.Request) ([]*oauth2.Token, error) {
+	return a.auxiliaryTokens, nil
+}
+
+type testFailingReader struct{}
+
+func (testFailingReader) Read([]byte) (int, error) {
+	return 0, errors.New("the request body should not be read")
+}
+
+func TestAuxiliaryTokensWithinLimit(t *testing.T) {
+	primary := testAuthorizer{
+		auxiliaryTokens: []*oauth2.Token{{AccessToken: "tenant-a"}, {AccessToken: "tenant-b"}, {AccessToken: "tenant-c"}},
+	}
+	authorizer := NewAuxiliaryTenantAuthorizer(primary, auth.Credentials{}, []string{"tenant-a", "tenant-b", "tenant-c"})
+
+	req, err := http.NewRequest(http.MethodPut, testPeeringUrl, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	req.Body = io.NopCloser(testFailingReader{})
+
+	// the tokens for every auxiliary tenant can be sent, so the request shouldn't be inspected
+	tokens, err := authorizer.AuxiliaryTokens(context.TODO(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(tokens, primary.auxiliaryTokens) {
+		t.Fatalf("expected the auxiliary tokens from the wrapped Authorizer but got %+v", tokens)
+	}
+}
+
+func TestAuxiliaryTenantsForRequest(t *testing.T) {
+	testData := []struct {
+		Name            string
+		TenantIds       []string
+		SubscriptionIds []string
+		Expected        []string
+		ExpectError     bool
+	}{
+		{
+			Name:            "primary tenant only",
+			TenantIds:       []string{"tenant-a", "tenant-b", "tenant-c", "tenant-d"},
+			SubscriptionIds: []string{testPrimarySubscriptionId},
+			Expected:        []string{},
+		},
+		{
+			Name:            "single auxiliary tenant",
+			TenantIds:       []string{"tenant-a", "tenant-b", "tenant-c", "tenant-d"},
+			SubscriptionIds: []string{testPrimarySubscriptionId, testFourthSubscriptionId},
+			Expected:        []string{"tenant-d"},
+		},
+		{
+			Name:            "multiple auxiliary tenants",
+			TenantIds:       []string{"tenant-a", "tenant-b", "tenant-c", "tenant-d"},
+			SubscriptionIds: []string{testFirstSubscriptionId, testSecondSubscriptionId, testFirstSubscriptionId},
+			Expected:        []string{"tenant-a", "tenant-b"},
+		},
+		{
+			Name:            "too many auxiliary tenants",
+			TenantIds:       []string{"tenant-a", "tenant-b", "tenant-c", "tenant-d"},
+			SubscriptionIds: []string{testFirstSubscriptionId, testSecondSubscriptionId, testThirdSubscriptionId, testFourthSubscriptionId},
+			ExpectError:     true,
+		},
+		{
+			Name:            "unknown subscription",
+			TenantIds:       []string{"tenant-a", "tenant-b", "tenant-c", "tenant-d"},
+			SubscriptionIds: []string{testUnknownSubscriptionId, testSecondSubscriptionId},
+			Expected:        []string{"tenant-b"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		pool := &auxiliaryTenantPool{
+			tenantIds:   v.TenantIds,
+			authorizers: make(map[string]auth.Authorizer),
+			subscriptionTenants: map[string]string{
+				testPrimarySubscriptionId: "",
+				testFirstSubscriptionId:   "tenant-a",
+				testSecondSubscriptionId:  "tenant-b",
+				testThirdSubscriptionId:   "tenant-c",
+				testFourthSubscriptionId:  "tenant-d",
+			},
+		}
+		// the tenants for the Subscriptions are pre-populated above, rather than being listed from the API
+		pool.resolve.Do(func() {})
+
+		ids := make([]string, 0)
+		for _, subscriptionId := range v.SubscriptionIds {
+			ids = append(ids, `"/subscriptions/`+subscriptionId+`"`)
+		}
+		req, err := http.NewRequest(http.MethodPut, "https://management.azure.com/providers/Microsoft.Example/operations", strings.NewReader("["+strings.Join(ids, ",")+"]"))
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		actual, err := pool.tenantsForRequest(context.TODO(), req)
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if len(actual) == 0 && len(v.Expected) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestAuxiliaryTenantAuthorizerFailureIsCached(t *testing.T) {
+	pool := &auxiliaryTenantPool{
+		tenantIds:   []string{"tenant-a", "tenant-b", "tenant-c", "tenant-d"},
+		authorizers: make(map[string]auth.Authorizer),
+		authorizerErrors: map[string]error{
+			"tenant-a": errors.New("building authorizer for the auxiliary tenant \"tenant-a\": unauthorized"),
+		},
+		subscriptionTenants: map[string]string{
+			testFirstSubscriptionId: "tenant-a",
+		},
+	}
+	pool.resolve.Do(func() {})
+	authorizer := &auxiliaryTenantAuthorizer{
+		Authorizer: testAuthorizer{},
+		pool:       pool,
+	}
+
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodPut, testPeeringUrl, strings.NewReader(testPeeringBody))
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		if _, err := authorizer.AuxiliaryTokens(context.TODO(), req); err == nil || !strings.Contains(err.Error(), "unauthorized") {
+			t.Fatalf("expected the cached error for the auxiliary tenant but got: %+v", err)
+		}
+	}
+}
+
+type testTenantAuthorizer struct {
+	tenantId string
+}
+
+func (a testTenantAuthorizer) Token(context.Context, *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: a.tenantId, TokenType: "Bearer"}, nil
+}
+
+func (a testTenantAuthorizer) AuxiliaryTokens(context.Context, *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+func TestAuxiliaryTenantAuthorizerAutorest(t *testing.T) {
+	tenantIds := []string{"tenant-a", "tenant-b", "tenant-c", "tenant-d"}
+	pool := &auxiliaryTenantPool{
+		tenantIds:   tenantIds,
+		authorizers: make(map[string]auth.Authorizer),
+		subscriptionTenants: map[string]string{
+			testPrimarySubscriptionId: "",
+			testFirstSubscriptionId:   "tenant-a",
+			testSecondSubscriptionId:  "tenant-b",
+			testThirdSubscriptionId:   "tenant-c",
+			testFourthSubscriptionId:  "tenant-d",
+		},
+	}
+	for _, tenantId := range tenantIds {
+		pool.authorizers[tenantId] = testTenantAuthorizer{tenantId: tenantId}
+	}
+	pool.resolve.Do(func() {})
+
+	// the wrapped Authorizer would send the tokens for every auxiliary tenant
+	primary := testAuthorizer{
+		auxiliaryTokens: []*oauth2.Token{{AccessToken: "tenant-a"}, {AccessToken: "tenant-b"}, {AccessToken: "tenant-c"}, {AccessToken: "tenant-d"}},
+	}
+	authorizer := authWrapper.AutorestAuthorizer(&auxiliaryTenantAuthorizer{
+		Authorizer: primary,
+		pool:       pool,
+	})
+
+	testData := []struct {
+		Name            string
+		SubscriptionIds []string
+		Expected        string
+		ExpectError     bool
+	}{
+		{
+			Name:            "primary tenant only",
+			SubscriptionIds: []string{testPrimarySubscriptionId},
+			Expected:        "",
+		},
+		{
+			Name:            "multiple auxiliary tenants",
+			SubscriptionIds: []string{testPrimarySubscriptionId, testSecondSubscriptionId, testFourthSubscriptionId},
+			Expected:        "Bearer tenant-b, Bearer tenant-d",
+		},
+		{
+			Name:            "too many auxiliary tenants",
+			SubscriptionIds: []string{testFirstSubscriptionId, testSecondSubscriptionId, testThirdSubscriptionId, testFourthSubscriptionId},
+			ExpectError:     true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		ids := make([]string, 0)
+		for _, subscriptionId := range v.SubscriptionIds {
+			ids = append(ids, `"/subscriptions/`+subscriptionId+`"`)
+		}
+
+		req, err := autorest.Prepare(&http.Request{},
+			autorest.AsPut(),
+			autorest.WithBaseURL("https://management.azure.com"),
+			autorest.WithPath("/providers/Microsoft.Example/operations"),
+			autorest.WithString("["+strings.Join(ids, ",")+"]"),
+			authorizer.WithAuthorization())
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		actual := req.Header.Get("x-ms-authorization-auxiliary")
+		if actual != v.Expected {
+			t.Fatalf("expected the auxiliary tokens %q but got %q", v.Expected, actual)
+		}
+		if tokens := strings.Split(actual, ","); actual != "" && len(tokens) > maxAuxiliaryTenantsPerRequest {
+			t.Fatalf("expected at most %d auxiliary tokens but got %d", maxAuxiliaryTenantsPerRequest, len(tokens))
+		}
+		if req.Header.Get("Authorization") != "Bearer primary" {
+			t.Fatalf("expected the access token for the primary tenant but got %q", req.Header.Get("Authorization"))
+		}
+	}
+}
@@ -47,6 +47,7 @@ type ClientOptions struct {
 	DisableCorrelationRequestID bool
 
 	DisableTerraformPartnerID bool
+	ReadOnly                  bool
 	SkipProviderReg           bool
 	StorageUseAzureAD         bool
 
@@ -73,6 +74,10 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 		c.AppendRequestMiddleware(correlationRequestIDMiddleware(id))
 	}
 
+	if o.ReadOnly {
+		c.AppendRequestMiddleware(ReadOnlyRequestMiddleware())
+	}
+
 	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
 }
@@ -84,6 +89,10 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
+	if o.ReadOnly {
+		c.Sender = readOnlySender(c.Sender)
+		c.SkipResourceProviderRegistration = true
+	}
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
 		if id == "" {
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// readOnlyAllowedPostAction is an action which is sent as a POST request but which only reads data
+type readOnlyAllowedPostAction struct {
+	// ResourceProvider optionally limits this action to the specified Resource Provider, for actions with a name which
+	// is too generic to allow across every Resource Provider
+	ResourceProvider string
+
+	// Action is the (case-insensitive) suffix of the request path, e.g. `listKeys` or `config/appsettings/list`
+	Action string
+}
+
+// readOnlyAllowedPostActions are the actions which are sent as a POST request but which only read data - and as such
+// are allowed when the Provider is running in Read-Only mode. These are the actions used when reading Resources and
+// Data Sources, any other POST request is blocked.
+var readOnlyAllowedPostActions = []readOnlyAllowedPostAction{
+	// Keys, Secrets and Credentials
+	{Action: "getKeys"},
+	{Action: "listAccountSas"},
+	{Action: "listAdminKeys"},
+	{Action: "listClusterAdminCredential"},
+	{Action: "listClusterUserCredential"},
+	{Action: "listConnectionStrings"},
+	{Action: "listCredentials"},
+	{Action: "listKeys"},
+	{Action: "listQueryKeys"},
+	{Action: "listSecrets"},
+	{Action: "listServiceSas"},
+	{Action: "listValue"},
+	{Action: "pnsCredentials"},
+	{Action: "readonlykeys"},
+	{Action: "sharedKeys"},
+
+	// Resource Provider specific actions
+	{ResourceProvider: "Microsoft.BotService", Action: "listChannelWithKeys"},
+	{ResourceProvider: "Microsoft.Compute", Action: "retrieveBootDiagnosticsData"},
+	{ResourceProvider: "Microsoft.ContainerRegistry", Action: "getCallbackConfig"},
+	{ResourceProvider: "Microsoft.ContainerRegistry", Action: "listDetails"},
+	{ResourceProvider: "Microsoft.DesktopVirtualization", Action: "retrieveRegistrationToken"},
+	{ResourceProvider: "Microsoft.DocumentDB", Action: "retrieveContinuousBackupInformation"},
+	{ResourceProvider: "Microsoft.EventGrid", Action: "getFullUrl"},
+	{ResourceProvider: "Microsoft.HDInsight", Action: "configurations"},
+	{ResourceProvider: "Microsoft.Logic", Action: "listCallbackUrl"},
+	{ResourceProvider: "Microsoft.Media", Action: "getPolicyPropertiesWithSecrets"},
+	{ResourceProvider: "Microsoft.Web", Action: "config/appsettings/list"},
+	{ResourceProvider: "Microsoft.Web", Action: "config/authsettings/list"},
+	{ResourceProvider: "Microsoft.Web", Action: "config/azurestorageaccounts/list"},
+	{ResourceProvider: "Microsoft.Web", Action: "config/backup/list"},
+	{ResourceProvider: "Microsoft.Web", Action: "config/connectionstrings/list"},
+	{ResourceProvider: "Microsoft.Web", Action: "config/metadata/list"},
+	{ResourceProvider: "Microsoft.Web", Action: "config/publishingcredentials/list"},
+	{ResourceProvider: "Microsoft.Web", Action: "listAppSettings"},
+
+	// Name Availability
+	{Action: "checkNameAvailability"},
+}
+
+func (a readOnlyAllowedPostAction) matches(path string) bool {
+	path = strings.ToLower(path)
+	if !strings.HasSuffix(path, "/"+strings.ToLower(a.Action)) {
+		return false
+	}
+
+	if a.ResourceProvider != "" {
+		return strings.Contains(path, "/providers/"+strings.ToLower(a.ResourceProvider)+"/")
+	}
+
+	return true
+}
+
+// ReadOnlyModeError is returned for any request which is blocked since the Provider is running in Read-Only mode
+type ReadOnlyModeError struct {
+	Method string
+	Url    string
+}
+
+func (e ReadOnlyModeError) Error() string {
+	return fmt.Sprintf("the %s request to %q was blocked since the AzureRM Provider is running in Read-Only mode (configured using `read_only` in the Provider block or the `ARM_READ_ONLY` Environment Variable) - only requests which read data are allowed", e.Method, e.Url)
+}
+
+// IsRequestAllowedInReadOnlyMode returns whether the specified request only reads data, and therefore can be sent
+// when the Provider is running in Read-Only mode.
+func IsRequestAllowedInReadOnlyMode(request *http.Request) bool {
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+
+	case http.MethodPost:
+		path := strings.TrimSuffix(request.URL.Path, "/")
+		for _, action := range readOnlyAllowedPostActions {
+			if action.matches(path) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ReadOnlyRequestMiddleware returns a RequestMiddleware which blocks any request which doesn't only read data, for use
+// with clients which aren't configured using `ClientOptions.Configure` (such as the Storage Data Plane clients).
+func ReadOnlyRequestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if !IsRequestAllowedInReadOnlyMode(request) {
+			log.Printf("[DEBUG] Read-Only mode: blocking the %s request to %q", request.Method, request.URL.String())
+			return nil, ReadOnlyModeError{
+				Method: request.Method,
+				Url:    request.URL.String(),
+			}
+		}
+
+		return request, nil
+	}
+}
+
+// readOnlySender wraps the autorest Sender to block any request which doesn't only read data.
+//
+// NOTE: rather than returning an error (which would be retried by autorest), a `405 Method Not Allowed` response is
+// returned containing the error details, which is surfaced as a (non-retryable) error by the autorest Responders.
+func readOnlySender(sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		if IsRequestAllowedInReadOnlyMode(request) {
+			return sender.Do(request)
+		}
+
+		err := ReadOnlyModeError{
+			Method: request.Method,
+			Url:    request.URL.String(),
+		}
+		log.Printf("[DEBUG] Read-Only mode: blocking the %s request to %q", request.Method, request.URL.String())
+
+		body, _ := json.Marshal(map[string]interface{}{
+			"error": map[string]interface{}{
+				"code":    "ReadOnlyMode",
+				"message": err.Error(),
+			},
+		})
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", http.StatusMethodNotAllowed, http.StatusText(http.StatusMethodNotAllowed)),
+			StatusCode:    http.StatusMethodNotAllowed,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": []string{"application/json"}},
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       request,
+		}, nil
+	})
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestIsRequestAllowedInReadOnlyMode(t *testing.T) {
+	testData := []struct {
+		Method   string
+		Url      string
+		Expected bool
+	}{
+		{
+			Method:   http.MethodGet,
+			Url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			Expected: true,
+		},
+		{
+			Method:   http.MethodHead,
+			Url:      "https://example.blob.core.windows.net/container/blob",
+			Expected: true,
+		},
+		{
+			Method:   http.MethodPut,
+			Url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			Expected: false,
+		},
+		{
+			Method:   http.MethodPatch,
+			Url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			Expected: false,
+		},
+		{
+			Method:   http.MethodDelete,
+			Url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			Expected: false,
+		},
+		{
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys?api-version=2023-01-01",
+			Expected: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Web/sites/example/config/appsettings/list/",
+			Expected: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Storage/checkNameAvailability",
+			Expected: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/regenerateKey",
+			Expected: false,
+		},
+		{
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Compute/register",
+			Expected: false,
+		},
+		{
+			// used by the `azurerm_cosmosdb_restore_window` Data Source
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.DocumentDB/databaseAccounts/example/sqlDatabases/example/containers/example/retrieveContinuousBackupInformation?api-version=2024-05-15",
+			Expected: true,
+		},
+		{
+			// actions are only allowed when they're listed, rather than based on their prefix
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Web/sites/example/listSyncStatus",
+			Expected: false,
+		},
+		{
+			// actions which are specific to a Resource Provider aren't allowed for other Resource Providers
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Example/clusters/example/configurations",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s %q", v.Method, v.Url)
+
+		request, err := http.NewRequest(v.Method, v.Url, nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		if actual := IsRequestAllowedInReadOnlyMode(request); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestReadOnlyAllowedPostActions(t *testing.T) {
+	for _, action := range readOnlyAllowedPostActions {
+		resourceProvider := action.ResourceProvider
+		if resourceProvider == "" {
+			resourceProvider = "Microsoft.Example"
+		}
+		url := fmt.Sprintf("https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/%s/resources/example/%s?api-version=2023-01-01", resourceProvider, action.Action)
+		t.Logf("[DEBUG] Testing %q", url)
+
+		request, err := http.NewRequest(http.MethodPost, url, nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		if !IsRequestAllowedInReadOnlyMode(request) {
+			t.Fatalf("expected the action %q to be allowed", action.Action)
+		}
+	}
+}
+
+func TestReadOnlyRequestMiddleware(t *testing.T) {
+	middleware := ReadOnlyRequestMiddleware()
+
+	request, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+	if _, err := middleware(request); err != nil {
+		t.Fatalf("expected no error for a GET request but got: %+v", err)
+	}
+
+	request, _ = http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions", nil)
+	_, err := middleware(request)
+	if err == nil {
+		t.Fatal("expected an error for a PUT request but didn't get one")
+	}
+
+	var readOnlyErr ReadOnlyModeError
+	if !errors.As(err, &readOnlyErr) {
+		t.Fatalf("expected a ReadOnlyModeError but got: %+v", err)
+	}
+}
+
+func TestReadOnlySender(t *testing.T) {
+	sent := false
+	sender := readOnlySender(autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		sent = true
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+
+	request, _ := http.NewRequest(http.MethodDelete, "https://management.azure.com/subscriptions", nil)
+	resp, err := sender.Do(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if sent {
+		t.Fatal("expected the DELETE request not to be sent")
+	}
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("expected a %d status code but got %d", http.StatusMethodNotAllowed, resp.StatusCode)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "Read-Only mode") {
+		t.Fatalf("expected the response body to contain the error details but got %q", string(body))
+	}
+
+	request, _ = http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+	if _, err := sender.Do(request); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !sent {
+		t.Fatal("expected the GET request to be sent")
+	}
+}
@@ -67,6 +67,7 @@ func Default() UserFeatures {
 		},
 		PostgresqlFlexibleServer: PostgresqlFlexibleServerFeatures{
 			RestartServerOnConfigurationValueChange: true,
+			InPlaceMajorVersionUpgrade:              false,
 		},
 		MachineLearning: MachineLearningFeatures{
 			PurgeSoftDeletedWorkspaceOnDestroy: false,
@@ -75,5 +76,9 @@ func Default() UserFeatures {
 			VMBackupStopProtectionAndRetainDataOnDestroy: false,
 			PurgeProtectedItemsFromVaultOnDestroy:        false,
 		},
+		PreviewFeatures: PreviewFeaturesFeatures{
+			RegisterRequiredFeatures:    false,
+			ErrorOnUnregisteredFeatures: false,
+		},
 	}
 }
@@ -20,6 +20,7 @@ type UserFeatures struct {
 	PostgresqlFlexibleServer PostgresqlFlexibleServerFeatures
 	MachineLearning          MachineLearningFeatures
 	RecoveryService          RecoveryServiceFeatures
+	PreviewFeatures          PreviewFeaturesFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -94,6 +95,7 @@ type RecoveryServicesVault struct {
 
 type PostgresqlFlexibleServerFeatures struct {
 	RestartServerOnConfigurationValueChange bool
+	InPlaceMajorVersionUpgrade              bool
 }
 
 type MachineLearningFeatures struct {
@@ -104,3 +106,8 @@ type RecoveryServiceFeatures struct {
 	VMBackupStopProtectionAndRetainDataOnDestroy bool
 	PurgeProtectedItemsFromVaultOnDestroy        bool
 }
+
+type PreviewFeaturesFeatures struct {
+	RegisterRequiredFeatures    bool
+	ErrorOnUnregisteredFeatures bool
+}
@@ -335,6 +335,11 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional: true,
 						Default:  true,
 					},
+					"in_place_major_version_upgrade": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
 				},
 			},
 		},
@@ -372,6 +377,26 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"preview_features": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"register_required_features": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"error_on_unregistered_features": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -598,6 +623,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := subscriptionRaw["restart_server_on_configuration_value_change"]; ok {
 				featuresMap.PostgresqlFlexibleServer.RestartServerOnConfigurationValueChange = v.(bool)
 			}
+			if v, ok := subscriptionRaw["in_place_major_version_upgrade"]; ok {
+				featuresMap.PostgresqlFlexibleServer.InPlaceMajorVersionUpgrade = v.(bool)
+			}
 		}
 	}
 
@@ -624,5 +652,18 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["preview_features"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			previewFeaturesRaw := items[0].(map[string]interface{})
+			if v, ok := previewFeaturesRaw["register_required_features"]; ok {
+				featuresMap.PreviewFeatures.RegisterRequiredFeatures = v.(bool)
+			}
+			if v, ok := previewFeaturesRaw["error_on_unregistered_features"]; ok {
+				featuresMap.PreviewFeatures.ErrorOnUnregisteredFeatures = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
@@ -80,6 +80,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				PostgresqlFlexibleServer: features.PostgresqlFlexibleServerFeatures{
 					RestartServerOnConfigurationValueChange: true,
+					InPlaceMajorVersionUpgrade:              false,
 				},
 				MachineLearning: features.MachineLearningFeatures{
 					PurgeSoftDeletedWorkspaceOnDestroy: false,
@@ -144,6 +145,7 @@ func TestExpandFeatures(t *testing.T) {
 					"postgresql_flexible_server": []interface{}{
 						map[string]interface{}{
 							"restart_server_on_configuration_value_change": true,
+							"in_place_major_version_upgrade":               true,
 						},
 					},
 					"resource_group": []interface{}{
@@ -193,6 +195,12 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          true,
 						},
 					},
+					"preview_features": []interface{}{
+						map[string]interface{}{
+							"register_required_features":     true,
+							"error_on_unregistered_features": true,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -255,6 +263,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				PostgresqlFlexibleServer: features.PostgresqlFlexibleServerFeatures{
 					RestartServerOnConfigurationValueChange: true,
+					InPlaceMajorVersionUpgrade:              true,
 				},
 				MachineLearning: features.MachineLearningFeatures{
 					PurgeSoftDeletedWorkspaceOnDestroy: true,
@@ -263,6 +272,10 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: true,
 					PurgeProtectedItemsFromVaultOnDestroy:        true,
 				},
+				PreviewFeatures: features.PreviewFeaturesFeatures{
+					RegisterRequiredFeatures:    true,
+					ErrorOnUnregisteredFeatures: true,
+				},
 			},
 		},
 		{
@@ -319,6 +332,7 @@ func TestExpandFeatures(t *testing.T) {
 					"postgresql_flexible_server": []interface{}{
 						map[string]interface{}{
 							"restart_server_on_configuration_value_change": false,
+							"in_place_major_version_upgrade":               false,
 						},
 					},
 					"resource_group": []interface{}{
@@ -368,6 +382,12 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          false,
 						},
 					},
+					"preview_features": []interface{}{
+						map[string]interface{}{
+							"register_required_features":     false,
+							"error_on_unregistered_features": false,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -430,6 +450,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				PostgresqlFlexibleServer: features.PostgresqlFlexibleServerFeatures{
 					RestartServerOnConfigurationValueChange: false,
+					InPlaceMajorVersionUpgrade:              false,
 				},
 				MachineLearning: features.MachineLearningFeatures{
 					PurgeSoftDeletedWorkspaceOnDestroy: false,
@@ -438,6 +459,10 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				PreviewFeatures: features.PreviewFeaturesFeatures{
+					RegisterRequiredFeatures:    false,
+					ErrorOnUnregisteredFeatures: false,
+				},
 			},
 		},
 	}
@@ -1533,6 +1558,25 @@ func TestExpandFeaturesPosgresqlFlexibleServer(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Postgresql Flexible Server in-place major version upgrade is Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"postgresql_flexible_server": []interface{}{
+						map[string]interface{}{
+							"restart_server_on_configuration_value_change": true,
+							"in_place_major_version_upgrade":               true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PostgresqlFlexibleServer: features.PostgresqlFlexibleServerFeatures{
+					RestartServerOnConfigurationValueChange: true,
+					InPlaceMajorVersionUpgrade:              true,
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testData {
@@ -1678,3 +1722,73 @@ func TestExpandFeaturesRecoveryService(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesPreviewFeatures(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"preview_features": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreviewFeatures: features.PreviewFeaturesFeatures{
+					RegisterRequiredFeatures:    false,
+					ErrorOnUnregisteredFeatures: false,
+				},
+			},
+		},
+		{
+			Name: "Register Required Features",
+			Input: []interface{}{
+				map[string]interface{}{
+					"preview_features": []interface{}{
+						map[string]interface{}{
+							"register_required_features":     true,
+							"error_on_unregistered_features": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreviewFeatures: features.PreviewFeaturesFeatures{
+					RegisterRequiredFeatures:    true,
+					ErrorOnUnregisteredFeatures: false,
+				},
+			},
+		},
+		{
+			Name: "Error On Unregistered Features",
+			Input: []interface{}{
+				map[string]interface{}{
+					"preview_features": []interface{}{
+						map[string]interface{}{
+							"register_required_features":     false,
+							"error_on_unregistered_features": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreviewFeatures: features.PreviewFeaturesFeatures{
+					RegisterRequiredFeatures:    false,
+					ErrorOnUnregisteredFeatures: true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.PreviewFeatures, testCase.Expected.PreviewFeatures) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected.PreviewFeatures, result.PreviewFeatures)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	sdkv2schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	azurermprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	providerfunction "github.com/hashicorp/terraform-provider-azurerm/internal/provider/function"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// AzureRmProvider is a Plugin Framework provider which is muxed alongside the Plugin SDKv2 provider,
+// and which exposes the functionality that's only available via the Plugin Framework (such as
+// Provider Functions and Ephemeral Resources).
+type AzureRmProvider struct {
+	// sdkProvider is the Plugin SDKv2 provider which defines (and configures) the Provider block
+	sdkProvider *sdkv2schema.Provider
+}
+
+var (
+	_ provider.ProviderWithEphemeralResources = &AzureRmProvider{}
+	_ provider.ProviderWithFunctions          = &AzureRmProvider{}
+)
+
+func NewAzureRmProvider(sdkProvider *sdkv2schema.Provider) provider.Provider {
+	return &AzureRmProvider{
+		sdkProvider: sdkProvider,
+	}
+}
+
+func (p *AzureRmProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "azurerm"
+}
+
+// Schema is intentionally empty since the Provider block is defined (and configured) by the Plugin SDKv2
+// provider - see ProtoV5ProviderServerFactory for how this is combined.
+func (p *AzureRmProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{}
+}
+
+// Configure exposes the Client configured by the Plugin SDKv2 provider to the Ephemeral Resources - the muxed
+// server configures each provider in turn, meaning that the Plugin SDKv2 provider has been configured by now.
+func (p *AzureRmProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	client, ok := p.sdkProvider.Meta().(*clients.Client)
+	if !ok || client == nil {
+		resp.Diagnostics.AddError("Provider not configured", "the Plugin SDKv2 provider must be configured before the Plugin Framework provider")
+		return
+	}
+
+	resp.EphemeralResourceData = client
+}
+
+func (p *AzureRmProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *AzureRmProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *AzureRmProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	ephemeralResources := make([]func() ephemeral.EphemeralResource, 0)
+	for _, service := range azurermprovider.SupportedTypedServices() {
+		if v, ok := service.(sdk.TypedServiceRegistrationWithEphemeralResources); ok {
+			ephemeralResources = append(ephemeralResources, v.EphemeralResources()...)
+		}
+	}
+	for _, service := range azurermprovider.SupportedUntypedServices() {
+		if v, ok := service.(sdk.UntypedServiceRegistrationWithEphemeralResources); ok {
+			ephemeralResources = append(ephemeralResources, v.EphemeralResources()...)
+		}
+	}
+
+	// a Service Registration can be both Typed and Untyped, in which case the same Ephemeral Resources are returned twice
+	output := make([]func() ephemeral.EphemeralResource, 0)
+	registered := make(map[string]struct{})
+	for _, v := range ephemeralResources {
+		var resp ephemeral.MetadataResponse
+		v().Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "azurerm"}, &resp)
+		if _, exists := registered[resp.TypeName]; exists {
+			continue
+		}
+		registered[resp.TypeName] = struct{}{}
+		output = append(output, v)
+	}
+
+	return output
+}
+
+func (p *AzureRmProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		providerfunction.NewBuildResourceIdFunction,
+		providerfunction.NewNormaliseResourceIdFunction,
+		providerfunction.NewParseResourceIdFunction,
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+func TestEphemeralResourcesHaveValidSchemas(t *testing.T) {
+	ctx := context.Background()
+	p := NewAzureRmProvider(provider.TestAzureProvider()).(*AzureRmProvider)
+
+	registered := make(map[string]struct{})
+	for _, v := range p.EphemeralResources(ctx) {
+		ephemeralResource := v()
+
+		var metadata ephemeral.MetadataResponse
+		ephemeralResource.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "azurerm"}, &metadata)
+		if _, exists := registered[metadata.TypeName]; exists {
+			t.Fatalf("Ephemeral Resource %q is registered more than once", metadata.TypeName)
+		}
+		registered[metadata.TypeName] = struct{}{}
+
+		t.Run(fmt.Sprintf("EphemeralResource/%s", metadata.TypeName), func(t *testing.T) {
+			var resp ephemeral.SchemaResponse
+			ephemeralResource.Schema(ctx, ephemeral.SchemaRequest{}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("retrieving the Schema for %q: %+v", metadata.TypeName, resp.Diagnostics)
+			}
+
+			if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("validating the Schema for %q: %+v", metadata.TypeName, diags)
+			}
+		})
+	}
+
+	if len(registered) == 0 {
+		t.Fatalf("expected Ephemeral Resources to be registered but got none")
+	}
+}
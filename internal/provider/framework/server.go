@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProtoV5ProviderServerFactory returns a factory for a Provider Server which muxes the Plugin SDKv2
+// provider alongside the Plugin Framework provider.
+func ProtoV5ProviderServerFactory(ctx context.Context, sdkProvider *schema.Provider) (func() tfprotov5.ProviderServer, error) {
+	servers := []func() tfprotov5.ProviderServer{
+		sdkProvider.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return providerBlockOmittedServer{
+				ProviderServer: providerserver.NewProtocol5(NewAzureRmProvider(sdkProvider))(),
+			}
+		},
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, fmt.Errorf("building the muxed Provider Server: %+v", err)
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// providerBlockOmittedServer wraps the Plugin Framework Provider Server so that the Provider block is only
+// exposed by the Plugin SDKv2 provider. The muxed server requires the Provider block to be identical across
+// each of the servers - rather than duplicating (and keeping in sync) the schema for the Provider block in
+// both the Plugin SDKv2 and Plugin Framework providers, the Plugin Framework provider doesn't expose one
+// and instead reuses the Client configured by the Plugin SDKv2 provider.
+type providerBlockOmittedServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s providerBlockOmittedServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Provider = nil
+	return resp, nil
+}
+
+func (s providerBlockOmittedServer) PrepareProviderConfig(_ context.Context, _ *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	return &tfprotov5.PrepareProviderConfigResponse{}, nil
+}
+
+func (s providerBlockOmittedServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	// the configuration for the Provider block is omitted, since it doesn't match the (empty) schema
+	return s.ProviderServer.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		TerraformVersion:   req.TerraformVersion,
+		ClientCapabilities: req.ClientCapabilities,
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type BuildResourceIdFunction struct{}
+
+var _ function.Function = BuildResourceIdFunction{}
+
+func NewBuildResourceIdFunction() function.Function {
+	return &BuildResourceIdFunction{}
+}
+
+func (f BuildResourceIdFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_resource_id"
+}
+
+func (f BuildResourceIdFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds an Azure Resource Manager ID from its components.",
+		Description:         "Builds an Azure Resource Manager ID from its individual components.",
+		MarkdownDescription: "Builds an Azure Resource Manager ID from its individual components, in the same manner as the `resourceId` function within ARM Templates. The casing of the ID is normalised in the same manner as `normalise_resource_id`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "subscription_id",
+				Description:         "The ID of the Subscription containing the Resource.",
+				MarkdownDescription: "The ID of the Subscription containing the Resource.",
+			},
+			function.StringParameter{
+				Name:                "resource_group_name",
+				Description:         "The name of the Resource Group containing the Resource, or an empty string for a Subscription-level Resource.",
+				MarkdownDescription: "The name of the Resource Group containing the Resource, or an empty string for a Subscription-level Resource.",
+			},
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "The fully qualified Resource Type, for example Microsoft.Sql/servers/databases.",
+				MarkdownDescription: "The fully qualified Resource Type, for example `Microsoft.Sql/servers/databases`.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "resource_names",
+			Description:         "The names of the Resource and any Parent Resources, one for each segment of the Resource Type.",
+			MarkdownDescription: "The names of the Resource and any Parent Resources, one for each segment of the Resource Type.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f BuildResourceIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var subscriptionId, resourceGroupName, resourceType string
+	var names []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &subscriptionId, &resourceGroupName, &resourceType, &names))
+	if resp.Error != nil {
+		return
+	}
+
+	id, err := buildResourceId(subscriptionId, resourceGroupName, resourceType, names)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, id))
+}
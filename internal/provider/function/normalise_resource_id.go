@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type NormaliseResourceIdFunction struct{}
+
+var _ function.Function = NormaliseResourceIdFunction{}
+
+func NewNormaliseResourceIdFunction() function.Function {
+	return &NormaliseResourceIdFunction{}
+}
+
+func (f NormaliseResourceIdFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalise_resource_id"
+}
+
+func (f NormaliseResourceIdFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalises the casing of an Azure Resource Manager ID.",
+		Description:         "Normalises the casing of an Azure Resource Manager ID to match the casing used by the Provider.",
+		MarkdownDescription: "Normalises the casing of an Azure Resource Manager ID to match the casing used by the Provider. Segments of IDs which aren't known to the Provider (such as the Resource Names) are left as-is.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				Description:         "The Resource Manager ID to normalise.",
+				MarkdownDescription: "The Resource Manager ID to normalise.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f NormaliseResourceIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, recaser.ReCase(id)))
+}
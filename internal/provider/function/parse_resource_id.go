@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ParseResourceIdFunction struct{}
+
+var _ function.Function = ParseResourceIdFunction{}
+
+func NewParseResourceIdFunction() function.Function {
+	return &ParseResourceIdFunction{}
+}
+
+var parseResourceIdResultAttributeTypes = map[string]attr.Type{
+	"subscription_id":     types.StringType,
+	"resource_group_name": types.StringType,
+	"resource_provider":   types.StringType,
+	"resource_type":       types.StringType,
+	"full_resource_type":  types.StringType,
+	"resource_name":       types.StringType,
+	"parent_resources":    types.MapType{ElemType: types.StringType},
+}
+
+func (f ParseResourceIdFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_resource_id"
+}
+
+func (f ParseResourceIdFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parses an Azure Resource Manager ID into its components.",
+		Description:         "Parses an Azure Resource Manager ID into its individual components.",
+		MarkdownDescription: "Parses an Azure Resource Manager ID into its individual components. The casing of the ID is normalised prior to parsing, in the same manner as `normalise_resource_id`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				Description:         "The Resource Manager ID to parse.",
+				MarkdownDescription: "The Resource Manager ID to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseResourceIdResultAttributeTypes,
+		},
+	}
+}
+
+func (f ParseResourceIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	components, err := parseResourceIdComponents(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	// the Parent Resources are keyed by their Resource Type, e.g. `servers` for a SQL Database
+	last := len(components.Types) - 1
+	parentResources := make(map[string]attr.Value)
+	for i := 0; i < last; i++ {
+		parentResources[components.Types[i]] = types.StringValue(components.Names[i])
+	}
+
+	fullResourceType := components.ResourceProvider + "/" + strings.Join(components.Types, "/")
+	result, diags := types.ObjectValue(parseResourceIdResultAttributeTypes, map[string]attr.Value{
+		"subscription_id":     types.StringValue(components.SubscriptionId),
+		"resource_group_name": types.StringValue(components.ResourceGroupName),
+		"resource_provider":   types.StringValue(components.ResourceProvider),
+		"resource_type":       types.StringValue(components.Types[last]),
+		"full_resource_type":  types.StringValue(fullResourceType),
+		"resource_name":       types.StringValue(components.Names[last]),
+		"parent_resources":    types.MapValueMust(types.StringType, parentResources),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseResourceIdFunction(t *testing.T) {
+	ctx := context.TODO()
+	f := NewParseResourceIdFunction()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1"),
+		}),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(parseResourceIdResultAttributeTypes)),
+	}
+	f.Run(ctx, req, &resp)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %+v", resp.Error)
+	}
+
+	expected := types.ObjectValueMust(parseResourceIdResultAttributeTypes, map[string]attr.Value{
+		"subscription_id":     types.StringValue("12345678-1234-9876-4563-123456789012"),
+		"resource_group_name": types.StringValue("group1"),
+		"resource_provider":   types.StringValue("Microsoft.Sql"),
+		"resource_type":       types.StringValue("databases"),
+		"full_resource_type":  types.StringValue("Microsoft.Sql/servers/databases"),
+		"resource_name":       types.StringValue("database1"),
+		"parent_resources": types.MapValueMust(types.StringType, map[string]attr.Value{
+			"servers": types.StringValue("server1"),
+		}),
+	})
+	if !resp.Result.Value().Equal(expected) {
+		t.Fatalf("expected %s but got %s", expected, resp.Result.Value())
+	}
+}
+
+func TestParseResourceIdFunction_invalid(t *testing.T) {
+	ctx := context.TODO()
+	f := NewParseResourceIdFunction()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("not-a-resource-id"),
+		}),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(parseResourceIdResultAttributeTypes)),
+	}
+	f.Run(ctx, req, &resp)
+	if resp.Error == nil {
+		t.Fatal("expected an error but didn't get one")
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+// resourceIdComponents are the components of a Resource Manager ID, ordered from the top-most
+// Resource Provider down to the Resource itself
+type resourceIdComponents struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ResourceProvider  string
+
+	// Types and Names are the (ordered) Resource Types and Names below the Resource Provider,
+	// e.g. `servers` / `databases` and `example-server` / `example-database`
+	Types []string
+	Names []string
+}
+
+// parseResourceIdComponents normalises the casing of the Resource ID using the Resource IDs known to
+// the Provider, and then splits it into its components.
+func parseResourceIdComponents(input string) (*resourceIdComponents, error) {
+	id := recaser.ReCase(input)
+
+	// validates the overall structure of the Resource ID
+	parsed, err := azure.ParseAzureResourceID(id)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Provider == "" {
+		return nil, fmt.Errorf("the Resource ID %q doesn't contain a Resource Provider", input)
+	}
+
+	// the parsed Path is unordered, so the Resource Types are determined from the segments following the
+	// (first) Resource Provider
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	output := resourceIdComponents{
+		SubscriptionId:    parsed.SubscriptionID,
+		ResourceGroupName: parsed.ResourceGroup,
+		ResourceProvider:  parsed.Provider,
+		Types:             make([]string, 0),
+		Names:             make([]string, 0),
+	}
+	for i := 0; i+1 < len(segments); i += 2 {
+		if strings.EqualFold(segments[i], "providers") && segments[i+1] == parsed.Provider {
+			for j := i + 2; j+1 < len(segments); j += 2 {
+				output.Types = append(output.Types, segments[j])
+				output.Names = append(output.Names, segments[j+1])
+			}
+			break
+		}
+	}
+	if len(output.Types) == 0 {
+		return nil, fmt.Errorf("the Resource ID %q doesn't contain a Resource Type", input)
+	}
+
+	return &output, nil
+}
+
+// buildResourceId builds a Resource Manager ID from the specified components, normalising the casing
+// using the Resource IDs known to the Provider.
+func buildResourceId(subscriptionId, resourceGroupName, fullResourceType string, names []string) (string, error) {
+	if subscriptionId == "" {
+		return "", fmt.Errorf("a Subscription ID must be specified")
+	}
+
+	typeSegments := strings.Split(strings.Trim(fullResourceType, "/"), "/")
+	if len(typeSegments) < 2 {
+		return "", fmt.Errorf("the Resource Type %q must be in the format `{Resource Provider}/{Resource Type}`, for example `Microsoft.Compute/virtualMachines`", fullResourceType)
+	}
+	types := typeSegments[1:]
+	if len(types) != len(names) {
+		return "", fmt.Errorf("the Resource Type %q requires %d Resource Name(s) but %d were specified", fullResourceType, len(types), len(names))
+	}
+
+	segments := []string{"subscriptions", subscriptionId}
+	if resourceGroupName != "" {
+		segments = append(segments, "resourceGroups", resourceGroupName)
+	}
+	segments = append(segments, "providers", typeSegments[0])
+	for i, name := range names {
+		if name == "" {
+			return "", fmt.Errorf("the Resource Name for %q cannot be empty", types[i])
+		}
+		segments = append(segments, types[i], name)
+	}
+
+	return recaser.ReCase("/" + strings.Join(segments, "/")), nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseResourceIdComponents(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *resourceIdComponents
+	}{
+		{
+			// empty
+			Input: "",
+		},
+		{
+			// no Resource Provider
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+		},
+		{
+			// odd number of segments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines",
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+			Expected: &resourceIdComponents{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroupName: "group1",
+				ResourceProvider:  "Microsoft.Compute",
+				Types:             []string{"virtualMachines"},
+				Names:             []string{"vm1"},
+			},
+		},
+		{
+			// incorrect casing is normalised
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1",
+			Expected: &resourceIdComponents{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroupName: "group1",
+				ResourceProvider:  "Microsoft.Sql",
+				Types:             []string{"servers", "databases"},
+				Names:             []string{"server1", "database1"},
+			},
+		},
+		{
+			// subscription-level resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/pricings/VirtualMachines",
+			Expected: &resourceIdComponents{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceProvider: "Microsoft.Security",
+				Types:            []string{"pricings"},
+				Names:            []string{"VirtualMachines"},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseResourceIdComponents(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+			t.Fatalf("expected a value but got an error: %+v", err)
+		}
+		if v.Expected == nil {
+			t.Fatal("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(*v.Expected, *actual) {
+			t.Fatalf("expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestBuildResourceId(t *testing.T) {
+	testData := []struct {
+		SubscriptionId    string
+		ResourceGroupName string
+		ResourceType      string
+		Names             []string
+		Expected          string
+		ShouldError       bool
+	}{
+		{
+			// no Subscription ID
+			ResourceGroupName: "group1",
+			ResourceType:      "Microsoft.Compute/virtualMachines",
+			Names:             []string{"vm1"},
+			ShouldError:       true,
+		},
+		{
+			// no Resource Type
+			SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+			ResourceGroupName: "group1",
+			ResourceType:      "Microsoft.Compute",
+			Names:             []string{"vm1"},
+			ShouldError:       true,
+		},
+		{
+			// mismatched number of names
+			SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+			ResourceGroupName: "group1",
+			ResourceType:      "Microsoft.Sql/servers/databases",
+			Names:             []string{"server1"},
+			ShouldError:       true,
+		},
+		{
+			// empty name
+			SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+			ResourceGroupName: "group1",
+			ResourceType:      "Microsoft.Compute/virtualMachines",
+			Names:             []string{""},
+			ShouldError:       true,
+		},
+		{
+			SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+			ResourceGroupName: "group1",
+			ResourceType:      "Microsoft.Sql/servers/databases",
+			Names:             []string{"server1", "database1"},
+			Expected:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1",
+		},
+		{
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			ResourceType:   "Microsoft.Security/pricings",
+			Names:          []string{"VirtualMachines"},
+			Expected:       "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/pricings/VirtualMachines",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q with %+v", v.ResourceType, v.Names)
+
+		actual, err := buildResourceId(v.SubscriptionId, v.ResourceGroupName, v.ResourceType, v.Names)
+		if err != nil {
+			if v.ShouldError {
+				continue
+			}
+			t.Fatalf("expected a value but got an error: %+v", err)
+		}
+		if v.ShouldError {
+			t.Fatal("expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
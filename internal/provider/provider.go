@@ -138,6 +138,20 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	for resourceType, createSubscriptionId := range subscriptionTargetedResources() {
+		resource, ok := resources[resourceType]
+		if !ok {
+			panic(fmt.Sprintf("Subscription Targeting is enabled for %q but the Resource isn't registered", resourceType))
+		}
+		withSubscriptionTargeting(resource, createSubscriptionId)
+	}
+
+	for resourceType, requirements := range resourceproviders.RequiredFeatures() {
+		if resource, ok := resources[resourceType]; ok {
+			withRequiredFeatures(resource, requirements)
+		}
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -147,6 +161,16 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The Subscription ID which should be used.",
 			},
 
+			"allowed_subscription_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+				Description: "A list of the additional Subscription IDs which Resources supporting Subscription Targeting can be managed within, based on the Subscription contained within the Resource ID.",
+			},
+
 			"client_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -171,7 +195,6 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			"auxiliary_tenant_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
-				MaxItems: 3,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -327,6 +350,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
 
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_READ_ONLY", false),
+				Description: "Should the AzureRM Provider block all requests which modify resources (and skip Resource Provider Registration)? This is intended for plan-only use-cases such as auditing and drift detection.",
+			},
+
 			"storage_use_azuread": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -353,10 +383,6 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			auxTenants = strings.Split(v, ";")
 		}
 
-		if len(auxTenants) > 3 {
-			return nil, diag.Errorf("the provider only supports up to 3 auxiliary tenant IDs")
-		}
-
 		var clientCertificateData []byte
 		if encodedCert := d.Get("client_certificate").(string); encodedCert != "" {
 			var err error
@@ -441,15 +467,23 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 }
 
 func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials) (*clients.Client, diag.Diagnostics) {
+	readOnly := d.Get("read_only").(bool)
 	skipProviderRegistration := d.Get("skip_provider_registration").(bool)
+	if readOnly {
+		// Resource Provider Registration requires write access, so is skipped in Read-Only mode
+		logEntry("[DEBUG] Read-Only mode is enabled - Resource Provider Registration will be skipped")
+		skipProviderRegistration = true
+	}
 
 	clientBuilder := clients.ClientBuilder{
+		AllowedSubscriptionIDs:      *utils.ExpandStringSlice(d.Get("allowed_subscription_ids").([]interface{})),
 		AuthConfig:                  authConfig,
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
+		ReadOnly:                    readOnly,
 		SkipProviderRegistration:    skipProviderRegistration,
 		StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 		SubscriptionID:              d.Get("subscription_id").(string),
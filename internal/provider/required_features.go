@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// withRequiredFeatures adds plan-time validation to each argument within the Resource which requires a Feature to be
+// registered on the Subscription, and wraps the Create and Update functions so that (when opted into via the
+// `preview_features` block) any Features required by the configuration are registered prior to the Resource being
+// provisioned. This is applied to both Typed and Untyped Resources.
+func withRequiredFeatures(resource *schema.Resource, requirements []resourceproviders.FeatureRequirement) {
+	found := make([]resourceproviders.FeatureRequirement, 0)
+	for _, requirement := range requirements {
+		s := schemaForArgument(resource.Schema, requirement.Argument)
+		if s == nil {
+			continue
+		}
+
+		appendValidateDiagFunc(s, resourceproviders.ValidateFeatureRegistered(requirement.Feature))
+		found = append(found, requirement)
+	}
+	if len(found) == 0 {
+		return
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if f := resource.Create; f != nil { //nolint:staticcheck
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+			defer cancel()
+
+			if err := registerFeaturesRequiredByResource(ctx, d, meta, found); err != nil {
+				return err
+			}
+			return f(d, meta)
+		}
+	}
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if f := resource.Update; f != nil { //nolint:staticcheck
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+			defer cancel()
+
+			if err := registerFeaturesRequiredByResource(ctx, d, meta, found); err != nil {
+				return err
+			}
+			return f(d, meta)
+		}
+	}
+
+	if f := resource.CreateContext; f != nil {
+		resource.CreateContext = wrapContextFuncWithRequiredFeatures(f, found)
+	}
+	if f := resource.UpdateContext; f != nil {
+		resource.UpdateContext = wrapContextFuncWithRequiredFeatures(f, found)
+	}
+}
+
+func wrapContextFuncWithRequiredFeatures(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, requirements []resourceproviders.FeatureRequirement) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := registerFeaturesRequiredByResource(ctx, d, meta, requirements); err != nil {
+			return diag.FromErr(err)
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+// registerFeaturesRequiredByResource registers any Features required by the configuration for the Resource which
+// aren't yet registered on the Subscription, when the Provider has been configured to do so
+func registerFeaturesRequiredByResource(ctx context.Context, d *schema.ResourceData, meta interface{}, requirements []resourceproviders.FeatureRequirement) error {
+	if !resourceproviders.RegisterRequiredFeaturesEnabled() {
+		return nil
+	}
+
+	client, ok := meta.(*clients.Client)
+	if !ok {
+		return nil
+	}
+
+	requiredFeatures := resourceproviders.FeaturesRequiredByConfiguration(requirements, d)
+	if len(requiredFeatures) == 0 {
+		return nil
+	}
+
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+	return resourceproviders.EnsureFeaturesRegistered(ctx, client.Resource.FeaturesClient, client.Resource.ResourceProvidersClient, subscriptionId, requiredFeatures)
+}
+
+// schemaForArgument returns the Schema for the argument at the path (e.g. `default_node_pool.host_encryption_enabled`)
+// within the Schema, or nil if it doesn't exist
+func schemaForArgument(input map[string]*schema.Schema, path string) *schema.Schema {
+	current := input
+	var out *schema.Schema
+	for _, segment := range strings.Split(path, ".") {
+		if current == nil {
+			return nil
+		}
+
+		s, ok := current[segment]
+		if !ok {
+			return nil
+		}
+		out = s
+
+		current = nil
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			current = elem.Schema
+		}
+	}
+
+	return out
+}
+
+// appendValidateDiagFunc runs the validation function after any existing validation for the Schema has succeeded
+func appendValidateDiagFunc(s *schema.Schema, f schema.SchemaValidateDiagFunc) {
+	existing := s.ValidateDiagFunc
+	if s.ValidateFunc != nil {
+		// the Plugin SDK only allows one of these to be specified
+		existing = validation.ToDiagFunc(s.ValidateFunc)
+		s.ValidateFunc = nil
+	}
+
+	s.ValidateDiagFunc = func(i interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+		if existing != nil {
+			diags = existing(i, path)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		return append(diags, f(i, path)...)
+	}
+}
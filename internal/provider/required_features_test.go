@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+)
+
+func TestRequiredFeaturesArgumentsExist(t *testing.T) {
+	provider := TestAzureProvider()
+
+	for resourceType, requirements := range resourceproviders.RequiredFeatures() {
+		resource, ok := provider.ResourcesMap[resourceType]
+		if !ok {
+			t.Errorf("the Resource %q requires Features but isn't registered in the Provider", resourceType)
+			continue
+		}
+
+		// arguments can be listed using both the current and upcoming names, so at least one should exist
+		found := false
+		for _, requirement := range requirements {
+			if s := schemaForArgument(resource.Schema, requirement.Argument); s != nil {
+				found = true
+				if s.ValidateDiagFunc == nil {
+					t.Errorf("expected the argument %q within %q to have the Feature validation applied", requirement.Argument, resourceType)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("none of the arguments requiring Features were found within the Schema for %q", resourceType)
+		}
+	}
+}
@@ -168,8 +168,10 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		desktopvirtualization.Registration{},
 		digitaltwins.Registration{},
 		disks.Registration{},
+		dns.Registration{},
 		domainservices.Registration{},
 		elasticsan.Registration{},
+		eventgrid.Registration{},
 		eventhub.Registration{},
 		fluidrelay.Registration{},
 		graphservices.Registration{},
@@ -199,6 +201,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		nginx.Registration{},
 		paloalto.Registration{},
 		policy.Registration{},
+		postgres.Registration{},
 		privatednsresolver.Registration{},
 		recoveryservices.Registration{},
 		redis.Registration{},
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// subscriptionTargetedResources returns the Resource Types which the Untyped Services have opted into Subscription
+// Targeting, and the function returning the Subscription which each Resource will be created within. Typed Resources
+// opt into this by implementing `sdk.ResourceWithSubscriptionTargeting` instead, which is handled by the SDK wrapper.
+func subscriptionTargetedResources() map[string]sdk.CreateSubscriptionIdFunc {
+	out := make(map[string]sdk.CreateSubscriptionIdFunc)
+	for _, service := range SupportedUntypedServices() {
+		if v, ok := service.(sdk.UntypedServiceRegistrationWithSubscriptionTargeting); ok {
+			for resourceType, createSubscriptionId := range v.SubscriptionTargetedResources() {
+				out[resourceType] = createSubscriptionId
+			}
+		}
+	}
+
+	return out
+}
+
+// withSubscriptionTargeting wraps the CRUD functions for the Resource so that when the Resource is scoped to a different
+// Subscription than the one configured in the Provider block, the clients for that Subscription are used instead. The
+// Subscription is taken from the Resource ID when Reading, Updating and Deleting - and from the Resource ID being
+// built (via `createSubscriptionId`) when Creating. This is only applied to the Resources which a Service has opted
+// into this via `SubscriptionTargetedResources`.
+func withSubscriptionTargeting(resource *schema.Resource, createSubscriptionId sdk.CreateSubscriptionIdFunc) {
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if f := resource.Create; f != nil { //nolint:staticcheck
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			meta, err := metaForCreate(d, meta, createSubscriptionId)
+			if err != nil {
+				return err
+			}
+			return f(d, meta)
+		}
+	}
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if f := resource.Read; f != nil { //nolint:staticcheck
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			meta, err := metaForResourceId(d, meta)
+			if err != nil {
+				return err
+			}
+			return f(d, meta)
+		}
+	}
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if f := resource.Update; f != nil { //nolint:staticcheck
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			meta, err := metaForResourceId(d, meta)
+			if err != nil {
+				return err
+			}
+			return f(d, meta)
+		}
+	}
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if f := resource.Delete; f != nil { //nolint:staticcheck
+		resource.Delete = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			meta, err := metaForResourceId(d, meta)
+			if err != nil {
+				return err
+			}
+			return f(d, meta)
+		}
+	}
+
+	if f := resource.CreateContext; f != nil {
+		resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			meta, err := metaForCreate(d, meta, createSubscriptionId)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return f(ctx, d, meta)
+		}
+	}
+	if f := resource.ReadContext; f != nil {
+		resource.ReadContext = wrapContextFuncWithSubscriptionTargeting(f)
+	}
+	if f := resource.UpdateContext; f != nil {
+		resource.UpdateContext = wrapContextFuncWithSubscriptionTargeting(f)
+	}
+	if f := resource.DeleteContext; f != nil {
+		resource.DeleteContext = wrapContextFuncWithSubscriptionTargeting(f)
+	}
+}
+
+func wrapContextFuncWithSubscriptionTargeting(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		meta, err := metaForResourceId(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+// metaForResourceId returns the Client for the Subscription which the Resource ID is scoped to, falling back to the
+// Client configured in the Provider block when the Resource ID isn't scoped to a Subscription
+func metaForResourceId(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*clients.Client)
+	if !ok || d.Id() == "" {
+		return meta, nil
+	}
+
+	return client.ForResourceId(d.Id())
+}
+
+// metaForCreate returns the Client for the Subscription which the Resource will be created within, falling back to the
+// Client configured in the Provider block when the Resource is created within that Subscription
+func metaForCreate(d *schema.ResourceData, meta interface{}, createSubscriptionId sdk.CreateSubscriptionIdFunc) (interface{}, error) {
+	client, ok := meta.(*clients.Client)
+	if !ok || createSubscriptionId == nil {
+		return meta, nil
+	}
+
+	subscriptionId, err := createSubscriptionId(d)
+	if err != nil {
+		return nil, fmt.Errorf("determining the Subscription to create the Resource within: %+v", err)
+	}
+	if subscriptionId == "" {
+		return meta, nil
+	}
+
+	return client.ForSubscription(subscriptionId)
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestSubscriptionIdFromResourceId(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+		Valid    bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: "12345678-1234-9876-4563-123456789012",
+			Valid:    true,
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			Expected: "12345678-1234-9876-4563-123456789012",
+			Valid:    true,
+		},
+		{
+			// casing of the segment
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			Expected: "12345678-1234-9876-4563-123456789012",
+			Valid:    true,
+		},
+		{
+			// not a uuid
+			Input: "/subscriptions/example/resourceGroups/example",
+			Valid: false,
+		},
+		{
+			// scoped to a Management Group
+			Input: "/providers/Microsoft.Management/managementGroups/example/subscriptions/12345678-1234-9876-4563-123456789012",
+			Valid: false,
+		},
+		{
+			// data plane ID
+			Input: "https://example.blob.core.windows.net/container",
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, valid := clients.SubscriptionIdFromResourceId(v.Input)
+		if valid != v.Valid {
+			t.Fatalf("expected valid to be %t but got %t", v.Valid, valid)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestMetaForResourceId(t *testing.T) {
+	client := &clients.Client{
+		Account: &clients.ResourceManagerAccount{
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		},
+	}
+
+	testData := []struct {
+		Name  string
+		Id    string
+		Error bool
+	}{
+		{
+			Name: "No ID",
+			Id:   "",
+		},
+		{
+			Name: "Not Scoped to a Subscription",
+			Id:   "/providers/Microsoft.Management/managementGroups/example",
+		},
+		{
+			Name: "Same Subscription",
+			Id:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+		},
+		{
+			Name: "Different Subscription",
+			Id:   "/subscriptions/87654321-1234-9876-4563-123456789012/resourceGroups/example",
+			// the Client wasn't built via `clients.Build` so other Subscriptions are unavailable
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		d.SetId(v.Id)
+
+		actual, err := metaForResourceId(d, client)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual != client {
+			t.Fatalf("expected the existing Client to be returned")
+		}
+	}
+}
+
+func TestMetaForCreate(t *testing.T) {
+	client := &clients.Client{
+		Account: &clients.ResourceManagerAccount{
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		},
+	}
+
+	testData := []struct {
+		Name                 string
+		CreateSubscriptionId sdk.CreateSubscriptionIdFunc
+		Error                bool
+	}{
+		{
+			Name: "No Function",
+		},
+		{
+			Name: "Provider Subscription",
+			CreateSubscriptionId: func(d *pluginsdk.ResourceData) (string, error) {
+				return "", nil
+			},
+		},
+		{
+			Name: "Same Subscription",
+			CreateSubscriptionId: func(d *pluginsdk.ResourceData) (string, error) {
+				return "12345678-1234-9876-4563-123456789012", nil
+			},
+		},
+		{
+			Name: "Different Subscription",
+			CreateSubscriptionId: func(d *pluginsdk.ResourceData) (string, error) {
+				return "87654321-1234-9876-4563-123456789012", nil
+			},
+			// the Client wasn't built via `clients.Build` so other Subscriptions are unavailable
+			Error: true,
+		},
+		{
+			Name: "Error",
+			CreateSubscriptionId: func(d *pluginsdk.ResourceData) (string, error) {
+				return "", fmt.Errorf("example")
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+
+		actual, err := metaForCreate(d, client, v.CreateSubscriptionId)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual != client {
+			t.Fatalf("expected the existing Client to be returned")
+		}
+	}
+}
+
+func TestSubscriptionTargetedResourcesExist(t *testing.T) {
+	provider := TestAzureProvider()
+
+	resourceTypes := subscriptionTargetedResources()
+	for _, resourceType := range []string{"azurerm_role_assignment", "azurerm_virtual_network_peering"} {
+		if _, ok := resourceTypes[resourceType]; !ok {
+			t.Errorf("expected the Resource %q to have opted into Subscription Targeting", resourceType)
+		}
+	}
+
+	for resourceType := range resourceTypes {
+		if _, ok := provider.ResourcesMap[resourceType]; !ok {
+			t.Errorf("the Resource %q has opted into Subscription Targeting but isn't registered in the Provider", resourceType)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"sync"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2021-07-01/features"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
 )
 
@@ -18,6 +19,9 @@ var cachedResourceProviders *[]string
 var registeredResourceProviders *map[string]struct{}
 var unregisteredResourceProviders *map[string]struct{}
 
+// registeredFeatures is keyed by the lower-cased `{ResourceProvider}/{Feature}` and can (validly) be nil
+var registeredFeatures *map[string]struct{}
+
 var cacheLock = &sync.Mutex{}
 
 // CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API
@@ -40,9 +44,63 @@ func ClearCache() {
 	cachedResourceProviders = nil
 	registeredResourceProviders = nil
 	unregisteredResourceProviders = nil
+	registeredFeatures = nil
 	cacheLock.Unlock()
 }
 
+// CacheRegisteredFeatures attempts to retrieve the Features registered on the Subscription from the Resource Manager
+// API and caches them, for use in enhanced validation
+func CacheRegisteredFeatures(ctx context.Context, client *features.FeaturesClient, subscriptionId commonids.SubscriptionId) error {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	// already populated - this is checked whilst holding the lock since this can be called concurrently
+	if registeredFeatures != nil {
+		return nil
+	}
+
+	result, err := client.ListAllComplete(ctx, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("listing Features: %+v", err)
+	}
+
+	registered := make(map[string]struct{})
+	for _, item := range result.Items {
+		if item.Name == nil || item.Properties == nil || item.Properties.State == nil {
+			continue
+		}
+
+		if strings.EqualFold(*item.Properties.State, "Registered") {
+			registered[strings.ToLower(*item.Name)] = struct{}{}
+		}
+	}
+
+	registeredFeatures = &registered
+	return nil
+}
+
+// featureRegistered returns whether the Feature is registered on the Subscription, and whether this is known
+func featureRegistered(feature RequiredFeature) (registered bool, known bool) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if registeredFeatures == nil {
+		return false, false
+	}
+
+	_, registered = (*registeredFeatures)[featureKey(feature)]
+	return registered, true
+}
+
+func markFeatureAsRegistered(feature RequiredFeature) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if registeredFeatures != nil {
+		(*registeredFeatures)[featureKey(feature)] = struct{}{}
+	}
+}
+
 func populateCache(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId) error {
 	cacheLock.Lock()
 	defer cacheLock.Unlock()
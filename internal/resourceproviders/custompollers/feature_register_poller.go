@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2021-07-01/features"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+var _ pollers.PollerType = &featureRegistrationPoller{}
+
+func NewFeatureRegistrationPoller(client *features.FeaturesClient, id features.FeatureId) *featureRegistrationPoller {
+	return &featureRegistrationPoller{
+		client: client,
+		id:     id,
+	}
+}
+
+type featureRegistrationPoller struct {
+	client *features.FeaturesClient
+	id     features.FeatureId
+}
+
+func (p *featureRegistrationPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	resp, err := p.client.Get(ctx, p.id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", p.id, err)
+	}
+
+	state := ""
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.State != nil {
+		state = *model.Properties.State
+	}
+
+	if strings.EqualFold(state, "Registered") {
+		return &pollers.PollResult{
+			Status:       pollers.PollingStatusSucceeded,
+			PollInterval: 30 * time.Second,
+		}, nil
+	}
+
+	if strings.EqualFold(state, "Pending") {
+		return nil, fmt.Errorf("%s requires manual approval before it can be registered", p.id)
+	}
+
+	// Registering
+	return &pollers.PollResult{
+		Status:       pollers.PollingStatusInProgress,
+		PollInterval: 30 * time.Second,
+	}, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RequiredFeature is a Feature within a Resource Provider (sometimes referred to as an AFEC flag) which must be
+// registered on the Subscription before it can be used.
+type RequiredFeature struct {
+	// ResourceProvider is the namespace of the Resource Provider, e.g. `Microsoft.Compute`
+	ResourceProvider string
+
+	// Name is the name of the Feature, e.g. `EncryptionAtHost`
+	Name string
+}
+
+func (f RequiredFeature) String() string {
+	return fmt.Sprintf("%s/%s", f.ResourceProvider, f.Name)
+}
+
+// FeatureRequirement defines that the Feature must be registered when the Argument is set to a non-zero value
+// (e.g. `true` for a boolean).
+type FeatureRequirement struct {
+	// Argument is the path to the argument within the Schema, where nested blocks are separated by a `.` - for example
+	// `default_node_pool.host_encryption_enabled`. Arguments which aren't present in the Schema are ignored, which
+	// allows both the current and the upcoming name for an argument to be specified.
+	Argument string
+
+	Feature RequiredFeature
+}
+
+var encryptionAtHost = RequiredFeature{
+	ResourceProvider: "Microsoft.Compute",
+	Name:             "EncryptionAtHost",
+}
+
+// RequiredFeatures returns a map of the Resource Type to the Features which need to be registered on the Subscription
+// for specific arguments within that Resource to be used.
+func RequiredFeatures() map[string][]FeatureRequirement {
+	return map[string][]FeatureRequirement{
+		"azurerm_kubernetes_cluster": {
+			{Argument: "default_node_pool.enable_host_encryption", Feature: encryptionAtHost},
+			{Argument: "default_node_pool.host_encryption_enabled", Feature: encryptionAtHost},
+		},
+		"azurerm_kubernetes_cluster_node_pool": {
+			{Argument: "enable_host_encryption", Feature: encryptionAtHost},
+			{Argument: "host_encryption_enabled", Feature: encryptionAtHost},
+		},
+		"azurerm_linux_virtual_machine": {
+			{Argument: "encryption_at_host_enabled", Feature: encryptionAtHost},
+		},
+		"azurerm_linux_virtual_machine_scale_set": {
+			{Argument: "encryption_at_host_enabled", Feature: encryptionAtHost},
+		},
+		"azurerm_orchestrated_virtual_machine_scale_set": {
+			{Argument: "encryption_at_host_enabled", Feature: encryptionAtHost},
+		},
+		"azurerm_windows_virtual_machine": {
+			{Argument: "encryption_at_host_enabled", Feature: encryptionAtHost},
+		},
+		"azurerm_windows_virtual_machine_scale_set": {
+			{Argument: "encryption_at_host_enabled", Feature: encryptionAtHost},
+		},
+	}
+}
+
+// featureRequiredForValue returns whether the value for an argument means the Feature needs to be registered
+func featureRequiredForValue(input interface{}) bool {
+	switch v := input.(type) {
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case int:
+		return v != 0
+	case float64:
+		return v != 0
+	}
+
+	return input != nil
+}
+
+// splitArgumentPath splits the path to an argument (e.g. `default_node_pool.host_encryption_enabled`) into segments
+func splitArgumentPath(input string) []string {
+	return strings.Split(input, ".")
+}
+
+func featureKey(f RequiredFeature) string {
+	return strings.ToLower(f.String())
+}
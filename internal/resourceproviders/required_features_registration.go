@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2021-07-01/features"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders/custompollers"
+)
+
+// RegisterRequiredFeaturesEnabled returns whether the Provider has been configured to register any Features required
+// by the arguments within a Resource during apply.
+func RegisterRequiredFeaturesEnabled() bool {
+	return registerRequiredFeatures
+}
+
+// EnsureFeaturesRegistered registers any of the specified Features which aren't registered on the Subscription, and then
+// re-registers the Resource Provider so that the Feature is propagated.
+func EnsureFeaturesRegistered(ctx context.Context, featuresClient *features.FeaturesClient, providersClient *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, requiredFeatures []RequiredFeature) error {
+	for _, feature := range requiredFeatures {
+		if registered, _ := featureRegistered(feature); registered {
+			continue
+		}
+
+		if err := registerFeature(ctx, featuresClient, subscriptionId, feature); err != nil {
+			return err
+		}
+
+		// the Resource Provider needs to be registered again for the Feature to take effect
+		if err := registerWithSubscription(ctx, providersClient, subscriptionId, feature.ResourceProvider); err != nil {
+			return fmt.Errorf("re-registering the Resource Provider %q to propagate the Feature %q: %+v", feature.ResourceProvider, feature, err)
+		}
+
+		markFeatureAsRegistered(feature)
+	}
+
+	return nil
+}
+
+func registerFeature(ctx context.Context, client *features.FeaturesClient, subscriptionId commonids.SubscriptionId, feature RequiredFeature) error {
+	id := features.NewFeatureID(subscriptionId.SubscriptionId, feature.ResourceProvider, feature.Name)
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.State != nil {
+		if strings.EqualFold(*model.Properties.State, "Registered") {
+			return nil
+		}
+		if strings.EqualFold(*model.Properties.State, "Pending") {
+			return fmt.Errorf("%s requires manual approval before it can be registered", id)
+		}
+	}
+
+	log.Printf("[DEBUG] Registering %s..", id)
+	if _, err := client.Register(ctx, id); err != nil {
+		return fmt.Errorf("registering %s: %+v", id, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for %s to finish registering..", id)
+	pollerType := custompollers.NewFeatureRegistrationPoller(client, id)
+	poller := pollers.NewPoller(pollerType, 30*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be registered: %+v", id, err)
+	}
+
+	log.Printf("[DEBUG] %s is registered.", id)
+	return nil
+}
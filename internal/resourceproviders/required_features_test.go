@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+func TestValidateFeatureRegistered(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		input                 interface{}
+		registered            *map[string]struct{}
+		autoRegister          bool
+		errorWhenUnregistered bool
+		expected              []diag.Severity
+	}{
+		{
+			name:       "cache unavailable",
+			input:      true,
+			registered: nil,
+			expected:   []diag.Severity{},
+		},
+		{
+			name:       "argument disabled",
+			input:      false,
+			registered: &map[string]struct{}{},
+			expected:   []diag.Severity{},
+		},
+		{
+			name:  "registered",
+			input: true,
+			registered: &map[string]struct{}{
+				"microsoft.compute/encryptionathost": {},
+			},
+			expected: []diag.Severity{},
+		},
+		{
+			name:       "unregistered",
+			input:      true,
+			registered: &map[string]struct{}{},
+			expected:   []diag.Severity{diag.Warning},
+		},
+		{
+			name:                  "unregistered with error",
+			input:                 true,
+			registered:            &map[string]struct{}{},
+			errorWhenUnregistered: true,
+			expected:              []diag.Severity{diag.Error},
+		},
+		{
+			name:                  "unregistered with auto-registration",
+			input:                 true,
+			registered:            &map[string]struct{}{},
+			autoRegister:          true,
+			errorWhenUnregistered: true,
+			expected:              []diag.Severity{diag.Warning},
+		},
+	}
+
+	enhancedEnabled = true
+	defer func() {
+		enhancedEnabled = features.EnhancedValidationEnabled()
+		registeredFeatures = nil
+		ConfigureRequiredFeatures(false, false)
+	}()
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.name)
+
+		registeredFeatures = testCase.registered
+		ConfigureRequiredFeatures(testCase.autoRegister, testCase.errorWhenUnregistered)
+
+		diags := ValidateFeatureRegistered(encryptionAtHost)(testCase.input, cty.GetAttrPath("encryption_at_host_enabled"))
+		if len(diags) != len(testCase.expected) {
+			t.Fatalf("expected %d diagnostics but got %d: %+v", len(testCase.expected), len(diags), diags)
+		}
+		for i, v := range diags {
+			if v.Severity != testCase.expected[i] {
+				t.Fatalf("expected the severity to be %d but got %d", testCase.expected[i], v.Severity)
+			}
+		}
+	}
+}
+
+type testConfiguration map[string]interface{}
+
+func (c testConfiguration) Get(key string) interface{} {
+	return c[key]
+}
+
+func TestFeaturesRequiredByConfiguration(t *testing.T) {
+	requirements := RequiredFeatures()["azurerm_kubernetes_cluster"]
+
+	testCases := []struct {
+		name     string
+		input    testConfiguration
+		expected int
+	}{
+		{
+			name:     "block omitted",
+			input:    testConfiguration{},
+			expected: 0,
+		},
+		{
+			name: "argument disabled",
+			input: testConfiguration{
+				"default_node_pool": []interface{}{
+					map[string]interface{}{
+						"host_encryption_enabled": false,
+					},
+				},
+			},
+			expected: 0,
+		},
+		{
+			name: "argument enabled",
+			input: testConfiguration{
+				"default_node_pool": []interface{}{
+					map[string]interface{}{
+						"host_encryption_enabled": true,
+					},
+				},
+			},
+			expected: 1,
+		},
+		{
+			name: "both names enabled",
+			input: testConfiguration{
+				"default_node_pool": []interface{}{
+					map[string]interface{}{
+						"enable_host_encryption":  true,
+						"host_encryption_enabled": true,
+					},
+				},
+			},
+			expected: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.name)
+
+		actual := FeaturesRequiredByConfiguration(requirements, testCase.input)
+		if len(actual) != testCase.expected {
+			t.Fatalf("expected %d features but got %d: %+v", testCase.expected, len(actual), actual)
+		}
+	}
+}
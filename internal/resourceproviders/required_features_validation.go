@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// these are configured from the `preview_features` block within the `features` block when the Provider is configured
+var registerRequiredFeatures = false
+var errorOnUnregisteredFeatures = false
+
+// ConfigureRequiredFeatures configures how the plan-time validation behaves when a Feature required by an argument
+// isn't registered on the Subscription.
+func ConfigureRequiredFeatures(autoRegister bool, errorWhenUnregistered bool) {
+	registerRequiredFeatures = autoRegister
+	errorOnUnregisteredFeatures = errorWhenUnregistered
+}
+
+// ValidateFeatureRegistered returns a validation function which checks whether the Feature required when this
+// argument is set is registered on the Subscription, raising either a warning or an error during plan when it isn't.
+//
+// NOTE: this is best-effort - when enhanced validation is disabled, or the registered Features couldn't be retrieved
+// from the API, this validation is skipped.
+func ValidateFeatureRegistered(feature RequiredFeature) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		if !enhancedEnabled || !featureRequiredForValue(i) {
+			return nil
+		}
+
+		registered, known := featureRegistered(feature)
+		if !known || registered {
+			return nil
+		}
+
+		if registerRequiredFeatures {
+			return diag.Diagnostics{
+				{
+					Severity:      diag.Warning,
+					Summary:       fmt.Sprintf("The Feature %q will be registered on the Subscription", feature),
+					Detail:        fmt.Sprintf("This argument requires the Feature %q to be registered on the Subscription, which isn't currently registered - as such this will be registered during apply, which can take a while to complete.", feature),
+					AttributePath: path,
+				},
+			}
+		}
+
+		severity := diag.Warning
+		if errorOnUnregisteredFeatures {
+			severity = diag.Error
+		}
+		return diag.Diagnostics{
+			{
+				Severity:      severity,
+				Summary:       fmt.Sprintf("The Feature %q is not registered on the Subscription", feature),
+				Detail:        fmt.Sprintf("This argument requires the Feature %q to be registered on the Subscription, otherwise the apply will fail. This can be registered using the `azurerm_resource_provider_registration` resource, or automatically by setting `register_required_features` to `true` within the `preview_features` block in the `features` block of the Provider.", feature),
+				AttributePath: path,
+			},
+		}
+	}
+}
+
+// FeaturesRequiredByConfiguration returns the Features which are required by the arguments set within the
+// configuration (e.g. a `*pluginsdk.ResourceData`) for the Resource.
+func FeaturesRequiredByConfiguration(requirements []FeatureRequirement, d interface{ Get(string) interface{} }) []RequiredFeature {
+	out := make([]RequiredFeature, 0)
+	seen := make(map[string]struct{})
+	for _, requirement := range requirements {
+		if _, ok := seen[featureKey(requirement.Feature)]; ok {
+			continue
+		}
+
+		segments := splitArgumentPath(requirement.Argument)
+		for _, value := range valuesAtPath(d.Get(segments[0]), segments[1:]) {
+			if featureRequiredForValue(value) {
+				out = append(out, requirement.Feature)
+				seen[featureKey(requirement.Feature)] = struct{}{}
+				break
+			}
+		}
+	}
+
+	return out
+}
+
+// valuesAtPath returns the value(s) for the nested argument within input - since nested blocks can contain multiple
+// items this returns the value for each item.
+func valuesAtPath(input interface{}, segments []string) []interface{} {
+	if len(segments) == 0 {
+		return []interface{}{input}
+	}
+
+	var items []interface{}
+	switch v := input.(type) {
+	case []interface{}:
+		items = v
+	case *schema.Set:
+		items = v.List()
+	default:
+		return nil
+	}
+
+	out := make([]interface{}, 0)
+	for _, item := range items {
+		raw, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		out = append(out, valuesAtPath(raw[segments[0]], segments[1:])...)
+	}
+	return out
+}
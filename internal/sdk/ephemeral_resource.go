@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// EphemeralResourceMetadata is embedded within Ephemeral Resources to provide access to the Client
+// which has been configured by the Provider.
+type EphemeralResourceMetadata struct {
+	Client *clients.Client
+}
+
+// Defaults configures the Client from the Provider Data - and should be called from the Configure
+// method of the Ephemeral Resource.
+func (m *EphemeralResourceMetadata) Defaults(req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Configure is called prior to the Provider being configured, in which case there's nothing to do
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", fmt.Sprintf("expected a `*clients.Client` but got %T", req.ProviderData))
+		return
+	}
+
+	m.Client = client
+}
+
+// StringValidator allows the existing Plugin SDK validation functions to be used to validate a String
+// attribute within an Ephemeral Resource.
+func StringValidator(validateFunc pluginsdk.SchemaValidateFunc) validator.String {
+	return stringValidator{
+		validateFunc: validateFunc,
+	}
+}
+
+type stringValidator struct {
+	validateFunc pluginsdk.SchemaValidateFunc
+}
+
+var _ validator.String = stringValidator{}
+
+func (v stringValidator) Description(_ context.Context) string {
+	return "the value must pass the validation function for this attribute"
+}
+
+func (v stringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	warnings, errs := v.validateFunc(req.ConfigValue.ValueString(), req.Path.String())
+	for _, warning := range warnings {
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Invalid Attribute Value", warning)
+	}
+	for _, err := range errs {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", err.Error())
+	}
+}
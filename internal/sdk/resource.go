@@ -156,6 +156,20 @@ type ResourceWithCustomizeDiff interface {
 	CustomizeDiff() ResourceFunc
 }
 
+// ResourceWithSubscriptionTargeting is an optional interface
+//
+// Resources implementing this interface use the clients for the Subscription contained within the Resource ID,
+// rather than the Subscription configured in the Provider block - which is exposed to the Resource as
+// `metadata.Client` in the Create, Read, Update, Delete and Import functions.
+type ResourceWithSubscriptionTargeting interface {
+	Resource
+
+	// CreateSubscriptionId returns the ID of the Subscription which the Resource will be created within, based on the
+	// Resource ID being built from the configuration - or an empty string to use the Subscription configured in the
+	// Provider block.
+	CreateSubscriptionId(metadata ResourceMetaData) (string, error)
+}
+
 // ResourceRunFunc is the function which can be run
 // ctx provides a Context instance with the user-provided timeout
 // metadata is a reference to an object containing the Client, ResourceData and a Logger
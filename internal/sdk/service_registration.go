@@ -4,6 +4,7 @@
 package sdk
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -64,3 +65,66 @@ type UntypedServiceRegistrationWithAGitHubLabel interface {
 
 	AssociatedGitHubLabel() string
 }
+
+// TypedServiceRegistrationWithSweepers is a superset of TypedServiceRegistration allowing
+// a Service to register Sweepers which remove any resources leaked by the Acceptance Tests.
+//
+// NOTE: this is intentionally an optional interface as not every Service needs a Sweeper
+type TypedServiceRegistrationWithSweepers interface {
+	TypedServiceRegistration
+
+	Sweepers() []Sweeper
+}
+
+// UntypedServiceRegistrationWithSweepers is a superset of UntypedServiceRegistration allowing
+// a Service to register Sweepers which remove any resources leaked by the Acceptance Tests.
+//
+// NOTE: this is intentionally an optional interface as not every Service needs a Sweeper
+type UntypedServiceRegistrationWithSweepers interface {
+	UntypedServiceRegistration
+
+	Sweepers() []Sweeper
+}
+
+// TypedServiceRegistrationWithEphemeralResources is a superset of TypedServiceRegistration allowing
+// a Service to register Ephemeral Resources, which are served by the Plugin Framework provider.
+//
+// NOTE: this is intentionally an optional interface as Ephemeral Resources are only supported in
+// Terraform 1.10 and later.
+type TypedServiceRegistrationWithEphemeralResources interface {
+	TypedServiceRegistration
+
+	EphemeralResources() []func() ephemeral.EphemeralResource
+}
+
+// UntypedServiceRegistrationWithEphemeralResources is a superset of UntypedServiceRegistration allowing
+// a Service to register Ephemeral Resources, which are served by the Plugin Framework provider.
+//
+// NOTE: this is intentionally an optional interface as Ephemeral Resources are only supported in
+// Terraform 1.10 and later.
+type UntypedServiceRegistrationWithEphemeralResources interface {
+	UntypedServiceRegistration
+
+	EphemeralResources() []func() ephemeral.EphemeralResource
+}
+
+// UntypedServiceRegistrationWithSubscriptionTargeting is a superset of UntypedServiceRegistration allowing
+// a Service to opt Resources into using the clients for the Subscription contained within the Resource ID,
+// rather than the Subscription configured in the Provider block.
+//
+// NOTE: this is intentionally an optional interface as most Resources are either always managed within the
+// Subscription configured in the Provider block, or are scoped APIs which work across Subscriptions. Typed
+// Resources opt into this by implementing ResourceWithSubscriptionTargeting instead.
+type UntypedServiceRegistrationWithSubscriptionTargeting interface {
+	UntypedServiceRegistration
+
+	// SubscriptionTargetedResources returns a map of the Resource Types which should use the clients for the
+	// Subscription contained within the Resource ID, to the function returning the ID of the Subscription which
+	// the Resource will be created within
+	SubscriptionTargetedResources() map[string]CreateSubscriptionIdFunc
+}
+
+// CreateSubscriptionIdFunc returns the ID of the Subscription which the Resource will be created within, based on
+// the Resource ID being built from the configuration - or an empty string to use the Subscription configured in the
+// Provider block
+type CreateSubscriptionIdFunc func(d *pluginsdk.ResourceData) (string, error)
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// Sweeper removes resources which have been leaked by the Acceptance Tests, for example
+// when a test run is cancelled part-way through.
+type Sweeper struct {
+	// Name is the unique name of this Sweeper, conventionally the Terraform Resource Type
+	// which is being swept, e.g. `azurerm_resource_group`
+	Name string
+
+	// Dependencies is a list of the names of the Sweepers which must be run prior to this one
+	Dependencies []string
+
+	// Func sweeps the leaked resources using the specified options
+	Func func(ctx context.Context, client *clients.Client, options SweeperOptions) error
+}
+
+// SweeperOptions configures which resources are removed by a Sweeper
+type SweeperOptions struct {
+	// DryRun specifies that the resources which would be removed should only be logged
+	DryRun bool
+
+	// MinimumAge is the minimum age of a resource before it's removed, so that the
+	// resources belonging to any in-progress test runs are left alone
+	MinimumAge time.Duration
+
+	// Prefix is the (case-insensitive) prefix of the names of the resources to be removed
+	Prefix string
+
+	// PurgeSoftDeletedItems specifies that any soft-deleted items should be purged
+	PurgeSoftDeletedItems bool
+}
+
+// ShouldSweep returns whether a resource with the specified name, created at the specified time,
+// should be removed. When the creation time is unknown the resource is only removed when no
+// MinimumAge has been specified.
+func (o SweeperOptions) ShouldSweep(name string, createdAt *time.Time) bool {
+	if !strings.HasPrefix(strings.ToLower(name), strings.ToLower(o.Prefix)) {
+		return false
+	}
+
+	if o.MinimumAge == 0 {
+		return true
+	}
+
+	return createdAt != nil && time.Since(*createdAt) >= o.MinimumAge
+}
+
+var testResourceNameTimestampRegex = regexp.MustCompile(`\d{18}`)
+
+// CreationTimeFromTestResourceName returns the time at which a resource was created by the Acceptance Tests,
+// determined from the `RandomInteger` of the Test Data (which is prefixed with a `YYMMddHHmmss` timestamp)
+// used within the name of the resource - or nil if the name doesn't contain one.
+func CreationTimeFromTestResourceName(name string) *time.Time {
+	matches := testResourceNameTimestampRegex.FindAllString(name, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	createdAt, err := time.ParseInLocation("060102150405", matches[len(matches)-1][0:12], time.Local)
+	if err != nil {
+		return nil
+	}
+
+	return &createdAt
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestSweeperOptions_ShouldSweep(t *testing.T) {
+	testData := []struct {
+		Name      string
+		Options   SweeperOptions
+		CreatedAt *time.Time
+		Expected  bool
+	}{
+		{
+			Name:     "acctestRG-123",
+			Options:  SweeperOptions{Prefix: "acctest"},
+			Expected: true,
+		},
+		{
+			Name:     "ACCTESTRG-123",
+			Options:  SweeperOptions{Prefix: "acctest"},
+			Expected: true,
+		},
+		{
+			Name:     "production",
+			Options:  SweeperOptions{Prefix: "acctest"},
+			Expected: false,
+		},
+		{
+			// the creation time is unknown, so it can't be determined whether this is old enough
+			Name:     "acctestRG-123",
+			Options:  SweeperOptions{Prefix: "acctest", MinimumAge: time.Hour},
+			Expected: false,
+		},
+		{
+			Name:      "acctestRG-123",
+			Options:   SweeperOptions{Prefix: "acctest", MinimumAge: time.Hour},
+			CreatedAt: pointer.To(time.Now().Add(-2 * time.Hour)),
+			Expected:  true,
+		},
+		{
+			Name:      "acctestRG-123",
+			Options:   SweeperOptions{Prefix: "acctest", MinimumAge: time.Hour},
+			CreatedAt: pointer.To(time.Now().Add(-10 * time.Minute)),
+			Expected:  false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q (Minimum Age %s)", v.Name, v.Options.MinimumAge)
+
+		if actual := v.Options.ShouldSweep(v.Name, v.CreatedAt); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestCreationTimeFromTestResourceName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected *time.Time
+	}{
+		{
+			Name:     "acctestRG-240423101502001234",
+			Expected: pointer.To(time.Date(2024, 4, 23, 10, 15, 2, 0, time.Local)),
+		},
+		{
+			Name:     "acctestRG-batch-240423101502001234",
+			Expected: pointer.To(time.Date(2024, 4, 23, 10, 15, 2, 0, time.Local)),
+		},
+		{
+			// a random string rather than a random integer
+			Name:     "acctestRG-abcde",
+			Expected: nil,
+		},
+		{
+			// not a valid timestamp
+			Name:     "acctestRG-249999999999991234",
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := CreationTimeFromTestResourceName(v.Name)
+		if v.Expected == nil {
+			if actual != nil {
+				t.Fatalf("expected nil but got %s", actual)
+			}
+			continue
+		}
+
+		if actual == nil || !actual.Equal(*v.Expected) {
+			t.Fatalf("expected %s but got %v", v.Expected, actual)
+		}
+	}
+}
@@ -51,8 +51,11 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		Schema: *resourceSchema,
 
 		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
-			err := rw.resource.Create().Func(ctx, metaData)
+			metaData, err := rw.runArgs(d, meta)
+			if err != nil {
+				return err
+			}
+			err = rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
 				return err
 			}
@@ -64,11 +67,17 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 		// looks like these could be reused, easiest if they're not
 		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData, err := rw.runArgs(d, meta)
+			if err != nil {
+				return err
+			}
 			return rw.resource.Read().Func(ctx, metaData)
 		}),
 		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData, err := rw.runArgs(d, meta)
+			if err != nil {
+				return err
+			}
 			return rw.resource.Delete().Func(ctx, metaData)
 		}),
 
@@ -96,11 +105,14 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			return nil
 		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				metaData := runArgs(d, meta, rw.logger)
+				metaData, err := rw.runArgs(d, meta)
+				if err != nil {
+					return nil, err
+				}
 
 				ctx, cancel := context.WithTimeout(ctx, rw.resource.Read().Timeout)
 				defer cancel()
-				err := v.CustomImporter()(ctx, metaData)
+				err = v.CustomImporter()(ctx, metaData)
 				if err != nil {
 					return nil, err
 				}
@@ -116,9 +128,12 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
 		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+			metaData, err := rw.runArgs(d, meta)
+			if err != nil {
+				return err
+			}
 
-			err := v.Update().Func(ctx, metaData)
+			err = v.Update().Func(ctx, metaData)
 			if err != nil {
 				return err
 			}
@@ -182,6 +197,40 @@ and we recommend using the %[2]q resource instead.
 	return &resource, nil
 }
 
+// runArgs returns the ResourceMetaData for the Resource - using the Client for the Subscription which the Resource
+// exists within when the Resource implements ResourceWithSubscriptionTargeting
+func (rw *ResourceWrapper) runArgs(d *schema.ResourceData, meta interface{}) (ResourceMetaData, error) {
+	metaData := runArgs(d, meta, rw.logger)
+
+	v, ok := rw.resource.(ResourceWithSubscriptionTargeting)
+	if !ok {
+		return metaData, nil
+	}
+
+	if d.Id() != "" {
+		client, err := metaData.Client.ForResourceId(d.Id())
+		if err != nil {
+			return metaData, err
+		}
+		metaData.Client = client
+		return metaData, nil
+	}
+
+	subscriptionId, err := v.CreateSubscriptionId(metaData)
+	if err != nil {
+		return metaData, fmt.Errorf("determining the Subscription to create the %s within: %+v", rw.resource.ResourceType(), err)
+	}
+	if subscriptionId != "" {
+		client, err := metaData.Client.ForSubscription(subscriptionId)
+		if err != nil {
+			return metaData, err
+		}
+		metaData.Client = client
+	}
+
+	return metaData, nil
+}
+
 func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return diagnosticsWrapper(in, rw.logger)
 }
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type subscriptionTargetedResource struct {
+	createSubscriptionId string
+}
+
+var _ ResourceWithSubscriptionTargeting = subscriptionTargetedResource{}
+
+func (subscriptionTargetedResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (subscriptionTargetedResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (subscriptionTargetedResource) ModelObject() interface{} {
+	return nil
+}
+
+func (subscriptionTargetedResource) ResourceType() string {
+	return "validator_subscription_targeted"
+}
+
+func (subscriptionTargetedResource) Create() ResourceFunc {
+	return ResourceFunc{
+		Func: func(_ context.Context, _ ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (subscriptionTargetedResource) Read() ResourceFunc {
+	return ResourceFunc{
+		Func: func(_ context.Context, _ ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (subscriptionTargetedResource) Delete() ResourceFunc {
+	return ResourceFunc{
+		Func: func(_ context.Context, _ ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (subscriptionTargetedResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return nil
+}
+
+func (r subscriptionTargetedResource) CreateSubscriptionId(_ ResourceMetaData) (string, error) {
+	return r.createSubscriptionId, nil
+}
+
+func TestResourceWrapperSubscriptionTargeting(t *testing.T) {
+	client := &clients.Client{
+		Account: &clients.ResourceManagerAccount{
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		},
+	}
+
+	testData := []struct {
+		Name                 string
+		Id                   string
+		CreateSubscriptionId string
+		Error                bool
+	}{
+		{
+			Name: "Creating within the Provider Subscription",
+		},
+		{
+			Name:                 "Creating within the same Subscription",
+			CreateSubscriptionId: "12345678-1234-9876-4563-123456789012",
+		},
+		{
+			Name:                 "Creating within a different Subscription",
+			CreateSubscriptionId: "87654321-1234-9876-4563-123456789012",
+			// the Client wasn't built via `clients.Build` so other Subscriptions are unavailable
+			Error: true,
+		},
+		{
+			Name: "Existing within the same Subscription",
+			Id:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+		},
+		{
+			Name: "Existing within a different Subscription",
+			Id:   "/subscriptions/87654321-1234-9876-4563-123456789012/resourceGroups/example",
+			// the Client wasn't built via `clients.Build` so other Subscriptions are unavailable
+			Error: true,
+		},
+		{
+			Name:                 "Existing ignores the Create Subscription",
+			Id:                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			CreateSubscriptionId: "87654321-1234-9876-4563-123456789012",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		wrapper := NewResourceWrapper(subscriptionTargetedResource{
+			createSubscriptionId: v.CreateSubscriptionId,
+		})
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		d.SetId(v.Id)
+
+		metaData, err := wrapper.runArgs(d, client)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if metaData.Client != client {
+			t.Fatalf("expected the existing Client to be used")
+		}
+	}
+}
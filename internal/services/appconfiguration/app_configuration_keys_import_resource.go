@@ -0,0 +1,444 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/appconfiguration/1.0/appconfiguration"
+)
+
+// keysImportBatchSize is the number of Key Values written or removed concurrently
+const keysImportBatchSize = 10
+
+type KeysImportResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = KeysImportResource{}
+	_ sdk.ResourceWithCustomizeDiff = KeysImportResource{}
+)
+
+type KeysImportResourceModel struct {
+	ConfigurationStoreId string                 `tfschema:"configuration_store_id"`
+	Content              string                 `tfschema:"content"`
+	Format               string                 `tfschema:"format"`
+	KeyPrefix            string                 `tfschema:"key_prefix"`
+	Label                string                 `tfschema:"label"`
+	Separator            string                 `tfschema:"separator"`
+	ContentType          string                 `tfschema:"content_type"`
+	Tags                 map[string]interface{} `tfschema:"tags"`
+	Keys                 map[string]string      `tfschema:"keys"`
+}
+
+func (r KeysImportResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: configurationstores.ValidateConfigurationStoreID,
+		},
+
+		"content": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"format": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(possibleValuesForKeysImportFormat(), false),
+		},
+
+		"key_prefix": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+
+		"label": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+
+		"separator": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(possibleValuesForKeysImportSeparator(), false),
+		},
+
+		"content_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r KeysImportResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"keys": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r KeysImportResource) ModelObject() interface{} {
+	return &KeysImportResourceModel{}
+}
+
+func (r KeysImportResource) ResourceType() string {
+	return "azurerm_app_configuration_keys_import"
+}
+
+func (r KeysImportResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.KeysImportId
+}
+
+func (r KeysImportResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KeysImportResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			configurationStoreEndpoint, err := metadata.Client.AppConfiguration.EndpointForConfigurationStore(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("retrieving Endpoint for %s: %s", *configurationStoreId, err)
+			}
+
+			id, err := parse.NewKeysImportID(*configurationStoreEndpoint, model.KeyPrefix, model.Label)
+			if err != nil {
+				return err
+			}
+
+			desired, err := expandKeysImportDocument(model)
+			if err != nil {
+				return err
+			}
+
+			if err := r.apply(ctx, metadata, *id, model, desired, []string{}, true); err != nil {
+				return fmt.Errorf("importing %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return metadata.ResourceData.Set("keys", desired)
+		},
+	}
+}
+
+func (r KeysImportResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseKeysImportID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			domainSuffix, ok := metadata.Client.Account.Environment.AppConfiguration.DomainSuffix()
+			if !ok {
+				return fmt.Errorf("could not determine AppConfiguration domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+			}
+
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+			configurationStoreIdRaw, err := metadata.Client.AppConfiguration.ConfigurationStoreIDFromEndpoint(ctx, subscriptionId, id.ConfigurationStoreEndpoint, *domainSuffix)
+			if err != nil {
+				return fmt.Errorf("while retrieving the Resource ID of Configuration Store at Endpoint: %q: %s", id.ConfigurationStoreEndpoint, err)
+			}
+			if configurationStoreIdRaw == nil {
+				// if the AppConfiguration is gone then all the data inside it is too
+				log.Printf("[DEBUG] Unable to determine the Resource ID for Configuration Store at Endpoint %q - removing from state", id.ConfigurationStoreEndpoint)
+				return metadata.MarkAsGone(id)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(*configurationStoreIdRaw)
+			if err != nil {
+				return err
+			}
+
+			var state KeysImportResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := listKeysImportKeyValues(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			// only the Keys previously imported are tracked, so that any drift is detected during the next plan
+			keys := make(map[string]string)
+			for key := range state.Keys {
+				if value, ok := existing[key]; ok {
+					keys[key] = value
+				}
+			}
+
+			state.ConfigurationStoreId = configurationStoreId.ID()
+			state.KeyPrefix = id.KeyPrefix
+			state.Label = id.Label
+			state.Keys = keys
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KeysImportResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseKeysImportID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KeysImportResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			desired, err := expandKeysImportDocument(model)
+			if err != nil {
+				return err
+			}
+
+			oldKeys, _ := metadata.ResourceData.GetChange("keys")
+			previouslyImported := make([]string, 0)
+			for key := range oldKeys.(map[string]interface{}) {
+				previouslyImported = append(previouslyImported, key)
+			}
+
+			// when the properties applied to every Key change, all of the Keys need to be rewritten
+			rewriteAll := metadata.ResourceData.HasChanges("content_type", "tags")
+
+			if err := r.apply(ctx, metadata, *id, model, desired, previouslyImported, rewriteAll); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return metadata.ResourceData.Set("keys", desired)
+		},
+	}
+}
+
+func (r KeysImportResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseKeysImportID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KeysImportResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			previouslyImported := make([]string, 0)
+			for key := range model.Keys {
+				previouslyImported = append(previouslyImported, key)
+			}
+
+			if err := r.apply(ctx, metadata, *id, model, map[string]string{}, previouslyImported, false); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KeysImportResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			for _, key := range []string{"content", "format", "key_prefix", "separator"} {
+				if !rd.NewValueKnown(key) {
+					return rd.SetNewComputed("keys")
+				}
+			}
+
+			model := KeysImportResourceModel{
+				Content:   rd.Get("content").(string),
+				Format:    rd.Get("format").(string),
+				KeyPrefix: rd.Get("key_prefix").(string),
+				Separator: rd.Get("separator").(string),
+			}
+
+			desired, err := expandKeysImportDocument(model)
+			if err != nil {
+				return err
+			}
+
+			current := make(map[string]string)
+			for key, value := range rd.Get("keys").(map[string]interface{}) {
+				current[key] = value.(string)
+			}
+
+			// the Key Values in the Configuration Store differ from the document, so they need to be re-imported
+			if !reflect.DeepEqual(current, desired) {
+				return rd.SetNew("keys", desired)
+			}
+
+			return nil
+		},
+	}
+}
+
+// apply writes the Key Values which differ from those in the Configuration Store and removes the previously
+// imported Keys which are no longer present in the document, in batches of `keysImportBatchSize` Keys.
+func (r KeysImportResource) apply(ctx context.Context, metadata sdk.ResourceMetaData, id parse.KeysImportId, model KeysImportResourceModel, desired map[string]string, previouslyImported []string, rewriteAll bool) error {
+	client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+	if err != nil {
+		return err
+	}
+
+	existing, err := listKeysImportKeyValues(ctx, metadata, id)
+	if err != nil {
+		return err
+	}
+
+	if rewriteAll {
+		existing = map[string]string{}
+	}
+	changes := diffKeysImport(desired, existing, previouslyImported)
+	metadata.Logger.Infof("[DEBUG] Importing %d and removing %d Keys for %s", len(changes.Upsert), len(changes.Delete), id)
+
+	for _, batch := range keysImportBatches(changes.Upsert, keysImportBatchSize) {
+		err := keysImportRunBatch(batch, func(key string) error {
+			entity := appconfiguration.KeyValue{
+				Key:         pointer.To(key),
+				Label:       pointer.To(id.Label),
+				Value:       pointer.To(desired[key]),
+				ContentType: pointer.To(model.ContentType),
+				Tags:        tags.Expand(model.Tags),
+			}
+			if _, err := client.PutKeyValue(ctx, key, id.Label, &entity, "", ""); err != nil {
+				return fmt.Errorf("writing key %q: %+v", key, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, batch := range keysImportBatches(changes.Delete, keysImportBatchSize) {
+		err := keysImportRunBatch(batch, func(key string) error {
+			if _, err := client.DeleteKeyValue(ctx, key, id.Label, ""); err != nil {
+				return fmt.Errorf("removing key %q: %+v", key, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func keysImportRunBatch(keys []string, apply func(key string) error) error {
+	errors := make(chan error, len(keys))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(keys))
+
+	for _, key := range keys {
+		go func(key string) {
+			defer wg.Done()
+			if err := apply(key); err != nil {
+				errors <- err
+			}
+		}(key)
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return <-errors
+	}
+
+	return nil
+}
+
+// listKeysImportKeyValues returns the Values of the Keys with the Key Prefix and Label of the Keys Import
+func listKeysImportKeyValues(ctx context.Context, metadata sdk.ResourceMetaData, id parse.KeysImportId) (map[string]string, error) {
+	client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// the `\0` label filter is used to match Keys without a label, since an empty filter matches every label
+	labelFilter := "\000"
+	if id.Label != "" {
+		labelFilter = escapeKeysImportFilter(id.Label)
+	}
+
+	result := make(map[string]string)
+	iter, err := client.GetKeyValuesComplete(ctx, escapeKeysImportFilter(id.KeyPrefix)+"*", labelFilter, "", "", []appconfiguration.KeyValueFields{})
+	if err != nil {
+		if v, ok := err.(autorest.DetailedError); ok && utils.ResponseWasNotFound(autorest.Response{Response: v.Response}) {
+			return result, nil
+		}
+		return nil, fmt.Errorf("listing keys for %s: %+v", id, err)
+	}
+
+	for iter.NotDone() {
+		kv := iter.Value()
+		result[pointer.From(kv.Key)] = pointer.From(kv.Value)
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing keys for %s: %+v", id, err)
+		}
+	}
+
+	return result, nil
+}
+
+func expandKeysImportDocument(model KeysImportResourceModel) (map[string]string, error) {
+	document, err := parseKeysImportDocument(model.Format, model.Content, model.Separator)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `content`: %+v", err)
+	}
+
+	result := make(map[string]string, len(document))
+	for key, value := range document {
+		result[model.KeyPrefix+key] = value
+	}
+
+	return result, nil
+}
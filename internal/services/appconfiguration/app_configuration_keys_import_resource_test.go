@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/appconfiguration/1.0/appconfiguration"
+)
+
+type AppConfigurationKeysImportResource struct{}
+
+func TestAccAppConfigurationKeysImport_json(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys_import", "test")
+	r := AppConfigurationKeysImportResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.json(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("keys.%").HasValue("3"),
+				check.That(data.ResourceName).Key("keys.app:name").HasValue("example"),
+				check.That(data.ResourceName).Key("keys.app:regions:1").HasValue("northeurope"),
+			),
+		},
+	})
+}
+
+func TestAccAppConfigurationKeysImport_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys_import", "test")
+	r := AppConfigurationKeysImportResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.json(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("keys.%").HasValue("3"),
+			),
+		},
+		{
+			Config: r.yaml(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("keys.%").HasValue("2"),
+				check.That(data.ResourceName).Key("keys.app:name").HasValue("updated"),
+			),
+		},
+	})
+}
+
+func TestAccAppConfigurationKeysImport_properties(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys_import", "test")
+	r := AppConfigurationKeysImportResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.properties(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("keys.%").HasValue("2"),
+				check.That(data.ResourceName).Key("keys.service/timeout").HasValue("30"),
+			),
+		},
+	})
+}
+
+func (t AppConfigurationKeysImportResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseKeysImportID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.AppConfiguration.DataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	for key := range state.Attributes {
+		// the map of imported keys is flattened into `keys.<name>` attributes
+		if !strings.HasPrefix(key, "keys.") || key == "keys.%" {
+			continue
+		}
+
+		name := strings.TrimPrefix(key, "keys.")
+		res, err := client.GetKeyValue(ctx, name, id.Label, "", "", "", []appconfiguration.KeyValueFields{})
+		if err != nil {
+			if utils.ResponseWasNotFound(res.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving key %q for %s: %+v", name, id, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (t AppConfigurationKeysImportResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appconfig-%d"
+  location = "%s"
+}
+
+data "azurerm_client_config" "test" {
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "App Configuration Data Owner"
+  principal_id         = data.azurerm_client_config.test.object_id
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "testacc-appconf%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+  depends_on = [
+    azurerm_role_assignment.test,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (t AppConfigurationKeysImportResource) json(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys_import" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  format                 = "json"
+  separator              = ":"
+  label                  = "acctest"
+  content = jsonencode({
+    app = {
+      name    = "example"
+      regions = ["westeurope", "northeurope"]
+    }
+  })
+}
+`, t.template(data))
+}
+
+func (t AppConfigurationKeysImportResource) yaml(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys_import" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  format                 = "yaml"
+  separator              = ":"
+  label                  = "acctest"
+  content_type           = "text/plain"
+  content = yamlencode({
+    app = {
+      name    = "updated"
+      enabled = true
+    }
+  })
+
+  tags = {
+    environment = "test"
+  }
+}
+`, t.template(data))
+}
+
+func (t AppConfigurationKeysImportResource) properties(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys_import" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  format                 = "properties"
+  key_prefix             = "service/"
+  content                = <<CONTENT
+# service settings
+timeout=30
+endpoint=https://example.com
+CONTENT
+}
+`, t.template(data))
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/configurationstores"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/replicas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ReplicaResource struct{}
+
+var _ sdk.Resource = ReplicaResource{}
+
+type ReplicaResourceModel struct {
+	Name                 string `tfschema:"name"`
+	ConfigurationStoreId string `tfschema:"configuration_store_id"`
+	Location             string `tfschema:"location"`
+	Endpoint             string `tfschema:"endpoint"`
+}
+
+func (r ReplicaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ConfigurationStoreReplicaName,
+		},
+
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: configurationstores.ValidateConfigurationStoreID,
+		},
+
+		"location": commonschema.Location(),
+	}
+}
+
+func (r ReplicaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ReplicaResource) ModelObject() interface{} {
+	return &ReplicaResourceModel{}
+}
+
+func (r ReplicaResource) ResourceType() string {
+	return "azurerm_app_configuration_replica"
+}
+
+func (r ReplicaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return replicas.ValidateReplicaID
+}
+
+func (r ReplicaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppConfiguration.ReplicasClient
+			storesClient := metadata.Client.AppConfiguration.ConfigurationStoresClient
+
+			var model ReplicaResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			id := replicas.NewReplicaID(configurationStoreId.SubscriptionId, configurationStoreId.ResourceGroupName, configurationStoreId.ConfigurationStoreName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			store, err := storesClient.Get(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *configurationStoreId, err)
+			}
+			if store.Model != nil && strings.EqualFold(location.Normalize(store.Model.Location), location.Normalize(model.Location)) {
+				return fmt.Errorf("the location (%q) of %s cannot be the same as the location of %s", model.Location, id, *configurationStoreId)
+			}
+
+			// replicas of a Configuration Store can't be created or deleted concurrently
+			locks.ByID(configurationStoreId.ID())
+			defer locks.UnlockByID(configurationStoreId.ID())
+
+			payload := replicas.Replica{
+				Name:     pointer.To(model.Name),
+				Location: pointer.To(location.Normalize(model.Location)),
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ReplicaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppConfiguration.ReplicasClient
+
+			id, err := replicas.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ReplicaResourceModel{
+				Name:                 id.ReplicaName,
+				ConfigurationStoreId: configurationstores.NewConfigurationStoreID(id.SubscriptionId, id.ResourceGroupName, id.ConfigurationStoreName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+				if props := model.Properties; props != nil {
+					state.Endpoint = pointer.From(props.Endpoint)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ReplicaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppConfiguration.ReplicasClient
+			operationsClient := metadata.Client.AppConfiguration.OperationsClient
+
+			id, err := replicas.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			configurationStoreId := configurationstores.NewConfigurationStoreID(id.SubscriptionId, id.ResourceGroupName, id.ConfigurationStoreName)
+			locks.ByID(configurationStoreId.ID())
+			defer locks.UnlockByID(configurationStoreId.ID())
+
+			if err := deleteReplicas(ctx, client, operationsClient, []replicas.ReplicaId{*id}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/replicas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AppConfigurationReplicaResource struct{}
+
+func TestAccAppConfigurationReplica_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_replica", "test")
+	r := AppConfigurationReplicaResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("endpoint").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationReplica_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_replica", "test")
+	r := AppConfigurationReplicaResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppConfigurationReplica_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_replica", "test")
+	r := AppConfigurationReplicaResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_app_configuration_replica.second").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t AppConfigurationReplicaResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := replicas.ParseReplicaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.AppConfiguration.ReplicasClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (t AppConfigurationReplicaResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appconfig-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "testaccappconf%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (t AppConfigurationReplicaResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_replica" "test" {
+  name                   = "replica1"
+  configuration_store_id = azurerm_app_configuration.test.id
+  location               = "%s"
+}
+`, t.template(data), data.Locations.Secondary)
+}
+
+func (t AppConfigurationReplicaResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_replica" "import" {
+  name                   = azurerm_app_configuration_replica.test.name
+  configuration_store_id = azurerm_app_configuration_replica.test.configuration_store_id
+  location               = azurerm_app_configuration_replica.test.location
+}
+`, t.basic(data))
+}
+
+func (t AppConfigurationReplicaResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_replica" "test" {
+  name                   = "replica1"
+  configuration_store_id = azurerm_app_configuration.test.id
+  location               = "%s"
+}
+
+resource "azurerm_app_configuration_replica" "second" {
+  name                   = "replica2"
+  configuration_store_id = azurerm_app_configuration.test.id
+  location               = "%s"
+}
+`, t.template(data), data.Locations.Secondary, data.Locations.Ternary)
+}
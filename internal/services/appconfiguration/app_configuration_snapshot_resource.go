@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SnapshotResource struct{}
+
+var _ sdk.Resource = SnapshotResource{}
+
+type SnapshotResourceModel struct {
+	Name                     string                 `tfschema:"name"`
+	ConfigurationStoreId     string                 `tfschema:"configuration_store_id"`
+	Filter                   []SnapshotFilterModel  `tfschema:"filter"`
+	CompositionType          string                 `tfschema:"composition_type"`
+	RetentionPeriodInSeconds int64                  `tfschema:"retention_period_in_seconds"`
+	Tags                     map[string]interface{} `tfschema:"tags"`
+	Status                   string                 `tfschema:"status"`
+	CreatedTime              string                 `tfschema:"created_time"`
+	ExpiryTime               string                 `tfschema:"expiry_time"`
+	ItemsCount               int64                  `tfschema:"items_count"`
+	SizeInBytes              int64                  `tfschema:"size_in_bytes"`
+	Etag                     string                 `tfschema:"etag"`
+}
+
+type SnapshotFilterModel struct {
+	Key   string `tfschema:"key"`
+	Label string `tfschema:"label"`
+}
+
+func (r SnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 256),
+				validation.StringDoesNotContainAny("*,\\"),
+			),
+		},
+
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: configurationstores.ValidateConfigurationStoreID,
+		},
+
+		"filter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 3,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"label": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+				},
+			},
+		},
+
+		"composition_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(azuresdkhacks.SnapshotCompositionTypeKey),
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForSnapshotCompositionType(), false),
+		},
+
+		"retention_period_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(3600, 7776000),
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r SnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"created_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"expiry_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"items_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"size_in_bytes": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"etag": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SnapshotResource) ModelObject() interface{} {
+	return &SnapshotResourceModel{}
+}
+
+func (r SnapshotResource) ResourceType() string {
+	return "azurerm_app_configuration_snapshot"
+}
+
+func (r SnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SnapshotId
+}
+
+func (r SnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SnapshotResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			configurationStoreEndpoint, err := metadata.Client.AppConfiguration.EndpointForConfigurationStore(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("retrieving Endpoint for snapshot %q in %q: %s", model.Name, *configurationStoreId, err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(*configurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			id, err := parse.NewSnapshotID(*configurationStoreEndpoint, model.Name)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetSnapshot(ctx, id.Name)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			filters := make([]azuresdkhacks.SnapshotKeyValueFilter, 0)
+			for _, filter := range model.Filter {
+				filters = append(filters, azuresdkhacks.SnapshotKeyValueFilter{
+					Key:   pointer.To(filter.Key),
+					Label: pointer.To(filter.Label),
+				})
+			}
+
+			snapshot := azuresdkhacks.Snapshot{
+				Filters:         &filters,
+				CompositionType: pointer.To(azuresdkhacks.SnapshotCompositionType(model.CompositionType)),
+				Tags:            tags.Expand(model.Tags),
+			}
+			if model.RetentionPeriodInSeconds != 0 {
+				snapshot.RetentionPeriod = pointer.To(model.RetentionPeriodInSeconds)
+			}
+
+			if _, err := client.CreateSnapshot(ctx, id.Name, snapshot); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:      []string{string(azuresdkhacks.SnapshotStatusProvisioning)},
+				Target:       []string{string(azuresdkhacks.SnapshotStatusReady)},
+				Refresh:      appConfigurationSnapshotStatusRefreshFunc(ctx, client, id.Name),
+				PollInterval: 10 * time.Second,
+				Timeout:      time.Until(deadline),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to become ready: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			domainSuffix, ok := metadata.Client.Account.Environment.AppConfiguration.DomainSuffix()
+			if !ok {
+				return fmt.Errorf("could not determine AppConfiguration domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+			}
+
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+			configurationStoreIdRaw, err := metadata.Client.AppConfiguration.ConfigurationStoreIDFromEndpoint(ctx, subscriptionId, id.ConfigurationStoreEndpoint, *domainSuffix)
+			if err != nil {
+				return fmt.Errorf("while retrieving the Resource ID of Configuration Store at Endpoint: %q: %s", id.ConfigurationStoreEndpoint, err)
+			}
+			if configurationStoreIdRaw == nil {
+				// if the AppConfiguration is gone then all the data inside it is too
+				log.Printf("[DEBUG] Unable to determine the Resource ID for Configuration Store at Endpoint %q - removing from state", id.ConfigurationStoreEndpoint)
+				return metadata.MarkAsGone(id)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(*configurationStoreIdRaw)
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := client.GetSnapshot(ctx, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(snapshot.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := SnapshotResourceModel{
+				Name:                     id.Name,
+				ConfigurationStoreId:     configurationStoreId.ID(),
+				CompositionType:          string(pointer.From(snapshot.CompositionType)),
+				RetentionPeriodInSeconds: pointer.From(snapshot.RetentionPeriod),
+				Tags:                     tags.Flatten(snapshot.Tags),
+				Status:                   string(pointer.From(snapshot.Status)),
+				CreatedTime:              pointer.From(snapshot.Created),
+				ExpiryTime:               pointer.From(snapshot.Expires),
+				ItemsCount:               pointer.From(snapshot.ItemsCount),
+				SizeInBytes:              pointer.From(snapshot.Size),
+				Etag:                     pointer.From(snapshot.Etag),
+			}
+
+			if snapshot.Filters != nil {
+				for _, filter := range *snapshot.Filters {
+					state.Filter = append(state.Filter, SnapshotFilterModel{
+						Key:   pointer.From(filter.Key),
+						Label: pointer.From(filter.Label),
+					})
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetSnapshot(ctx, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// Snapshots can't be deleted - instead they're archived and purged once the retention period has elapsed
+			if pointer.From(existing.Status) == azuresdkhacks.SnapshotStatusArchived {
+				return nil
+			}
+
+			if _, err := client.ArchiveSnapshot(ctx, id.Name); err != nil {
+				return fmt.Errorf("archiving %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func appConfigurationSnapshotStatusRefreshFunc(ctx context.Context, client *azuresdkhacks.DataPlaneClient, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := client.GetSnapshot(ctx, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving Snapshot %q: %+v", name, err)
+		}
+
+		status := pointer.From(snapshot.Status)
+		if status == azuresdkhacks.SnapshotStatusFailed {
+			return snapshot, string(status), fmt.Errorf("Snapshot %q failed to be created", name)
+		}
+
+		return snapshot, string(status), nil
+	}
+}
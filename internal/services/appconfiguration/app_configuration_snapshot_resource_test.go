@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppConfigurationSnapshotResource struct{}
+
+func TestAccAppConfigurationSnapshot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("ready"),
+				check.That(data.ResourceName).Key("items_count").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationSnapshot_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppConfigurationSnapshot_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("items_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t AppConfigurationSnapshotResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseSnapshotID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSnapshot(ctx, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	// archived Snapshots can't be deleted and are instead purged once the retention period has elapsed
+	return utils.Bool(resp.Status == nil || *resp.Status != "archived"), nil
+}
+
+func (t AppConfigurationSnapshotResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appconfig-%d"
+  location = "%s"
+}
+
+data "azurerm_client_config" "test" {
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "App Configuration Data Owner"
+  principal_id         = data.azurerm_client_config.test.object_id
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "testacc-appconf%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+  depends_on = [
+    azurerm_role_assignment.test,
+  ]
+}
+
+resource "azurerm_app_configuration_key" "first" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest-first"
+  label                  = "acctest"
+  value                  = "first"
+}
+
+resource "azurerm_app_configuration_key" "second" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest-second"
+  label                  = "acctest"
+  value                  = "second"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (t AppConfigurationSnapshotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "test" {
+  name                   = "acctest-snapshot-%d"
+  configuration_store_id = azurerm_app_configuration.test.id
+
+  filter {
+    key   = "acctest-first"
+    label = "acctest"
+  }
+
+  depends_on = [
+    azurerm_app_configuration_key.first,
+    azurerm_app_configuration_key.second,
+  ]
+}
+`, t.template(data), data.RandomInteger)
+}
+
+func (t AppConfigurationSnapshotResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "import" {
+  name                   = azurerm_app_configuration_snapshot.test.name
+  configuration_store_id = azurerm_app_configuration_snapshot.test.configuration_store_id
+
+  filter {
+    key   = "acctest-first"
+    label = "acctest"
+  }
+}
+`, t.basic(data))
+}
+
+func (t AppConfigurationSnapshotResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "test" {
+  name                        = "acctest-snapshot-%d"
+  configuration_store_id      = azurerm_app_configuration.test.id
+  composition_type            = "key_label"
+  retention_period_in_seconds = 86400
+
+  filter {
+    key   = "acctest-*"
+    label = "acctest"
+  }
+
+  tags = {
+    environment = "test"
+  }
+
+  depends_on = [
+    azurerm_app_configuration_key.first,
+    azurerm_app_configuration_key.second,
+  ]
+}
+`, t.template(data), data.RandomInteger)
+}
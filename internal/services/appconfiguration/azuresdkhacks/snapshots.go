@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: Snapshots are only available from version `2023-10-01` of the Data Plane API, whereas the SDK
+// used for the other Data Plane resources targets version `1.0` - as such these are implemented here
+// until the SDK is updated.
+
+const snapshotsAPIVersion = "2023-10-01"
+
+type SnapshotStatus string
+
+const (
+	SnapshotStatusArchived     SnapshotStatus = "archived"
+	SnapshotStatusFailed       SnapshotStatus = "failed"
+	SnapshotStatusProvisioning SnapshotStatus = "provisioning"
+	SnapshotStatusReady        SnapshotStatus = "ready"
+)
+
+type SnapshotCompositionType string
+
+const (
+	SnapshotCompositionTypeKey      SnapshotCompositionType = "key"
+	SnapshotCompositionTypeKeyLabel SnapshotCompositionType = "key_label"
+)
+
+func PossibleValuesForSnapshotCompositionType() []string {
+	return []string{
+		string(SnapshotCompositionTypeKey),
+		string(SnapshotCompositionTypeKeyLabel),
+	}
+}
+
+type Snapshot struct {
+	autorest.Response `json:"-"`
+	Name              *string                   `json:"name,omitempty"`
+	Status            *SnapshotStatus           `json:"status,omitempty"`
+	Filters           *[]SnapshotKeyValueFilter `json:"filters,omitempty"`
+	CompositionType   *SnapshotCompositionType  `json:"composition_type,omitempty"`
+	Created           *string                   `json:"created,omitempty"`
+	Expires           *string                   `json:"expires,omitempty"`
+	RetentionPeriod   *int64                    `json:"retention_period,omitempty"`
+	Size              *int64                    `json:"size,omitempty"`
+	ItemsCount        *int64                    `json:"items_count,omitempty"`
+	Tags              map[string]*string        `json:"tags,omitempty"`
+	Etag              *string                   `json:"etag,omitempty"`
+}
+
+type SnapshotKeyValueFilter struct {
+	Key   *string `json:"key,omitempty"`
+	Label *string `json:"label,omitempty"`
+}
+
+type snapshotUpdateParameters struct {
+	Status SnapshotStatus `json:"status"`
+}
+
+// CreateSnapshot starts the creation of a Snapshot, the Snapshot is created asynchronously and has the
+// status `provisioning` until the Key Values matching the filters have been captured.
+func (c DataPlaneClient) CreateSnapshot(ctx context.Context, name string, entity Snapshot) (result Snapshot, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/vnd.microsoft.appconfig.snapshot+json"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.client.Endpoint),
+		autorest.WithPathParameters("/snapshots/{name}", map[string]interface{}{
+			"name": autorest.Encode("path", name),
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": snapshotsAPIVersion,
+		}),
+		autorest.WithJSON(entity)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "CreateSnapshot", nil, "Failure preparing request")
+	}
+
+	resp, err := c.client.Send(req, autorest.DoRetryForStatusCodes(c.client.RetryAttempts, c.client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "CreateSnapshot", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "CreateSnapshot", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (c DataPlaneClient) GetSnapshot(ctx context.Context, name string) (result Snapshot, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.client.Endpoint),
+		autorest.WithPathParameters("/snapshots/{name}", map[string]interface{}{
+			"name": autorest.Encode("path", name),
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": snapshotsAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "GetSnapshot", nil, "Failure preparing request")
+	}
+
+	resp, err := c.client.Send(req, autorest.DoRetryForStatusCodes(c.client.RetryAttempts, c.client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "GetSnapshot", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "GetSnapshot", resp, "Failure responding to request")
+	}
+	return
+}
+
+// ArchiveSnapshot archives the Snapshot - Snapshots cannot be deleted, instead once archived they're
+// purged by the service once their retention period has elapsed.
+func (c DataPlaneClient) ArchiveSnapshot(ctx context.Context, name string) (result Snapshot, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/merge-patch+json"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.client.Endpoint),
+		autorest.WithPathParameters("/snapshots/{name}", map[string]interface{}{
+			"name": autorest.Encode("path", name),
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": snapshotsAPIVersion,
+		}),
+		autorest.WithJSON(snapshotUpdateParameters{Status: SnapshotStatusArchived})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "ArchiveSnapshot", nil, "Failure preparing request")
+	}
+
+	resp, err := c.client.Send(req, autorest.DoRetryForStatusCodes(c.client.RetryAttempts, c.client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "ArchiveSnapshot", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "ArchiveSnapshot", resp, "Failure responding to request")
+	}
+	return
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	KeysImportFormatJson       = "json"
+	KeysImportFormatProperties = "properties"
+	KeysImportFormatYaml       = "yaml"
+)
+
+func possibleValuesForKeysImportFormat() []string {
+	return []string{
+		KeysImportFormatJson,
+		KeysImportFormatProperties,
+		KeysImportFormatYaml,
+	}
+}
+
+// possibleValuesForKeysImportSeparator returns the separators which can be used to flatten hierarchical
+// documents, these match the separators supported by the Azure CLI
+func possibleValuesForKeysImportSeparator() []string {
+	return []string{".", ",", ";", "-", "_", "__", "/", ":"}
+}
+
+// parseKeysImportDocument parses the JSON, YAML or Java Properties document into a map of Key to Value.
+//
+// Hierarchical (JSON and YAML) documents are flattened using the separator when one is specified, with the
+// index of an item within a list used as its key - when no separator is specified nested objects and lists
+// are stored as JSON.
+func parseKeysImportDocument(format, content, separator string) (map[string]string, error) {
+	switch format {
+	case KeysImportFormatJson:
+		var document interface{}
+		if err := json.Unmarshal([]byte(content), &document); err != nil {
+			return nil, fmt.Errorf("parsing JSON document: %+v", err)
+		}
+		return flattenKeysImportDocument(document, separator)
+
+	case KeysImportFormatYaml:
+		var document interface{}
+		if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+			return nil, fmt.Errorf("parsing YAML document: %+v", err)
+		}
+		return flattenKeysImportDocument(document, separator)
+
+	case KeysImportFormatProperties:
+		return parseKeysImportProperties(content)
+	}
+
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+func flattenKeysImportDocument(document interface{}, separator string) (map[string]string, error) {
+	if document == nil {
+		return map[string]string{}, nil
+	}
+
+	root, ok := document.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected the document to contain an object but got %T", document)
+	}
+
+	result := make(map[string]string)
+	for key, value := range root {
+		if err := flattenKeysImportValue(result, key, value, separator); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func flattenKeysImportValue(result map[string]string, key string, value interface{}, separator string) error {
+	if separator != "" {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for childKey, childValue := range v {
+				if err := flattenKeysImportValue(result, key+separator+childKey, childValue, separator); err != nil {
+					return err
+				}
+			}
+			return nil
+
+		case []interface{}:
+			for i, childValue := range v {
+				if err := flattenKeysImportValue(result, key+separator+strconv.Itoa(i), childValue, separator); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	if v, ok := value.(string); ok {
+		result[key] = v
+		return nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("encoding the value of %q: %+v", key, err)
+	}
+	result[key] = string(encoded)
+	return nil
+}
+
+// parseKeysImportProperties parses a Java Properties document, supporting `=` and `:` delimiters,
+// `#` and `!` comments and values continued onto the next line with a trailing `\`
+func parseKeysImportProperties(content string) (map[string]string, error) {
+	result := make(map[string]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	logicalLine := ""
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if logicalLine == "" && (line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!")) {
+			continue
+		}
+
+		if strings.HasSuffix(line, `\`) && !strings.HasSuffix(line, `\\`) {
+			logicalLine += strings.TrimSuffix(line, `\`)
+			continue
+		}
+		logicalLine += line
+
+		index := strings.IndexAny(logicalLine, "=:")
+		if index <= 0 {
+			return nil, fmt.Errorf("parsing properties document: expected a line in the format `key=value` but got %q", logicalLine)
+		}
+
+		key := strings.TrimSpace(logicalLine[:index])
+		result[key] = strings.TrimSpace(logicalLine[index+1:])
+		logicalLine = ""
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading properties document: %+v", err)
+	}
+
+	if logicalLine != "" {
+		return nil, fmt.Errorf("parsing properties document: unterminated line continuation %q", logicalLine)
+	}
+
+	return result, nil
+}
+
+// keysImportChanges contains the Keys which need to be written to and removed from the Configuration Store
+type keysImportChanges struct {
+	Upsert []string
+	Delete []string
+}
+
+// diffKeysImport compares the desired Key Values against those present in the Configuration Store, only Keys
+// which are missing or have a different value are written and only Keys previously imported are removed.
+func diffKeysImport(desired map[string]string, existing map[string]string, previouslyImported []string) keysImportChanges {
+	changes := keysImportChanges{
+		Upsert: make([]string, 0),
+		Delete: make([]string, 0),
+	}
+
+	for key, value := range desired {
+		if existingValue, ok := existing[key]; !ok || existingValue != value {
+			changes.Upsert = append(changes.Upsert, key)
+		}
+	}
+
+	for _, key := range previouslyImported {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if _, ok := existing[key]; ok {
+			changes.Delete = append(changes.Delete, key)
+		}
+	}
+
+	sort.Strings(changes.Upsert)
+	sort.Strings(changes.Delete)
+
+	return changes
+}
+
+// keysImportBatches splits the keys into batches of at most batchSize items
+func keysImportBatches(keys []string, batchSize int) [][]string {
+	batches := make([][]string, 0)
+	for start := 0; start < len(keys); start += batchSize {
+		end := start + batchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		batches = append(batches, keys[start:end])
+	}
+	return batches
+}
+
+// escapeKeysImportFilter escapes the characters reserved by the Key filter of the Data Plane API
+func escapeKeysImportFilter(input string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `*`, `\*`, `,`, `\,`)
+	return replacer.Replace(input)
+}
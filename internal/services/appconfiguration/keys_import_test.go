@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseKeysImportDocument(t *testing.T) {
+	testData := []struct {
+		Name      string
+		Format    string
+		Content   string
+		Separator string
+		Expected  map[string]string
+		Error     bool
+	}{
+		{
+			Name:     "empty json object",
+			Format:   KeysImportFormatJson,
+			Content:  `{}`,
+			Expected: map[string]string{},
+		},
+		{
+			Name:    "json array",
+			Format:  KeysImportFormatJson,
+			Content: `["a", "b"]`,
+			Error:   true,
+		},
+		{
+			Name:    "invalid json",
+			Format:  KeysImportFormatJson,
+			Content: `{"a":`,
+			Error:   true,
+		},
+		{
+			Name:    "json without separator",
+			Format:  KeysImportFormatJson,
+			Content: `{"name": "example", "count": 3, "enabled": true, "nested": {"key": "value"}}`,
+			Expected: map[string]string{
+				"name":    "example",
+				"count":   "3",
+				"enabled": "true",
+				"nested":  `{"key":"value"}`,
+			},
+		},
+		{
+			Name:      "json with separator",
+			Format:    KeysImportFormatJson,
+			Content:   `{"app": {"name": "example", "regions": ["westeurope", "northeurope"], "empty": null}}`,
+			Separator: ":",
+			Expected: map[string]string{
+				"app:name":      "example",
+				"app:regions:0": "westeurope",
+				"app:regions:1": "northeurope",
+				"app:empty":     "null",
+			},
+		},
+		{
+			Name:      "yaml with separator",
+			Format:    KeysImportFormatYaml,
+			Content:   "app:\n  name: example\n  replicas: 2\n",
+			Separator: ".",
+			Expected: map[string]string{
+				"app.name":     "example",
+				"app.replicas": "2",
+			},
+		},
+		{
+			Name:     "empty yaml",
+			Format:   KeysImportFormatYaml,
+			Content:  "",
+			Expected: map[string]string{},
+		},
+		{
+			Name:    "yaml list",
+			Format:  KeysImportFormatYaml,
+			Content: "- a\n- b\n",
+			Error:   true,
+		},
+		{
+			Name:    "properties",
+			Format:  KeysImportFormatProperties,
+			Content: "# comment\n! another comment\n\nname=example\nurl: https://example.com\nlong = first \\\n  second\n",
+			Expected: map[string]string{
+				"name": "example",
+				"url":  "https://example.com",
+				"long": "first second",
+			},
+		},
+		{
+			Name:    "properties without delimiter",
+			Format:  KeysImportFormatProperties,
+			Content: "name\n",
+			Error:   true,
+		},
+		{
+			Name:    "properties with unterminated continuation",
+			Format:  KeysImportFormatProperties,
+			Content: "name=first \\\n",
+			Error:   true,
+		},
+		{
+			Name:    "unsupported format",
+			Format:  "toml",
+			Content: "name = \"example\"",
+			Error:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := parseKeysImportDocument(v.Format, v.Content, v.Separator)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("expected no error for %q but got: %+v", v.Name, err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error for %q but didn't get one", v.Name)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v for %q but got %+v", v.Expected, v.Name, actual)
+		}
+	}
+}
+
+func TestDiffKeysImport(t *testing.T) {
+	testData := []struct {
+		Name               string
+		Desired            map[string]string
+		Existing           map[string]string
+		PreviouslyImported []string
+		Expected           keysImportChanges
+	}{
+		{
+			Name:     "nothing to do",
+			Desired:  map[string]string{},
+			Existing: map[string]string{},
+			Expected: keysImportChanges{
+				Upsert: []string{},
+				Delete: []string{},
+			},
+		},
+		{
+			Name: "new and changed keys are written",
+			Desired: map[string]string{
+				"c": "3",
+				"a": "1",
+				"b": "2",
+			},
+			Existing: map[string]string{
+				"a": "1",
+				"b": "old",
+			},
+			Expected: keysImportChanges{
+				Upsert: []string{"b", "c"},
+				Delete: []string{},
+			},
+		},
+		{
+			Name: "only previously imported keys are removed",
+			Desired: map[string]string{
+				"a": "1",
+			},
+			Existing: map[string]string{
+				"a":         "1",
+				"b":         "2",
+				"unmanaged": "3",
+			},
+			PreviouslyImported: []string{"a", "b", "gone"},
+			Expected: keysImportChanges{
+				Upsert: []string{},
+				Delete: []string{"b"},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := diffKeysImport(v.Desired, v.Existing, v.PreviouslyImported)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v for %q but got %+v", v.Expected, v.Name, actual)
+		}
+	}
+}
+
+func TestKeysImportBatches(t *testing.T) {
+	testData := []struct {
+		Keys      []string
+		BatchSize int
+		Expected  [][]string
+	}{
+		{
+			Keys:      []string{},
+			BatchSize: 2,
+			Expected:  [][]string{},
+		},
+		{
+			Keys:      []string{"a", "b"},
+			BatchSize: 2,
+			Expected:  [][]string{{"a", "b"}},
+		},
+		{
+			Keys:      []string{"a", "b", "c", "d", "e"},
+			BatchSize: 2,
+			Expected:  [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.Keys)
+
+		actual := keysImportBatches(v.Keys, v.BatchSize)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = KeysImportId{}
+
+// KeysImportId identifies the set of Key Values sharing a Key Prefix and Label within a Configuration Store
+type KeysImportId struct {
+	ConfigurationStoreEndpoint string
+	KeyPrefix                  string
+	Label                      string
+}
+
+func NewKeysImportID(configurationStoreEndpoint, keyPrefix, label string) (*KeysImportId, error) {
+	// configurationStoreEndpoint example: https://testappconf1.azconfig.io
+	configurationURL, err := url.ParseRequestURI(configurationStoreEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", configurationStoreEndpoint, err)
+	}
+
+	return &KeysImportId{
+		ConfigurationStoreEndpoint: fmt.Sprintf("%s://%s", configurationURL.Scheme, configurationURL.Host),
+		KeyPrefix:                  keyPrefix,
+		Label:                      label,
+	}, nil
+}
+
+func (id KeysImportId) ID() string {
+	// example with label: https://testappconf1.azconfig.io/kv?key=app1%2F%2A&label=testLabel
+	// example without prefix or label: https://testappconf1.azconfig.io/kv?key=%2A&label=
+	baseURL, _ := url.ParseRequestURI(id.ConfigurationStoreEndpoint)
+	u := &url.URL{
+		Scheme:   baseURL.Scheme,
+		Host:     baseURL.Host,
+		Path:     "kv",
+		RawQuery: fmt.Sprintf("key=%s&label=%s", url.QueryEscape(id.KeyPrefix+"*"), url.QueryEscape(id.Label)),
+	}
+
+	return u.String()
+}
+
+func (id KeysImportId) String() string {
+	components := []string{
+		fmt.Sprintf("Configuration Store Endpoint %q", id.ConfigurationStoreEndpoint),
+		fmt.Sprintf("Key Prefix %q", id.KeyPrefix),
+		fmt.Sprintf("Label %q", id.Label),
+	}
+	return fmt.Sprintf("AppConfiguration Keys Import %s", strings.Join(components, " / "))
+}
+
+// ParseKeysImportID parses an App Configuration Keys Import ID
+func ParseKeysImportID(input string) (*KeysImportId, error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse Azure App Configuration Keys Import ID %q: %s", input, err)
+	}
+
+	if strings.Trim(idURL.EscapedPath(), "/") != "kv" {
+		return nil, fmt.Errorf("Azure App Configuration Keys Import ID should have the path `kv`, got %q", idURL.EscapedPath())
+	}
+
+	queryMap := idURL.Query()
+	rawKey, hasKey := queryMap["key"]
+	rawLabel, hasLabel := queryMap["label"]
+	if len(queryMap) != 2 || !hasKey || !hasLabel || len(rawKey) != 1 || len(rawLabel) != 1 {
+		return nil, fmt.Errorf("exactly one 'key' and one 'label' must be defined in Azure App Configuration Keys Import URL query, but got %q", idURL.RawQuery)
+	}
+
+	if !strings.HasSuffix(rawKey[0], "*") {
+		return nil, fmt.Errorf("the 'key' of an Azure App Configuration Keys Import URL query must end with `*`, got %q", rawKey[0])
+	}
+
+	return &KeysImportId{
+		ConfigurationStoreEndpoint: fmt.Sprintf("%s://%s", idURL.Scheme, idURL.Host),
+		KeyPrefix:                  strings.TrimSuffix(rawKey[0], "*"),
+		Label:                      rawLabel[0],
+	}, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "testing"
+
+func TestNewKeysImportID(t *testing.T) {
+	cases := []struct {
+		ConfigurationStoreEndpoint string
+		KeyPrefix                  string
+		Label                      string
+		Expected                   string
+		ExpectError                bool
+	}{
+		{
+			ConfigurationStoreEndpoint: "",
+			ExpectError:                true,
+		},
+		{
+			ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+			Expected:                   "https://testappconf1.azconfig.io/kv?key=%2A&label=",
+		},
+		{
+			ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+			KeyPrefix:                  "app1/",
+			Label:                      "prod",
+			Expected:                   "https://testappconf1.azconfig.io/kv?key=app1%2F%2A&label=prod",
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := NewKeysImportID(tc.ConfigurationStoreEndpoint, tc.KeyPrefix, tc.Label)
+		if err != nil {
+			if !tc.ExpectError {
+				t.Fatalf("Got error for New Keys Import ID (BaseURL:%q, KeyPrefix:%q, Label:%q): %+v", tc.ConfigurationStoreEndpoint, tc.KeyPrefix, tc.Label, err)
+			}
+			continue
+		}
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for New Keys Import ID (BaseURL:%q, KeyPrefix:%q, Label:%q) but didn't get one", tc.ConfigurationStoreEndpoint, tc.KeyPrefix, tc.Label)
+		}
+		if id.ID() != tc.Expected {
+			t.Fatalf("Expected id for (BaseURL:%q, KeyPrefix:%q, Label:%q) to be %q, got %q", tc.ConfigurationStoreEndpoint, tc.KeyPrefix, tc.Label, tc.Expected, id.ID())
+		}
+	}
+}
+
+func TestParseKeysImportID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    KeysImportId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kv",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kv?key=app1%2F&label=",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kv/testKey?label=",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kv?key=%2A&label=a&label=b",
+			ExpectError: true,
+		},
+		{
+			Input: "https://testappconf1.azconfig.io/kv?key=%2A&label=",
+			Expected: KeysImportId{
+				ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+			},
+		},
+		{
+			Input: "https://testappconf1.azconfig.io/kv?key=app1%2F%2A&label=prod",
+			Expected: KeysImportId{
+				ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+				KeyPrefix:                  "app1/",
+				Label:                      "prod",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := ParseKeysImportID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID %q: %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID %q but didn't get one", tc.Input)
+		}
+
+		if *id != tc.Expected {
+			t.Fatalf("Expected %+v, got %+v for ID %q", tc.Expected, *id, tc.Input)
+		}
+	}
+}
@@ -30,6 +30,9 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		KeyResource{},
 		FeatureResource{},
+		KeysImportResource{},
+		ReplicaResource{},
+		SnapshotResource{},
 	}
 }
 
@@ -16,15 +16,17 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type FunctionAppActiveSlotResource struct{}
 
 type FunctionAppActiveSlotModel struct {
-	SlotID              string `tfschema:"slot_id"`
-	OverwriteNetworking bool   `tfschema:"overwrite_network_config"` // Note: This setting controls the ambiguously named `PreserveVnet`
-	LastSwap            string `tfschema:"last_successful_swap"`
+	SlotID              string                        `tfschema:"slot_id"`
+	OverwriteNetworking bool                          `tfschema:"overwrite_network_config"` // Note: This setting controls the ambiguously named `PreserveVnet`
+	LastSwap            string                        `tfschema:"last_successful_swap"`
+	SwapWithPreview     []helpers.SlotSwapWithPreview `tfschema:"swap_with_preview"`
 }
 
 var _ sdk.ResourceWithUpdate = FunctionAppActiveSlotResource{}
@@ -57,6 +59,8 @@ func (r FunctionAppActiveSlotResource) Arguments() map[string]*pluginsdk.Schema
 			Description: "The swap action should overwrite the Production slot's network configuration with the configuration from this slot. Defaults to `true`.",
 			ForceNew:    true,
 		},
+
+		"swap_with_preview": helpers.SlotSwapWithPreviewSchema(),
 	}
 }
 
@@ -103,8 +107,14 @@ func (r FunctionAppActiveSlotResource) Create() sdk.ResourceFunc {
 			locks.ByID(appId.ID())
 			defer locks.UnlockByID(appId.ID())
 
-			if _, err := client.SwapSlotWithProduction(ctx, appId, csmSlotEntity); err != nil {
-				return fmt.Errorf("making %s the active slot: %+v", id.SlotName, err)
+			if len(activeSlot.SwapWithPreview) > 0 {
+				if err := helpers.SwapSlotWithProductionWithPreview(ctx, client, appId, *id, csmSlotEntity, activeSlot.SwapWithPreview[0]); err != nil {
+					return fmt.Errorf("making %s the active slot: %+v", id.SlotName, err)
+				}
+			} else {
+				if _, err := client.SwapSlotWithProduction(ctx, appId, csmSlotEntity); err != nil {
+					return fmt.Errorf("making %s the active slot: %+v", id.SlotName, err)
+				}
 			}
 
 			pollerType := custompollers.NewAppServiceActiveSlotPoller(client, appId, *id)
@@ -158,6 +168,13 @@ func (r FunctionAppActiveSlotResource) Read() sdk.ResourceFunc {
 			}
 			activeSlot.OverwriteNetworking = overwriteNetworking
 
+			// `swap_with_preview` only configures how the swap is performed and is not returned by the service
+			var state FunctionAppActiveSlotModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			activeSlot.SwapWithPreview = state.SwapWithPreview
+
 			return metadata.Encode(&activeSlot)
 		},
 	}
@@ -179,5 +196,15 @@ func (r FunctionAppActiveSlotResource) Delete() sdk.ResourceFunc {
 // Terraform deleting and recreating this resource, which may cause concern that the operation is somehow destructive.
 
 func (r FunctionAppActiveSlotResource) Update() sdk.ResourceFunc {
-	return r.Create()
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// changes to `swap_with_preview` only apply to the next swap, so they must not trigger a swap themselves
+			if !metadata.ResourceData.HasChange("slot_id") {
+				return nil
+			}
+
+			return r.Create().Func(ctx, metadata)
+		},
+	}
 }
@@ -93,6 +93,21 @@ func TestAccFunctionAppActiveSlot_linuxUpdate(t *testing.T) {
 	})
 }
 
+func TestAccFunctionAppActiveSlot_swapWithPreviewLinux(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_active_slot", "test")
+	r := FunctionApActiveSlotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.swapWithPreviewLinux(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("swap_with_preview"),
+	})
+}
+
 func (r FunctionApActiveSlotResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := commonids.ParseFunctionAppID(state.ID)
 	if err != nil {
@@ -145,6 +160,27 @@ resource "azurerm_function_app_active_slot" "test" {
 `, r.templateLinux(data))
 }
 
+func (r FunctionApActiveSlotResource) swapWithPreviewLinux(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_function_app_active_slot" "test" {
+  slot_id = azurerm_linux_function_app_slot.test.id
+
+  swap_with_preview {
+    health_probe {
+      path = "/"
+    }
+  }
+}
+
+`, r.templateLinux(data))
+}
+
 func (r FunctionApActiveSlotResource) windowsUpdate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SlotSwapWithPreview struct {
+	HealthProbes         []SlotSwapHealthProbe `tfschema:"health_probe"`
+	ProbeTimeoutMinutes  int64                 `tfschema:"probe_timeout_in_minutes"`
+	ProbeIntervalSeconds int64                 `tfschema:"probe_interval_in_seconds"`
+	HealthyThreshold     int64                 `tfschema:"healthy_threshold"`
+}
+
+type SlotSwapHealthProbe struct {
+	Path                string  `tfschema:"path"`
+	ExpectedStatusCodes []int64 `tfschema:"expected_status_codes"`
+}
+
+func SlotSwapWithPreviewSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Swap in two phases: the `Production` slot configuration is applied to the slot first, and the swap is only completed once all health probes pass against the slot. The swap is cancelled if the probes do not pass in time.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"health_probe": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"path": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "`path` must start with `/`"),
+								Description:  "The path on the slot's default hostname to send a HTTPS `GET` request to, e.g. `/healthz`.",
+							},
+
+							"expected_status_codes": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeInt,
+									ValidateFunc: validation.IntBetween(100, 599),
+								},
+								Description: "The HTTP status codes which mark the probe as healthy. Defaults to `[200]`.",
+							},
+						},
+					},
+				},
+
+				"probe_timeout_in_minutes": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      10,
+					ValidateFunc: validation.IntBetween(1, 25),
+					Description:  "How long to wait for the health probes to pass before the swap is cancelled. Defaults to `10`.",
+				},
+
+				"probe_interval_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      15,
+					ValidateFunc: validation.IntBetween(5, 300),
+					Description:  "The interval between two rounds of health probes. Defaults to `15`.",
+				},
+
+				"healthy_threshold": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntBetween(1, 10),
+					Description:  "The number of consecutive rounds in which all health probes must pass before the swap is completed. Defaults to `1`.",
+				},
+			},
+		},
+	}
+}
+
+// SwapSlotWithProductionWithPreview performs a multi-phase swap of the slot into `Production`. The sticky settings of
+// `Production` are applied to the slot first (the preview phase), after which the health probes are run against the
+// slot's default hostname. The swap is only completed once the probes pass, otherwise it is cancelled which resets the
+// slot to its own configuration. The configuration of `Production` is not changed until the swap is completed.
+func SwapSlotWithProductionWithPreview(ctx context.Context, client *webapps.WebAppsClient, appId commonids.AppServiceId, slotId webapps.SlotId, csmSlotEntity webapps.CsmSlotEntity, config SlotSwapWithPreview) error {
+	preview := webapps.CsmSlotEntity{
+		TargetSlot:   "production",
+		PreserveVnet: csmSlotEntity.PreserveVnet,
+	}
+	if _, err := client.ApplySlotConfigurationSlot(ctx, slotId, preview); err != nil {
+		return fmt.Errorf("applying the production configuration to %s to start the swap preview: %+v", slotId, err)
+	}
+
+	if err := checkSlotSwapPreview(ctx, client, appId, slotId, config); err != nil {
+		log.Printf("[DEBUG] swap preview for %s failed, cancelling the swap", slotId)
+		if _, resetErr := client.ResetSlotConfigurationSlot(ctx, slotId); resetErr != nil {
+			return fmt.Errorf("cancelling the swap of %s after the swap preview failed with %+v: %+v", slotId, err, resetErr)
+		}
+		return fmt.Errorf("swap of %s was cancelled: %+v", slotId, err)
+	}
+
+	if _, err := client.SwapSlotWithProduction(ctx, appId, csmSlotEntity); err != nil {
+		if _, resetErr := client.ResetSlotConfigurationSlot(ctx, slotId); resetErr != nil {
+			return fmt.Errorf("cancelling the swap of %s after completing the swap failed with %+v: %+v", slotId, err, resetErr)
+		}
+		return fmt.Errorf("completing the swap of %s with production: %+v", slotId, err)
+	}
+
+	return nil
+}
+
+func checkSlotSwapPreview(ctx context.Context, client *webapps.WebAppsClient, appId commonids.AppServiceId, slotId webapps.SlotId, config SlotSwapWithPreview) error {
+	interval := time.Duration(config.ProbeIntervalSeconds) * time.Second
+	deadline := time.Now().Add(time.Duration(config.ProbeTimeoutMinutes) * time.Minute)
+	// leave enough time to cancel or complete the swap once the probes have finished
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Add(-5*time.Minute).Before(deadline) {
+		deadline = ctxDeadline.Add(-5 * time.Minute)
+	}
+
+	// the slot restarts with the sticky settings of `Production` once the preview has been applied, so the probes are
+	// only meaningful once those settings are in place on the slot
+	stickyWait := &pluginsdk.StateChangeConf{
+		Pending:      []string{"Pending"},
+		Target:       []string{"Applied"},
+		PollInterval: interval,
+		Timeout:      time.Until(deadline),
+		Refresh:      slotSwapPreviewStickySettingsRefreshFunc(ctx, client, appId, slotId),
+	}
+	if _, err := stickyWait.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the sticky settings of production to be applied to %s: %+v", slotId, err)
+	}
+
+	slot, err := client.GetSlot(ctx, slotId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", slotId, err)
+	}
+	if slot.Model == nil || slot.Model.Properties == nil || pointer.From(slot.Model.Properties.DefaultHostName) == "" {
+		return fmt.Errorf("could not determine the default hostname of %s", slotId)
+	}
+	hostName := *slot.Model.Properties.DefaultHostName
+
+	healthyThreshold := int(config.HealthyThreshold)
+	if healthyThreshold < 1 {
+		healthyThreshold = 1
+	}
+
+	probeWait := &pluginsdk.StateChangeConf{
+		Pending:                   []string{"Unhealthy"},
+		Target:                    []string{"Healthy"},
+		PollInterval:              interval,
+		Timeout:                   time.Until(deadline),
+		ContinuousTargetOccurence: healthyThreshold,
+		Refresh:                   slotSwapHealthProbesRefreshFunc(ctx, hostName, client.Client.UserAgent, config.HealthProbes),
+	}
+	if _, err := probeWait.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the health probes of %s to pass: %+v", slotId, err)
+	}
+
+	return nil
+}
+
+func slotSwapPreviewStickySettingsRefreshFunc(ctx context.Context, client *webapps.WebAppsClient, appId commonids.AppServiceId, slotId webapps.SlotId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		names, err := client.ListSlotConfigurationNames(ctx, appId)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving the sticky settings for %s: %+v", appId, err)
+		}
+		if names.Model == nil || names.Model.Properties == nil {
+			return "Applied", "Applied", nil
+		}
+
+		if appSettingNames := pointer.From(names.Model.Properties.AppSettingNames); len(appSettingNames) > 0 {
+			production, err := client.ListApplicationSettings(ctx, appId)
+			if err != nil {
+				return nil, "", fmt.Errorf("listing the app settings for %s: %+v", appId, err)
+			}
+			slot, err := client.ListApplicationSettingsSlot(ctx, slotId)
+			if err != nil {
+				return nil, "", fmt.Errorf("listing the app settings for %s: %+v", slotId, err)
+			}
+
+			productionSettings := make(map[string]string)
+			if production.Model != nil {
+				productionSettings = pointer.From(production.Model.Properties)
+			}
+			slotSettings := make(map[string]string)
+			if slot.Model != nil {
+				slotSettings = pointer.From(slot.Model.Properties)
+			}
+
+			for _, name := range appSettingNames {
+				if productionSettings[name] != slotSettings[name] {
+					log.Printf("[DEBUG] sticky app setting %q has not been applied to %s yet", name, slotId)
+					return "Pending", "Pending", nil
+				}
+			}
+		}
+
+		if connectionStringNames := pointer.From(names.Model.Properties.ConnectionStringNames); len(connectionStringNames) > 0 {
+			production, err := client.ListConnectionStrings(ctx, appId)
+			if err != nil {
+				return nil, "", fmt.Errorf("listing the connection strings for %s: %+v", appId, err)
+			}
+			slot, err := client.ListConnectionStringsSlot(ctx, slotId)
+			if err != nil {
+				return nil, "", fmt.Errorf("listing the connection strings for %s: %+v", slotId, err)
+			}
+
+			productionConnectionStrings := make(map[string]webapps.ConnStringValueTypePair)
+			if production.Model != nil {
+				productionConnectionStrings = pointer.From(production.Model.Properties)
+			}
+			slotConnectionStrings := make(map[string]webapps.ConnStringValueTypePair)
+			if slot.Model != nil {
+				slotConnectionStrings = pointer.From(slot.Model.Properties)
+			}
+
+			for _, name := range connectionStringNames {
+				if productionConnectionStrings[name] != slotConnectionStrings[name] {
+					log.Printf("[DEBUG] sticky connection string %q has not been applied to %s yet", name, slotId)
+					return "Pending", "Pending", nil
+				}
+			}
+		}
+
+		return "Applied", "Applied", nil
+	}
+}
+
+func slotSwapHealthProbesRefreshFunc(ctx context.Context, hostName string, userAgent string, probes []SlotSwapHealthProbe) pluginsdk.StateRefreshFunc {
+	httpClient := &http.Client{
+		Timeout: 30 * time.Second,
+	}
+
+	return func() (interface{}, string, error) {
+		for _, probe := range probes {
+			endpoint := fmt.Sprintf("https://%s/%s", hostName, strings.TrimPrefix(probe.Path, "/"))
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+			if err != nil {
+				return nil, "", fmt.Errorf("building health probe request for %q: %+v", endpoint, err)
+			}
+			req.Header.Set("User-Agent", userAgent)
+
+			resp, err := httpClient.Do(req)
+			if err != nil {
+				// the slot may still be restarting, so connection errors are treated as unhealthy rather than fatal
+				log.Printf("[DEBUG] health probe %q failed: %+v", endpoint, err)
+				return "Unhealthy", "Unhealthy", nil
+			}
+			resp.Body.Close()
+
+			if !slotSwapHealthProbeStatusCodeExpected(resp.StatusCode, probe.ExpectedStatusCodes) {
+				log.Printf("[DEBUG] health probe %q returned unexpected status %d", endpoint, resp.StatusCode)
+				return "Unhealthy", "Unhealthy", nil
+			}
+		}
+
+		return "Healthy", "Healthy", nil
+	}
+}
+
+func slotSwapHealthProbeStatusCodeExpected(statusCode int, expected []int64) bool {
+	if len(expected) == 0 {
+		return statusCode == http.StatusOK
+	}
+
+	for _, v := range expected {
+		if int64(statusCode) == v {
+			return true
+		}
+	}
+
+	return false
+}
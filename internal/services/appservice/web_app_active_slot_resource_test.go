@@ -6,6 +6,7 @@ package appservice_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -93,6 +94,39 @@ func TestWebAppAccActiveSlot_linuxUpdate(t *testing.T) {
 	})
 }
 
+func TestWebAppAccActiveSlot_swapWithPreviewLinux(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_active_slot", "test")
+	r := WebAppActiveSlotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.swapWithPreviewLinux(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("swap_with_preview"),
+	})
+}
+
+func TestWebAppAccActiveSlot_swapWithPreviewFailedProbeLinux(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_active_slot", "test")
+	r := WebAppActiveSlotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.swapWithPreviewFailedProbeLinux(data),
+			ExpectError: regexp.MustCompile("swap of .* was cancelled"),
+		},
+		{
+			// the sticky settings of production (and the settings of the slot) must be unchanged once the swap has
+			// been cancelled, so there should be no changes to apply
+			Config:   r.templateStickySettingsLinux(data),
+			PlanOnly: true,
+		},
+	})
+}
+
 func (r WebAppActiveSlotResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := commonids.ParseWebAppID(state.ID)
 	if err != nil {
@@ -144,6 +178,52 @@ resource "azurerm_web_app_active_slot" "test" {
 `, r.templateLinux(data))
 }
 
+func (r WebAppActiveSlotResource) swapWithPreviewLinux(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_active_slot" "test" {
+  slot_id = azurerm_linux_web_app_slot.test.id
+
+  swap_with_preview {
+    health_probe {
+      path                  = "/"
+      expected_status_codes = [200, 204]
+    }
+
+    probe_timeout_in_minutes  = 15
+    probe_interval_in_seconds = 10
+    healthy_threshold         = 2
+  }
+}
+
+`, r.templateLinux(data))
+}
+
+func (r WebAppActiveSlotResource) swapWithPreviewFailedProbeLinux(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_app_active_slot" "test" {
+  slot_id = azurerm_linux_web_app_slot.test.id
+
+  swap_with_preview {
+    health_probe {
+      path                  = "/"
+      expected_status_codes = [599]
+    }
+
+    probe_timeout_in_minutes  = 1
+    probe_interval_in_seconds = 10
+  }
+}
+`, r.templateStickySettingsLinux(data))
+}
+
 func (r WebAppActiveSlotResource) windowsUpdate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -221,6 +301,68 @@ resource "azurerm_linux_web_app_slot" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (WebAppActiveSlotResource) templateStickySettingsLinux(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-WAS-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "S1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  app_settings = {
+    "STICKY_SETTING" = "production"
+  }
+
+  connection_string {
+    name  = "StickyConnection"
+    type  = "Custom"
+    value = "production"
+  }
+
+  sticky_settings {
+    app_setting_names       = ["STICKY_SETTING"]
+    connection_string_names = ["StickyConnection"]
+  }
+
+  site_config {}
+}
+
+resource "azurerm_linux_web_app_slot" "test" {
+  name           = "acctestWAS-%[1]d"
+  app_service_id = azurerm_linux_web_app.test.id
+
+  app_settings = {
+    "STICKY_SETTING" = "slot"
+  }
+
+  connection_string {
+    name  = "StickyConnection"
+    type  = "Custom"
+    value = "slot"
+  }
+
+  site_config {}
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (WebAppActiveSlotResource) templateWindows(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
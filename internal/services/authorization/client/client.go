@@ -4,6 +4,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization" // nolint: staticcheck // nolint: staticcheck
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleeligibilityschedules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-05-01-preview/roledefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients/graph"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -30,6 +32,8 @@ type Client struct {
 	RoleEligibilitySchedulesClient         *roleeligibilityschedules.RoleEligibilitySchedulesClient
 	ScopedRoleAssignmentsClient            *roleassignments.RoleAssignmentsClient
 	ScopedRoleDefinitionsClient            *roledefinitions.RoleDefinitionsClient
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -95,5 +99,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		RoleEligibilitySchedulesClient:         roleEligibilitySchedulesClient,
 		ScopedRoleAssignmentsClient:            scopedRoleAssignmentsClient,
 		ScopedRoleDefinitionsClient:            scopedRoleDefinitionsClient,
+
+		options: o,
 	}, nil
 }
+
+// ExistingPrincipalIds returns the (lower-cased) Object IDs from `principalIds` for the Principals which still exist
+// within Microsoft Graph
+func (c Client) ExistingPrincipalIds(ctx context.Context, principalIds []string) (map[string]struct{}, error) {
+	authorizer, err := c.options.Authorizers.AuthorizerFunc(c.options.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, fmt.Errorf("building authorizer for Microsoft Graph: %+v", err)
+	}
+
+	return graph.ExistingDirectoryObjectIDs(ctx, authorizer, c.options.Environment, principalIds)
+}
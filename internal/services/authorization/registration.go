@@ -11,8 +11,10 @@ import (
 type Registration struct{}
 
 var (
-	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
-	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.TypedServiceRegistrationWithAGitHubLabel            = Registration{}
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel          = Registration{}
+	_ sdk.UntypedServiceRegistrationWithSubscriptionTargeting = Registration{}
+	_ sdk.UntypedServiceRegistrationWithSweepers              = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -45,6 +47,26 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	}
 }
 
+// Sweepers returns a list of Sweepers which remove any resources leaked by the Acceptance Tests
+func (r Registration) Sweepers() []sdk.Sweeper {
+	return []sdk.Sweeper{
+		{
+			// Role Assignments scoped to a Resource Group are removed along with the Resource Group
+			Name:         "azurerm_role_assignment",
+			Dependencies: []string{"azurerm_resource_group"},
+			Func:         sweepRoleAssignments,
+		},
+	}
+}
+
+// SubscriptionTargetedResources returns the Resources which use the clients for the Subscription within the Resource ID
+func (r Registration) SubscriptionTargetedResources() map[string]sdk.CreateSubscriptionIdFunc {
+	return map[string]sdk.CreateSubscriptionIdFunc{
+		// Role Assignments are commonly managed within the scope of another Landing Zone
+		"azurerm_role_assignment": roleAssignmentCreateSubscriptionId,
+	}
+}
+
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		RoleDefinitionDataSource{},
@@ -154,6 +154,13 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 	}
 }
 
+// roleAssignmentCreateSubscriptionId returns the Subscription which the `scope` of the Role Assignment exists within,
+// or an empty string when the Role Assignment is scoped to a Management Group (or the Tenant)
+func roleAssignmentCreateSubscriptionId(d *pluginsdk.ResourceData) (string, error) {
+	subscriptionId, _ := clients.SubscriptionIdFromResourceId(d.Get("scope").(string))
+	return subscriptionId, nil
+}
+
 func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	roleAssignmentsClient := meta.(*clients.Client).Authorization.RoleAssignmentsClient
 	roleDefinitionsClient := meta.(*clients.Client).Authorization.ScopedRoleDefinitionsClient
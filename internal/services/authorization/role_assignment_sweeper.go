@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// sweepRoleAssignments removes the Role Assignments within the Subscription whose Principal no longer exists (shown as
+// "Identity not found" in the Portal) - which are left behind when the Principal is deleted before the Role Assignment,
+// for example when a test run creating a User Assigned Identity is cancelled part-way through.
+func sweepRoleAssignments(ctx context.Context, client *clients.Client, options sdk.SweeperOptions) error {
+	roleAssignmentsClient := client.Authorization.ScopedRoleAssignmentsClient
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+
+	resp, err := roleAssignmentsClient.ListForSubscriptionComplete(ctx, subscriptionId, roleassignments.DefaultListForSubscriptionOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing Role Assignments within %s: %+v", subscriptionId, err)
+	}
+
+	// Role Assignments are named using a UUID, so only the age is used to determine whether they should be swept
+	ageOptions := options
+	ageOptions.Prefix = ""
+
+	candidates := make([]roleassignments.RoleAssignment, 0)
+	principalIds := make([]string, 0)
+	for _, item := range resp.Items {
+		props := item.Properties
+		if item.Id == nil || props == nil {
+			continue
+		}
+
+		// Role Assignments inherited from a Management Group are included, but aren't managed within this Subscription
+		if !strings.HasPrefix(strings.ToLower(*item.Id), strings.ToLower(subscriptionId.ID()+"/")) {
+			continue
+		}
+
+		createdAt, err := props.GetCreatedOnAsTime()
+		if err != nil {
+			createdAt = nil
+		}
+		if !ageOptions.ShouldSweep(*item.Id, createdAt) {
+			continue
+		}
+
+		candidates = append(candidates, item)
+		principalIds = append(principalIds, props.PrincipalId)
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	existingPrincipalIds, err := client.Authorization.ExistingPrincipalIds(ctx, principalIds)
+	if err != nil {
+		return fmt.Errorf("checking which Principals exist for the Role Assignments within %s: %+v", subscriptionId, err)
+	}
+
+	errs := make([]error, 0)
+	for _, item := range candidates {
+		if _, ok := existingPrincipalIds[strings.ToLower(item.Properties.PrincipalId)]; ok {
+			continue
+		}
+
+		id, err := roleassignments.ParseScopedRoleAssignmentID(*item.Id)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if options.DryRun {
+			log.Printf("[DEBUG] Dry Run - would delete %s since Principal %q no longer exists", id, item.Properties.PrincipalId)
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting %s since Principal %q no longer exists..", id, item.Properties.PrincipalId)
+		if _, err := roleAssignmentsClient.Delete(ctx, *id, roleassignments.DefaultDeleteOperationOptions()); err != nil {
+			errs = append(errs, fmt.Errorf("deleting %s: %+v", id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -25,12 +25,15 @@ type BatchJobResource struct{}
 var _ sdk.ResourceWithUpdate = BatchJobResource{}
 
 type BatchJobModel struct {
-	Name                        string            `tfschema:"name"`
-	BatchPoolId                 string            `tfschema:"batch_pool_id"`
-	DisplayName                 string            `tfschema:"display_name"`
-	Priority                    int64             `tfschema:"priority"`
-	TaskRetryMaximum            int64             `tfschema:"task_retry_maximum"`
-	CommonEnvironmentProperties map[string]string `tfschema:"common_environment_properties"`
+	Name                        string                         `tfschema:"name"`
+	BatchPoolId                 string                         `tfschema:"batch_pool_id"`
+	DisplayName                 string                         `tfschema:"display_name"`
+	Priority                    int64                          `tfschema:"priority"`
+	TaskRetryMaximum            int64                          `tfschema:"task_retry_maximum"`
+	CommonEnvironmentProperties map[string]string              `tfschema:"common_environment_properties"`
+	JobManagerTask              []BatchJobManagerTaskModel     `tfschema:"job_manager_task"`
+	JobPreparationTask          []BatchJobPreparationTaskModel `tfschema:"job_preparation_task"`
+	JobReleaseTask              []BatchJobReleaseTaskModel     `tfschema:"job_release_task"`
 }
 
 func (r BatchJobResource) Arguments() map[string]*pluginsdk.Schema {
@@ -72,6 +75,9 @@ func (r BatchJobResource) Arguments() map[string]*pluginsdk.Schema {
 			Optional:     true,
 			ValidateFunc: validation.IntAtLeast(-1),
 		},
+		"job_manager_task":     batchJobManagerTaskSchema(true),
+		"job_preparation_task": batchJobPreparationTaskSchema(true),
+		"job_release_task":     batchJobReleaseTaskSchema(true),
 	}
 }
 
@@ -130,10 +136,13 @@ func (r BatchJobResource) Create() sdk.ResourceFunc {
 				Constraints: &batchDataplane.JobConstraints{
 					MaxTaskRetryCount: utils.Int32(int32(model.TaskRetryMaximum)),
 				},
-				CommonEnvironmentSettings: r.expandEnvironmentSettings(model.CommonEnvironmentProperties),
+				CommonEnvironmentSettings: expandBatchEnvironmentSettings(model.CommonEnvironmentProperties),
 				PoolInfo: &batchDataplane.PoolInformation{
 					PoolID: &poolId.PoolName,
 				},
+				JobManagerTask:     expandBatchJobManagerTask(model.JobManagerTask),
+				JobPreparationTask: expandBatchJobPreparationTask(model.JobPreparationTask),
+				JobReleaseTask:     expandBatchJobReleaseTask(model.JobReleaseTask),
 			}
 
 			if err := r.addJob(ctx, client, id, params); err != nil {
@@ -188,7 +197,10 @@ func (r BatchJobResource) Read() sdk.ResourceFunc {
 				}
 			}
 
-			model.CommonEnvironmentProperties = r.flattenEnvironmentSettings(resp.CommonEnvironmentSettings)
+			model.CommonEnvironmentProperties = flattenBatchEnvironmentSettings(resp.CommonEnvironmentSettings)
+			model.JobManagerTask = flattenBatchJobManagerTask(resp.JobManagerTask)
+			model.JobPreparationTask = flattenBatchJobPreparationTask(resp.JobPreparationTask)
+			model.JobReleaseTask = flattenBatchJobReleaseTask(resp.JobReleaseTask)
 
 			return metadata.Encode(&model)
 		},
@@ -289,32 +301,3 @@ func (r BatchJobResource) deleteJob(ctx context.Context, client *batchDataplane.
 	_, err := client.Delete(ctx, id.Name, utils.Int32(int32(timeout.Seconds())), nil, nil, &date.TimeRFC1123{Time: now}, "", "", nil, nil)
 	return err
 }
-
-func (r BatchJobResource) expandEnvironmentSettings(input map[string]string) *[]batchDataplane.EnvironmentSetting {
-	if len(input) == 0 {
-		return nil
-	}
-	m := make([]batchDataplane.EnvironmentSetting, 0, len(input))
-	for k, v := range input {
-		m = append(m, batchDataplane.EnvironmentSetting{
-			Name:  utils.String(k),
-			Value: utils.String(v),
-		})
-	}
-	return &m
-}
-
-func (r BatchJobResource) flattenEnvironmentSettings(input *[]batchDataplane.EnvironmentSetting) map[string]string {
-	if input == nil {
-		return nil
-	}
-
-	m := make(map[string]string)
-	for _, setting := range *input {
-		if setting.Name == nil || setting.Value == nil {
-			continue
-		}
-		m[*setting.Name] = *setting.Value
-	}
-	return m
-}
@@ -49,6 +49,21 @@ func TestAccBatchJob_complete(t *testing.T) {
 	})
 }
 
+func TestAccBatchJob_tasks(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_job", "test")
+	r := BatchJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.tasks(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccBatchJob_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_batch_job", "test")
 	r := BatchJobResource{}
@@ -145,6 +160,52 @@ resource "azurerm_batch_job" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r BatchJobResource) tasks(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_batch_job" "test" {
+  name          = "testaccbj-%d"
+  batch_pool_id = azurerm_batch_pool.test.id
+
+  job_manager_task {
+    id           = "manager"
+    command_line = "/bin/bash -c 'echo manager'"
+    display_name = "Job Manager"
+    common_environment_properties = {
+      role = "manager"
+    }
+    kill_job_on_completion = false
+    task_retry_maximum     = 2
+    max_wall_clock_time    = "PT1H"
+
+    user_identity {
+      auto_user {
+        elevation_level = "admin"
+        scope           = "task"
+      }
+    }
+
+    resource_file {
+      http_url  = "https://raw.githubusercontent.com/hashicorp/terraform-provider-azurerm/main/README.md"
+      file_path = "README.md"
+    }
+  }
+
+  job_preparation_task {
+    command_line     = "/bin/bash -c 'echo preparation'"
+    wait_for_success = true
+  }
+
+  job_release_task {
+    command_line        = "/bin/bash -c 'echo release'"
+    max_wall_clock_time = "PT10M"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r BatchJobResource) update(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,506 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2023-05-01/batchaccount"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2023-05-01/pool"
+	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	batchDataplane "github.com/tombuildsstuff/kermit/sdk/batch/2022-01.15.0/batch"
+)
+
+type BatchJobScheduleResource struct{}
+
+var _ sdk.ResourceWithUpdate = BatchJobScheduleResource{}
+
+type BatchJobScheduleModel struct {
+	Name             string                          `tfschema:"name"`
+	BatchAccountId   string                          `tfschema:"batch_account_id"`
+	DisplayName      string                          `tfschema:"display_name"`
+	Schedule         []BatchJobScheduleScheduleModel `tfschema:"schedule"`
+	JobSpecification []BatchJobSpecificationModel    `tfschema:"job_specification"`
+}
+
+type BatchJobScheduleScheduleModel struct {
+	DoNotRunUntil      string `tfschema:"do_not_run_until"`
+	DoNotRunAfter      string `tfschema:"do_not_run_after"`
+	StartWindow        string `tfschema:"start_window"`
+	RecurrenceInterval string `tfschema:"recurrence_interval"`
+}
+
+type BatchJobSpecificationModel struct {
+	BatchPoolId                 string                         `tfschema:"batch_pool_id"`
+	DisplayName                 string                         `tfschema:"display_name"`
+	Priority                    int64                          `tfschema:"priority"`
+	TaskRetryMaximum            int64                          `tfschema:"task_retry_maximum"`
+	MaxWallClockTime            string                         `tfschema:"max_wall_clock_time"`
+	CommonEnvironmentProperties map[string]string              `tfschema:"common_environment_properties"`
+	OnAllTasksComplete          string                         `tfschema:"on_all_tasks_complete"`
+	OnTaskFailure               string                         `tfschema:"on_task_failure"`
+	UsesTaskDependencies        bool                           `tfschema:"uses_task_dependencies"`
+	JobManagerTask              []BatchJobManagerTaskModel     `tfschema:"job_manager_task"`
+	JobPreparationTask          []BatchJobPreparationTaskModel `tfschema:"job_preparation_task"`
+	JobReleaseTask              []BatchJobReleaseTaskModel     `tfschema:"job_release_task"`
+}
+
+func (r BatchJobScheduleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.JobName,
+		},
+
+		"batch_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: batchaccount.ValidateBatchAccountID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"do_not_run_until": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"do_not_run_after": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"start_window": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: helpersValidate.ISO8601Duration,
+					},
+
+					"recurrence_interval": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: helpersValidate.ISO8601Duration,
+					},
+				},
+			},
+		},
+
+		"job_specification": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"batch_pool_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: pool.ValidatePoolID,
+					},
+
+					"display_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"priority": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntBetween(-1000, 1000),
+					},
+
+					"task_retry_maximum": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(-1),
+					},
+
+					"max_wall_clock_time": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: helpersValidate.ISO8601Duration,
+					},
+
+					"common_environment_properties": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"on_all_tasks_complete": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(batchDataplane.OnAllTasksCompleteNoAction),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(batchDataplane.OnAllTasksCompleteNoAction),
+							string(batchDataplane.OnAllTasksCompleteTerminateJob),
+						}, false),
+					},
+
+					"on_task_failure": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(batchDataplane.OnTaskFailureNoAction),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(batchDataplane.OnTaskFailureNoAction),
+							string(batchDataplane.OnTaskFailurePerformExitOptionsJobAction),
+						}, false),
+					},
+
+					"uses_task_dependencies": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"job_manager_task": batchJobManagerTaskSchema(false),
+
+					"job_preparation_task": batchJobPreparationTaskSchema(false),
+
+					"job_release_task": batchJobReleaseTaskSchema(false),
+				},
+			},
+		},
+	}
+}
+
+func (r BatchJobScheduleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r BatchJobScheduleResource) ResourceType() string {
+	return "azurerm_batch_job_schedule"
+}
+
+func (r BatchJobScheduleResource) ModelObject() interface{} {
+	return &BatchJobScheduleModel{}
+}
+
+func (r BatchJobScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.JobScheduleID
+}
+
+func (r BatchJobScheduleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model BatchJobScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := batchaccount.ParseBatchAccountID(model.BatchAccountId)
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.Batch.JobScheduleClient(ctx, *accountId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewJobScheduleID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.BatchAccountName, model.Name)
+
+			existing, err := r.getJobSchedule(ctx, client, id)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			schedule, err := expandBatchJobScheduleSchedule(model.Schedule)
+			if err != nil {
+				return err
+			}
+
+			jobSpecification, err := expandBatchJobSpecification(*accountId, model.JobSpecification)
+			if err != nil {
+				return err
+			}
+
+			params := batchDataplane.JobScheduleAddParameter{
+				ID:               pointer.To(model.Name),
+				DisplayName:      batchTaskOptionalString(model.DisplayName),
+				Schedule:         schedule,
+				JobSpecification: jobSpecification,
+			}
+
+			deadline, _ := ctx.Deadline()
+			now := time.Now()
+			timeout := deadline.Sub(now)
+			if _, err := client.Add(ctx, params, utils.Int32(int32(timeout.Seconds())), nil, nil, &date.TimeRFC1123{Time: now}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r BatchJobScheduleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.JobScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := batchaccount.NewBatchAccountID(id.SubscriptionId, id.ResourceGroup, id.BatchAccountName)
+			client, err := metadata.Client.Batch.JobScheduleClient(ctx, accountId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := r.getJobSchedule(ctx, client, *id)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := BatchJobScheduleModel{
+				Name:             id.Name,
+				BatchAccountId:   accountId.ID(),
+				DisplayName:      pointer.From(resp.DisplayName),
+				Schedule:         flattenBatchJobScheduleSchedule(resp.Schedule),
+				JobSpecification: flattenBatchJobSpecification(accountId, resp.JobSpecification),
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r BatchJobScheduleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.JobScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model BatchJobScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId := batchaccount.NewBatchAccountID(id.SubscriptionId, id.ResourceGroup, id.BatchAccountName)
+			client, err := metadata.Client.Batch.JobScheduleClient(ctx, accountId)
+			if err != nil {
+				return err
+			}
+
+			schedule, err := expandBatchJobScheduleSchedule(model.Schedule)
+			if err != nil {
+				return err
+			}
+
+			jobSpecification, err := expandBatchJobSpecification(accountId, model.JobSpecification)
+			if err != nil {
+				return err
+			}
+
+			// the Update operation replaces the whole Schedule and Job Specification, which is intentional here so
+			// that any properties removed from the configuration are reset rather than retained
+			params := batchDataplane.JobScheduleUpdateParameter{
+				Schedule:         schedule,
+				JobSpecification: jobSpecification,
+			}
+
+			deadline, _ := ctx.Deadline()
+			now := time.Now()
+			timeout := deadline.Sub(now)
+			if _, err := client.Update(ctx, id.Name, params, utils.Int32(int32(timeout.Seconds())), nil, nil, &date.TimeRFC1123{Time: now}, "", "", nil, nil); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r BatchJobScheduleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.JobScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := batchaccount.NewBatchAccountID(id.SubscriptionId, id.ResourceGroup, id.BatchAccountName)
+			client, err := metadata.Client.Batch.JobScheduleClient(ctx, accountId)
+			if err != nil {
+				return err
+			}
+
+			deadline, _ := ctx.Deadline()
+			now := time.Now()
+			timeout := deadline.Sub(now)
+			if _, err := client.Delete(ctx, id.Name, utils.Int32(int32(timeout.Seconds())), nil, nil, &date.TimeRFC1123{Time: now}, "", "", nil, nil); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r BatchJobScheduleResource) getJobSchedule(ctx context.Context, client *batchDataplane.JobScheduleClient, id parse.JobScheduleId) (batchDataplane.CloudJobSchedule, error) {
+	deadline, _ := ctx.Deadline()
+	now := time.Now()
+	timeout := deadline.Sub(now)
+	return client.Get(ctx, id.Name, "", "", utils.Int32(int32(timeout.Seconds())), nil, nil, &date.TimeRFC1123{Time: now}, "", "", nil, nil)
+}
+
+func expandBatchJobScheduleSchedule(input []BatchJobScheduleScheduleModel) (*batchDataplane.Schedule, error) {
+	output := &batchDataplane.Schedule{}
+	if len(input) == 0 {
+		return output, nil
+	}
+	schedule := input[0]
+
+	if schedule.DoNotRunUntil != "" {
+		t, err := time.Parse(time.RFC3339, schedule.DoNotRunUntil)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `do_not_run_until`: %+v", err)
+		}
+		output.DoNotRunUntil = &date.Time{Time: t}
+	}
+
+	if schedule.DoNotRunAfter != "" {
+		t, err := time.Parse(time.RFC3339, schedule.DoNotRunAfter)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `do_not_run_after`: %+v", err)
+		}
+		output.DoNotRunAfter = &date.Time{Time: t}
+	}
+
+	output.StartWindow = batchTaskOptionalString(schedule.StartWindow)
+	output.RecurrenceInterval = batchTaskOptionalString(schedule.RecurrenceInterval)
+
+	return output, nil
+}
+
+func flattenBatchJobScheduleSchedule(input *batchDataplane.Schedule) []BatchJobScheduleScheduleModel {
+	if input == nil {
+		return []BatchJobScheduleScheduleModel{}
+	}
+
+	schedule := BatchJobScheduleScheduleModel{
+		StartWindow:        pointer.From(input.StartWindow),
+		RecurrenceInterval: pointer.From(input.RecurrenceInterval),
+	}
+
+	if input.DoNotRunUntil != nil {
+		schedule.DoNotRunUntil = input.DoNotRunUntil.Format(time.RFC3339)
+	}
+
+	if input.DoNotRunAfter != nil {
+		schedule.DoNotRunAfter = input.DoNotRunAfter.Format(time.RFC3339)
+	}
+
+	return []BatchJobScheduleScheduleModel{schedule}
+}
+
+func expandBatchJobSpecification(accountId batchaccount.BatchAccountId, input []BatchJobSpecificationModel) (*batchDataplane.JobSpecification, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+	spec := input[0]
+
+	poolId, err := pool.ParsePoolID(spec.BatchPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	if poolId.SubscriptionId != accountId.SubscriptionId || poolId.ResourceGroupName != accountId.ResourceGroupName || poolId.BatchAccountName != accountId.BatchAccountName {
+		return nil, fmt.Errorf("`batch_pool_id` must reference a Batch Pool within %s", accountId)
+	}
+
+	return &batchDataplane.JobSpecification{
+		DisplayName: batchTaskOptionalString(spec.DisplayName),
+		Priority:    utils.Int32(int32(spec.Priority)),
+		Constraints: &batchDataplane.JobConstraints{
+			MaxTaskRetryCount: utils.Int32(int32(spec.TaskRetryMaximum)),
+			MaxWallClockTime:  batchTaskOptionalString(spec.MaxWallClockTime),
+		},
+		CommonEnvironmentSettings: expandBatchEnvironmentSettings(spec.CommonEnvironmentProperties),
+		OnAllTasksComplete:        batchDataplane.OnAllTasksComplete(spec.OnAllTasksComplete),
+		OnTaskFailure:             batchDataplane.OnTaskFailure(spec.OnTaskFailure),
+		UsesTaskDependencies:      pointer.To(spec.UsesTaskDependencies),
+		PoolInfo: &batchDataplane.PoolInformation{
+			PoolID: pointer.To(poolId.PoolName),
+		},
+		JobManagerTask:     expandBatchJobManagerTask(spec.JobManagerTask),
+		JobPreparationTask: expandBatchJobPreparationTask(spec.JobPreparationTask),
+		JobReleaseTask:     expandBatchJobReleaseTask(spec.JobReleaseTask),
+	}, nil
+}
+
+func flattenBatchJobSpecification(accountId batchaccount.BatchAccountId, input *batchDataplane.JobSpecification) []BatchJobSpecificationModel {
+	if input == nil {
+		return []BatchJobSpecificationModel{}
+	}
+
+	spec := BatchJobSpecificationModel{
+		DisplayName:                 pointer.From(input.DisplayName),
+		Priority:                    int64(pointer.From(input.Priority)),
+		CommonEnvironmentProperties: flattenBatchEnvironmentSettings(input.CommonEnvironmentSettings),
+		OnAllTasksComplete:          string(input.OnAllTasksComplete),
+		OnTaskFailure:               string(input.OnTaskFailure),
+		UsesTaskDependencies:        pointer.From(input.UsesTaskDependencies),
+		JobManagerTask:              flattenBatchJobManagerTask(input.JobManagerTask),
+		JobPreparationTask:          flattenBatchJobPreparationTask(input.JobPreparationTask),
+		JobReleaseTask:              flattenBatchJobReleaseTask(input.JobReleaseTask),
+	}
+
+	if info := input.PoolInfo; info != nil && info.PoolID != nil {
+		spec.BatchPoolId = pool.NewPoolID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.BatchAccountName, *info.PoolID).ID()
+	}
+
+	if constraints := input.Constraints; constraints != nil {
+		spec.TaskRetryMaximum = int64(pointer.From(constraints.MaxTaskRetryCount))
+		spec.MaxWallClockTime = pointer.From(constraints.MaxWallClockTime)
+	}
+
+	return []BatchJobSpecificationModel{spec}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2023-05-01/batchaccount"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type BatchJobScheduleResource struct{}
+
+func TestAccBatchJobSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_job_schedule", "test")
+	r := BatchJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBatchJobSchedule_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_job_schedule", "test")
+	r := BatchJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBatchJobSchedule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_job_schedule", "test")
+	r := BatchJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBatchJobSchedule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_job_schedule", "test")
+	r := BatchJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r BatchJobScheduleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.JobScheduleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.Batch.JobScheduleClient(ctx, batchaccount.NewBatchAccountID(id.SubscriptionId, id.ResourceGroup, id.BatchAccountName))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.Name, "", "", nil, nil, nil, nil, "", "", nil, nil); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r BatchJobScheduleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_batch_job_schedule" "test" {
+  name             = "testaccbjs-%d"
+  batch_account_id = azurerm_batch_account.test.id
+
+  schedule {
+    recurrence_interval = "PT1H"
+  }
+
+  job_specification {
+    batch_pool_id = azurerm_batch_pool.test.id
+  }
+}
+`, BatchJobResource{}.template(data), data.RandomInteger)
+}
+
+func (r BatchJobScheduleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_batch_job_schedule" "test" {
+  name             = "testaccbjs-%d"
+  batch_account_id = azurerm_batch_account.test.id
+
+  schedule {
+    do_not_run_until    = "2030-01-01T00:00:00Z"
+    do_not_run_after    = "2031-01-01T00:00:00Z"
+    start_window        = "PT30M"
+    recurrence_interval = "P1D"
+  }
+
+  job_specification {
+    batch_pool_id         = azurerm_batch_pool.test.id
+    display_name          = "Nightly"
+    priority              = 10
+    task_retry_maximum    = 2
+    max_wall_clock_time   = "PT12H"
+    on_all_tasks_complete = "terminatejob"
+    common_environment_properties = {
+      env = "Test"
+    }
+
+    job_manager_task {
+      id           = "manager"
+      command_line = "/bin/bash -c 'echo manager'"
+    }
+
+    job_preparation_task {
+      command_line = "/bin/bash -c 'echo preparation'"
+    }
+
+    job_release_task {
+      command_line = "/bin/bash -c 'echo release'"
+    }
+  }
+}
+`, BatchJobResource{}.template(data), data.RandomInteger)
+}
+
+func (r BatchJobScheduleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_batch_job_schedule" "import" {
+  name             = azurerm_batch_job_schedule.test.name
+  batch_account_id = azurerm_batch_job_schedule.test.batch_account_id
+
+  schedule {
+    recurrence_interval = "PT1H"
+  }
+
+  job_specification {
+    batch_pool_id = azurerm_batch_pool.test.id
+  }
+}
+`, r.basic(data))
+}
@@ -0,0 +1,634 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	batchDataplane "github.com/tombuildsstuff/kermit/sdk/batch/2022-01.15.0/batch"
+)
+
+type BatchJobManagerTaskModel struct {
+	Id                          string                       `tfschema:"id"`
+	CommandLine                 string                       `tfschema:"command_line"`
+	DisplayName                 string                       `tfschema:"display_name"`
+	CommonEnvironmentProperties map[string]string            `tfschema:"common_environment_properties"`
+	KillJobOnCompletion         bool                         `tfschema:"kill_job_on_completion"`
+	RunExclusive                bool                         `tfschema:"run_exclusive"`
+	AllowLowPriorityNode        bool                         `tfschema:"allow_low_priority_node"`
+	TaskRetryMaximum            int64                        `tfschema:"task_retry_maximum"`
+	MaxWallClockTime            string                       `tfschema:"max_wall_clock_time"`
+	RetentionTime               string                       `tfschema:"retention_time"`
+	UserIdentity                []BatchTaskUserIdentityModel `tfschema:"user_identity"`
+	ResourceFile                []BatchTaskResourceFileModel `tfschema:"resource_file"`
+}
+
+type BatchJobPreparationTaskModel struct {
+	Id                            string                       `tfschema:"id"`
+	CommandLine                   string                       `tfschema:"command_line"`
+	CommonEnvironmentProperties   map[string]string            `tfschema:"common_environment_properties"`
+	WaitForSuccess                bool                         `tfschema:"wait_for_success"`
+	RerunOnNodeRebootAfterSuccess bool                         `tfschema:"rerun_on_node_reboot_after_success"`
+	TaskRetryMaximum              int64                        `tfschema:"task_retry_maximum"`
+	MaxWallClockTime              string                       `tfschema:"max_wall_clock_time"`
+	RetentionTime                 string                       `tfschema:"retention_time"`
+	UserIdentity                  []BatchTaskUserIdentityModel `tfschema:"user_identity"`
+	ResourceFile                  []BatchTaskResourceFileModel `tfschema:"resource_file"`
+}
+
+type BatchJobReleaseTaskModel struct {
+	Id                          string                       `tfschema:"id"`
+	CommandLine                 string                       `tfschema:"command_line"`
+	CommonEnvironmentProperties map[string]string            `tfschema:"common_environment_properties"`
+	MaxWallClockTime            string                       `tfschema:"max_wall_clock_time"`
+	RetentionTime               string                       `tfschema:"retention_time"`
+	UserIdentity                []BatchTaskUserIdentityModel `tfschema:"user_identity"`
+	ResourceFile                []BatchTaskResourceFileModel `tfschema:"resource_file"`
+}
+
+type BatchTaskUserIdentityModel struct {
+	UserName string                   `tfschema:"user_name"`
+	AutoUser []BatchTaskAutoUserModel `tfschema:"auto_user"`
+}
+
+type BatchTaskAutoUserModel struct {
+	ElevationLevel string `tfschema:"elevation_level"`
+	Scope          string `tfschema:"scope"`
+}
+
+type BatchTaskResourceFileModel struct {
+	AutoStorageContainerName string `tfschema:"auto_storage_container_name"`
+	BlobPrefix               string `tfschema:"blob_prefix"`
+	FileMode                 string `tfschema:"file_mode"`
+	FilePath                 string `tfschema:"file_path"`
+	HttpUrl                  string `tfschema:"http_url"`
+	StorageContainerUrl      string `tfschema:"storage_container_url"`
+	UserAssignedIdentityId   string `tfschema:"user_assigned_identity_id"`
+}
+
+func batchJobManagerTaskSchema(forceNew bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: forceNew,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"command_line": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"display_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"common_environment_properties": batchTaskEnvironmentSchema(forceNew),
+
+				"kill_job_on_completion": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					ForceNew: forceNew,
+					Default:  true,
+				},
+
+				"run_exclusive": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					ForceNew: forceNew,
+					Default:  true,
+				},
+
+				"allow_low_priority_node": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					ForceNew: forceNew,
+					Default:  true,
+				},
+
+				"task_retry_maximum": batchTaskRetryMaximumSchema(forceNew),
+
+				"max_wall_clock_time": batchTaskDurationSchema(forceNew),
+
+				"retention_time": batchTaskDurationSchema(forceNew),
+
+				"user_identity": batchTaskUserIdentitySchema(forceNew),
+
+				"resource_file": batchTaskResourceFileSchema(forceNew),
+			},
+		},
+	}
+}
+
+func batchJobPreparationTaskSchema(forceNew bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: forceNew,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"command_line": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"id": batchTaskIdSchema(forceNew),
+
+				"common_environment_properties": batchTaskEnvironmentSchema(forceNew),
+
+				"wait_for_success": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					ForceNew: forceNew,
+					Default:  true,
+				},
+
+				"rerun_on_node_reboot_after_success": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					ForceNew: forceNew,
+					Default:  true,
+				},
+
+				"task_retry_maximum": batchTaskRetryMaximumSchema(forceNew),
+
+				"max_wall_clock_time": batchTaskDurationSchema(forceNew),
+
+				"retention_time": batchTaskDurationSchema(forceNew),
+
+				"user_identity": batchTaskUserIdentitySchema(forceNew),
+
+				"resource_file": batchTaskResourceFileSchema(forceNew),
+			},
+		},
+	}
+}
+
+func batchJobReleaseTaskSchema(forceNew bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: forceNew,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"command_line": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"id": batchTaskIdSchema(forceNew),
+
+				"common_environment_properties": batchTaskEnvironmentSchema(forceNew),
+
+				"max_wall_clock_time": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validate.ISO8601DurationBetween("PT0S", "PT15M"),
+				},
+
+				"retention_time": batchTaskDurationSchema(forceNew),
+
+				"user_identity": batchTaskUserIdentitySchema(forceNew),
+
+				"resource_file": batchTaskResourceFileSchema(forceNew),
+			},
+		},
+	}
+}
+
+func batchTaskIdSchema(forceNew bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     forceNew,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+}
+
+func batchTaskEnvironmentSchema(forceNew bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Optional: true,
+		ForceNew: forceNew,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}
+
+func batchTaskRetryMaximumSchema(forceNew bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Optional:     true,
+		ForceNew:     forceNew,
+		ValidateFunc: validation.IntAtLeast(-1),
+	}
+}
+
+func batchTaskDurationSchema(forceNew bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     forceNew,
+		ValidateFunc: validate.ISO8601Duration,
+	}
+}
+
+func batchTaskUserIdentitySchema(forceNew bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: forceNew,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"user_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"auto_user": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					ForceNew: forceNew,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"elevation_level": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								ForceNew: forceNew,
+								Default:  string(batchDataplane.ElevationLevelNonAdmin),
+								ValidateFunc: validation.StringInSlice([]string{
+									string(batchDataplane.ElevationLevelNonAdmin),
+									string(batchDataplane.ElevationLevelAdmin),
+								}, false),
+							},
+
+							"scope": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								ForceNew: forceNew,
+								Default:  string(batchDataplane.AutoUserScopeTask),
+								ValidateFunc: validation.StringInSlice([]string{
+									string(batchDataplane.AutoUserScopeTask),
+									string(batchDataplane.AutoUserScopePool),
+								}, false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func batchTaskResourceFileSchema(forceNew bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: forceNew,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"auto_storage_container_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"blob_prefix": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"file_mode": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"file_path": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"http_url": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				},
+
+				"storage_container_url": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				},
+
+				"user_assigned_identity_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+				},
+			},
+		},
+	}
+}
+
+func expandBatchJobManagerTask(input []BatchJobManagerTaskModel) *batchDataplane.JobManagerTask {
+	if len(input) == 0 {
+		return nil
+	}
+	task := input[0]
+
+	return &batchDataplane.JobManagerTask{
+		ID:                   pointer.To(task.Id),
+		CommandLine:          pointer.To(task.CommandLine),
+		DisplayName:          batchTaskOptionalString(task.DisplayName),
+		EnvironmentSettings:  expandBatchEnvironmentSettings(task.CommonEnvironmentProperties),
+		KillJobOnCompletion:  pointer.To(task.KillJobOnCompletion),
+		RunExclusive:         pointer.To(task.RunExclusive),
+		AllowLowPriorityNode: pointer.To(task.AllowLowPriorityNode),
+		Constraints:          expandBatchTaskConstraints(task.TaskRetryMaximum, task.MaxWallClockTime, task.RetentionTime),
+		UserIdentity:         expandBatchTaskUserIdentity(task.UserIdentity),
+		ResourceFiles:        expandBatchTaskResourceFiles(task.ResourceFile),
+	}
+}
+
+func flattenBatchJobManagerTask(input *batchDataplane.JobManagerTask) []BatchJobManagerTaskModel {
+	if input == nil {
+		return []BatchJobManagerTaskModel{}
+	}
+
+	task := BatchJobManagerTaskModel{
+		Id:                          pointer.From(input.ID),
+		CommandLine:                 pointer.From(input.CommandLine),
+		DisplayName:                 pointer.From(input.DisplayName),
+		CommonEnvironmentProperties: flattenBatchEnvironmentSettings(input.EnvironmentSettings),
+		KillJobOnCompletion:         pointer.From(input.KillJobOnCompletion),
+		RunExclusive:                pointer.From(input.RunExclusive),
+		AllowLowPriorityNode:        pointer.From(input.AllowLowPriorityNode),
+		UserIdentity:                flattenBatchTaskUserIdentity(input.UserIdentity),
+		ResourceFile:                flattenBatchTaskResourceFiles(input.ResourceFiles),
+	}
+
+	if constraints := input.Constraints; constraints != nil {
+		task.TaskRetryMaximum = int64(pointer.From(constraints.MaxTaskRetryCount))
+		task.MaxWallClockTime = pointer.From(constraints.MaxWallClockTime)
+		task.RetentionTime = pointer.From(constraints.RetentionTime)
+	}
+
+	return []BatchJobManagerTaskModel{task}
+}
+
+func expandBatchJobPreparationTask(input []BatchJobPreparationTaskModel) *batchDataplane.JobPreparationTask {
+	if len(input) == 0 {
+		return nil
+	}
+	task := input[0]
+
+	return &batchDataplane.JobPreparationTask{
+		ID:                            batchTaskOptionalString(task.Id),
+		CommandLine:                   pointer.To(task.CommandLine),
+		EnvironmentSettings:           expandBatchEnvironmentSettings(task.CommonEnvironmentProperties),
+		WaitForSuccess:                pointer.To(task.WaitForSuccess),
+		RerunOnNodeRebootAfterSuccess: pointer.To(task.RerunOnNodeRebootAfterSuccess),
+		Constraints:                   expandBatchTaskConstraints(task.TaskRetryMaximum, task.MaxWallClockTime, task.RetentionTime),
+		UserIdentity:                  expandBatchTaskUserIdentity(task.UserIdentity),
+		ResourceFiles:                 expandBatchTaskResourceFiles(task.ResourceFile),
+	}
+}
+
+func flattenBatchJobPreparationTask(input *batchDataplane.JobPreparationTask) []BatchJobPreparationTaskModel {
+	if input == nil {
+		return []BatchJobPreparationTaskModel{}
+	}
+
+	task := BatchJobPreparationTaskModel{
+		Id:                            pointer.From(input.ID),
+		CommandLine:                   pointer.From(input.CommandLine),
+		CommonEnvironmentProperties:   flattenBatchEnvironmentSettings(input.EnvironmentSettings),
+		WaitForSuccess:                pointer.From(input.WaitForSuccess),
+		RerunOnNodeRebootAfterSuccess: pointer.From(input.RerunOnNodeRebootAfterSuccess),
+		UserIdentity:                  flattenBatchTaskUserIdentity(input.UserIdentity),
+		ResourceFile:                  flattenBatchTaskResourceFiles(input.ResourceFiles),
+	}
+
+	if constraints := input.Constraints; constraints != nil {
+		task.TaskRetryMaximum = int64(pointer.From(constraints.MaxTaskRetryCount))
+		task.MaxWallClockTime = pointer.From(constraints.MaxWallClockTime)
+		task.RetentionTime = pointer.From(constraints.RetentionTime)
+	}
+
+	return []BatchJobPreparationTaskModel{task}
+}
+
+func expandBatchJobReleaseTask(input []BatchJobReleaseTaskModel) *batchDataplane.JobReleaseTask {
+	if len(input) == 0 {
+		return nil
+	}
+	task := input[0]
+
+	return &batchDataplane.JobReleaseTask{
+		ID:                  batchTaskOptionalString(task.Id),
+		CommandLine:         pointer.To(task.CommandLine),
+		EnvironmentSettings: expandBatchEnvironmentSettings(task.CommonEnvironmentProperties),
+		MaxWallClockTime:    batchTaskOptionalString(task.MaxWallClockTime),
+		RetentionTime:       batchTaskOptionalString(task.RetentionTime),
+		UserIdentity:        expandBatchTaskUserIdentity(task.UserIdentity),
+		ResourceFiles:       expandBatchTaskResourceFiles(task.ResourceFile),
+	}
+}
+
+func flattenBatchJobReleaseTask(input *batchDataplane.JobReleaseTask) []BatchJobReleaseTaskModel {
+	if input == nil {
+		return []BatchJobReleaseTaskModel{}
+	}
+
+	return []BatchJobReleaseTaskModel{
+		{
+			Id:                          pointer.From(input.ID),
+			CommandLine:                 pointer.From(input.CommandLine),
+			CommonEnvironmentProperties: flattenBatchEnvironmentSettings(input.EnvironmentSettings),
+			MaxWallClockTime:            pointer.From(input.MaxWallClockTime),
+			RetentionTime:               pointer.From(input.RetentionTime),
+			UserIdentity:                flattenBatchTaskUserIdentity(input.UserIdentity),
+			ResourceFile:                flattenBatchTaskResourceFiles(input.ResourceFiles),
+		},
+	}
+}
+
+func expandBatchTaskConstraints(taskRetryMaximum int64, maxWallClockTime, retentionTime string) *batchDataplane.TaskConstraints {
+	return &batchDataplane.TaskConstraints{
+		MaxTaskRetryCount: utils.Int32(int32(taskRetryMaximum)),
+		MaxWallClockTime:  batchTaskOptionalString(maxWallClockTime),
+		RetentionTime:     batchTaskOptionalString(retentionTime),
+	}
+}
+
+func expandBatchEnvironmentSettings(input map[string]string) *[]batchDataplane.EnvironmentSetting {
+	if len(input) == 0 {
+		return nil
+	}
+
+	settings := make([]batchDataplane.EnvironmentSetting, 0, len(input))
+	for k, v := range input {
+		settings = append(settings, batchDataplane.EnvironmentSetting{
+			Name:  pointer.To(k),
+			Value: pointer.To(v),
+		})
+	}
+	return &settings
+}
+
+func flattenBatchEnvironmentSettings(input *[]batchDataplane.EnvironmentSetting) map[string]string {
+	if input == nil {
+		return nil
+	}
+
+	settings := make(map[string]string)
+	for _, setting := range *input {
+		if setting.Name == nil || setting.Value == nil {
+			continue
+		}
+		settings[*setting.Name] = *setting.Value
+	}
+	return settings
+}
+
+func expandBatchTaskUserIdentity(input []BatchTaskUserIdentityModel) *batchDataplane.UserIdentity {
+	if len(input) == 0 {
+		return nil
+	}
+	identity := input[0]
+
+	output := &batchDataplane.UserIdentity{
+		UserName: batchTaskOptionalString(identity.UserName),
+	}
+
+	if len(identity.AutoUser) > 0 {
+		output.AutoUser = &batchDataplane.AutoUserSpecification{
+			ElevationLevel: batchDataplane.ElevationLevel(identity.AutoUser[0].ElevationLevel),
+			Scope:          batchDataplane.AutoUserScope(identity.AutoUser[0].Scope),
+		}
+	}
+
+	return output
+}
+
+func flattenBatchTaskUserIdentity(input *batchDataplane.UserIdentity) []BatchTaskUserIdentityModel {
+	if input == nil {
+		return []BatchTaskUserIdentityModel{}
+	}
+
+	identity := BatchTaskUserIdentityModel{
+		UserName: pointer.From(input.UserName),
+		AutoUser: []BatchTaskAutoUserModel{},
+	}
+
+	if autoUser := input.AutoUser; autoUser != nil {
+		identity.AutoUser = append(identity.AutoUser, BatchTaskAutoUserModel{
+			ElevationLevel: string(autoUser.ElevationLevel),
+			Scope:          string(autoUser.Scope),
+		})
+	}
+
+	return []BatchTaskUserIdentityModel{identity}
+}
+
+func expandBatchTaskResourceFiles(input []BatchTaskResourceFileModel) *[]batchDataplane.ResourceFile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	files := make([]batchDataplane.ResourceFile, 0)
+	for _, v := range input {
+		file := batchDataplane.ResourceFile{
+			AutoStorageContainerName: batchTaskOptionalString(v.AutoStorageContainerName),
+			BlobPrefix:               batchTaskOptionalString(v.BlobPrefix),
+			FileMode:                 batchTaskOptionalString(v.FileMode),
+			FilePath:                 batchTaskOptionalString(v.FilePath),
+			HTTPURL:                  batchTaskOptionalString(v.HttpUrl),
+			StorageContainerURL:      batchTaskOptionalString(v.StorageContainerUrl),
+		}
+
+		if v.UserAssignedIdentityId != "" {
+			file.IdentityReference = &batchDataplane.ComputeNodeIdentityReference{
+				ResourceID: pointer.To(v.UserAssignedIdentityId),
+			}
+		}
+
+		files = append(files, file)
+	}
+	return &files
+}
+
+func flattenBatchTaskResourceFiles(input *[]batchDataplane.ResourceFile) []BatchTaskResourceFileModel {
+	files := make([]BatchTaskResourceFileModel, 0)
+	if input == nil {
+		return files
+	}
+
+	for _, v := range *input {
+		file := BatchTaskResourceFileModel{
+			AutoStorageContainerName: pointer.From(v.AutoStorageContainerName),
+			BlobPrefix:               pointer.From(v.BlobPrefix),
+			FileMode:                 pointer.From(v.FileMode),
+			FilePath:                 pointer.From(v.FilePath),
+			HttpUrl:                  pointer.From(v.HTTPURL),
+			StorageContainerUrl:      pointer.From(v.StorageContainerURL),
+		}
+
+		if v.IdentityReference != nil {
+			file.UserAssignedIdentityId = pointer.From(v.IdentityReference.ResourceID)
+		}
+
+		files = append(files, file)
+	}
+	return files
+}
+
+func batchTaskOptionalString(input string) *string {
+	if input == "" {
+		return nil
+	}
+	return pointer.To(input)
+}
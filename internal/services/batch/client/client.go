@@ -60,23 +60,80 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 }
 
 func (r *Client) JobClient(ctx context.Context, accountId batchaccount.BatchAccountId) (*batchDataplane.JobClient, error) {
+	endpoint, authorizer, err := r.dataPlaneEndpointAndAuthorizer(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	c := batchDataplane.NewJobClient(endpoint)
+	c.BaseClient.Client.Authorizer = authorizer
+	return &c, nil
+}
+
+func (r *Client) JobScheduleClient(ctx context.Context, accountId batchaccount.BatchAccountId) (*batchDataplane.JobScheduleClient, error) {
+	endpoint, authorizer, err := r.dataPlaneEndpointAndAuthorizer(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	c := batchDataplane.NewJobScheduleClient(endpoint)
+	c.BaseClient.Client.Authorizer = authorizer
+	return &c, nil
+}
+
+// dataPlaneEndpointAndAuthorizer returns the endpoint of the Batch Account along with an Authorizer for its data plane.
+// AAD authentication is used where the Batch Account allows it, otherwise the Shared Key of the Batch Account is used.
+func (r *Client) dataPlaneEndpointAndAuthorizer(ctx context.Context, accountId batchaccount.BatchAccountId) (string, autorest.Authorizer, error) {
 	// Retrieve the batch account to find the batch account endpoint
 	accountClient := r.AccountClient
 	account, err := accountClient.Get(ctx, accountId)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving %s: %v", accountId, err)
+		return "", nil, fmt.Errorf("retrieving %s: %v", accountId, err)
 	}
 
 	endpoint := ""
+	authenticationModes := make([]batchaccount.AuthenticationMode, 0)
 	if account.Model != nil && account.Model.Properties != nil {
-		endpoint = fmt.Sprintf("https://%s", *account.Model.Properties.AccountEndpoint)
+		if account.Model.Properties.AccountEndpoint != nil {
+			endpoint = fmt.Sprintf("https://%s", *account.Model.Properties.AccountEndpoint)
+		}
+		if account.Model.Properties.AllowedAuthenticationModes != nil {
+			authenticationModes = *account.Model.Properties.AllowedAuthenticationModes
+		}
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("retrieving %s: `properties.AccountEndpoint` was empty", accountId)
+		return "", nil, fmt.Errorf("retrieving %s: `properties.AccountEndpoint` was empty", accountId)
 	}
 
-	// Copy the client since we'll manipulate its BatchURL
-	c := batchDataplane.NewJobClient(endpoint)
-	c.BaseClient.Client.Authorizer = r.BatchManagementAuthorizer
-	return &c, nil
+	if len(authenticationModes) == 0 || hasAuthenticationMode(authenticationModes, batchaccount.AuthenticationModeAAD) {
+		return endpoint, r.BatchManagementAuthorizer, nil
+	}
+
+	if !hasAuthenticationMode(authenticationModes, batchaccount.AuthenticationModeSharedKey) {
+		return "", nil, fmt.Errorf("%s must allow either the `AAD` or `SharedKey` authentication modes to manage data plane resources", accountId)
+	}
+
+	keys, err := accountClient.GetKeys(ctx, accountId)
+	if err != nil {
+		return "", nil, fmt.Errorf("retrieving the Shared Keys for %s: %v", accountId, err)
+	}
+	if keys.Model == nil || keys.Model.Primary == nil {
+		return "", nil, fmt.Errorf("retrieving the Shared Keys for %s: `primary` was nil", accountId)
+	}
+
+	authorizer, err := newSharedKeyAuthorizer(accountId.BatchAccountName, *keys.Model.Primary)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return endpoint, authorizer, nil
+}
+
+func hasAuthenticationMode(input []batchaccount.AuthenticationMode, mode batchaccount.AuthenticationMode) bool {
+	for _, v := range input {
+		if v == mode {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+var _ autorest.Authorizer = sharedKeyAuthorizer{}
+
+// sharedKeyAuthorizer authorizes requests to the Batch data plane using the Shared Key of the Batch Account, see:
+// https://learn.microsoft.com/rest/api/batchservice/authenticate-requests-to-the-azure-batch-service
+type sharedKeyAuthorizer struct {
+	accountName string
+	accountKey  []byte
+}
+
+func newSharedKeyAuthorizer(accountName, accountKey string) (*sharedKeyAuthorizer, error) {
+	key, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return nil, fmt.Errorf("decoding the Shared Key for the Batch Account %q: %+v", accountName, err)
+	}
+
+	return &sharedKeyAuthorizer{
+		accountName: accountName,
+		accountKey:  key,
+	}, nil
+}
+
+func (a sharedKeyAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			// the `ocp-date` header is included in the signature, so must be set before signing
+			if r.Header.Get("ocp-date") == "" {
+				r.Header.Set("ocp-date", time.Now().UTC().Format(http.TimeFormat))
+			}
+
+			mac := hmac.New(sha256.New, a.accountKey)
+			mac.Write([]byte(sharedKeyStringToSign(a.accountName, r)))
+			signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+			r.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", a.accountName, signature))
+			return r, nil
+		})
+	}
+}
+
+func sharedKeyStringToSign(accountName string, r *http.Request) string {
+	contentLength := ""
+	if r.ContentLength > 0 {
+		contentLength = strconv.FormatInt(r.ContentLength, 10)
+	}
+
+	lines := []string{
+		r.Method,
+		r.Header.Get("Content-Encoding"),
+		r.Header.Get("Content-Language"),
+		contentLength,
+		r.Header.Get("Content-MD5"),
+		r.Header.Get("Content-Type"),
+		r.Header.Get("Date"),
+		r.Header.Get("If-Modified-Since"),
+		r.Header.Get("If-Match"),
+		r.Header.Get("If-None-Match"),
+		r.Header.Get("If-Unmodified-Since"),
+		r.Header.Get("Range"),
+	}
+
+	return strings.Join(lines, "\n") + "\n" + sharedKeyCanonicalizedHeaders(r) + sharedKeyCanonicalizedResource(accountName, r)
+}
+
+func sharedKeyCanonicalizedHeaders(r *http.Request) string {
+	headers := make(map[string]string)
+	names := make([]string, 0)
+	for k, v := range r.Header {
+		name := strings.ToLower(k)
+		if !strings.HasPrefix(name, "ocp-") {
+			continue
+		}
+		headers[name] = strings.TrimSpace(strings.Join(v, ","))
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		sb.WriteString(fmt.Sprintf("%s:%s\n", name, headers[name]))
+	}
+	return sb.String()
+}
+
+func sharedKeyCanonicalizedResource(accountName string, r *http.Request) string {
+	path := r.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("/%s%s", accountName, path))
+
+	query := r.URL.Query()
+	params := make(map[string]string)
+	names := make([]string, 0)
+	for k, v := range query {
+		name := strings.ToLower(k)
+		values := append([]string{}, v...)
+		sort.Strings(values)
+		params[name] = strings.Join(values, ",")
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		sb.WriteString(fmt.Sprintf("\n%s:%s", name, params[name]))
+	}
+	return sb.String()
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSharedKeyStringToSign(t *testing.T) {
+	body := `{"id":"example"}`
+	req, err := http.NewRequest(http.MethodPost, "https://account1.westeurope.batch.azure.com/jobschedules?timeout=30&api-version=2022-01-01.15.0", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	req.Header.Set("Content-Type", "application/json; odata=minimalmetadata; charset=utf-8")
+	req.Header.Set("ocp-date", "Tue, 01 Aug 2023 10:00:00 GMT")
+	req.Header.Set("Return-Client-Request-Id", "false")
+
+	expected := strings.Join([]string{
+		"POST",
+		"",
+		"",
+		"16",
+		"",
+		"application/json; odata=minimalmetadata; charset=utf-8",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"ocp-date:Tue, 01 Aug 2023 10:00:00 GMT",
+		"/account1/jobschedules",
+		"api-version:2022-01-01.15.0",
+		"timeout:30",
+	}, "\n")
+
+	if actual := sharedKeyStringToSign("account1", req); actual != expected {
+		t.Fatalf("expected the string to sign to be:\n%s\n\nbut got:\n%s", expected, actual)
+	}
+}
+
+func TestSharedKeyAuthorizer(t *testing.T) {
+	if _, err := newSharedKeyAuthorizer("account1", "not-base64!"); err == nil {
+		t.Fatalf("expected an error for an invalid Shared Key but didn't get one")
+	}
+
+	authorizer, err := newSharedKeyAuthorizer("account1", "dGVzdGluZw==")
+	if err != nil {
+		t.Fatalf("building authorizer: %+v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://account1.westeurope.batch.azure.com/jobschedules/schedule1?api-version=2022-01-01.15.0", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	req, err = authorizer.WithAuthorization()(nopPreparer{}).Prepare(req)
+	if err != nil {
+		t.Fatalf("authorizing request: %+v", err)
+	}
+
+	if req.Header.Get("ocp-date") == "" {
+		t.Fatalf("expected the `ocp-date` header to be set")
+	}
+	if v := req.Header.Get("Authorization"); !strings.HasPrefix(v, "SharedKey account1:") {
+		t.Fatalf("expected the `Authorization` header to use the SharedKey scheme but got %q", v)
+	}
+}
+
+type nopPreparer struct{}
+
+func (nopPreparer) Prepare(r *http.Request) (*http.Request, error) {
+	return r, nil
+}
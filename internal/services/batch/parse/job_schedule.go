@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type JobScheduleId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	BatchAccountName string
+	Name             string
+}
+
+func NewJobScheduleID(subscriptionId, resourceGroup, batchAccountName, name string) JobScheduleId {
+	return JobScheduleId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		BatchAccountName: batchAccountName,
+		Name:             name,
+	}
+}
+
+func (id JobScheduleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Batch Account Name %q", id.BatchAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Job Schedule", segmentsStr)
+}
+
+func (id JobScheduleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Batch/batchAccounts/%s/jobSchedules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BatchAccountName, id.Name)
+}
+
+// JobScheduleID parses a JobSchedule ID into an JobScheduleId struct
+func JobScheduleID(input string) (*JobScheduleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an JobSchedule ID: %+v", input, err)
+	}
+
+	resourceId := JobScheduleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("jobSchedules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -55,5 +55,6 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		BatchJobResource{},
+		BatchJobScheduleResource{},
 	}
 }
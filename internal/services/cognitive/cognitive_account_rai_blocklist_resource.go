@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-05-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-10-01-preview/raiblocklists"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type cognitiveRaiBlocklistModel struct {
+	Name               string                  `tfschema:"name"`
+	CognitiveAccountId string                  `tfschema:"cognitive_account_id"`
+	Description        string                  `tfschema:"description"`
+	Items              []RaiBlocklistItemModel `tfschema:"item"`
+}
+
+type RaiBlocklistItemModel struct {
+	Name         string `tfschema:"name"`
+	Pattern      string `tfschema:"pattern"`
+	RegexEnabled bool   `tfschema:"regex_enabled"`
+}
+
+type CognitiveAccountRaiBlocklistResource struct{}
+
+var _ sdk.ResourceWithUpdate = CognitiveAccountRaiBlocklistResource{}
+
+func (r CognitiveAccountRaiBlocklistResource) ResourceType() string {
+	return "azurerm_cognitive_account_rai_blocklist"
+}
+
+func (r CognitiveAccountRaiBlocklistResource) ModelObject() interface{} {
+	return &cognitiveRaiBlocklistModel{}
+}
+
+func (r CognitiveAccountRaiBlocklistResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return raiblocklists.ValidateRaiBlocklistID
+}
+
+func (r CognitiveAccountRaiBlocklistResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cognitive_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: cognitiveservicesaccounts.ValidateAccountID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"item": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"pattern": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"regex_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CognitiveAccountRaiBlocklistResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CognitiveAccountRaiBlocklistResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model cognitiveRaiBlocklistModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cognitive.RaiBlocklistsClient
+			accountId, err := cognitiveservicesaccounts.ParseAccountID(model.CognitiveAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			id := raiblocklists.NewRaiBlocklistID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := raiblocklists.RaiBlocklist{
+				Properties: &raiblocklists.RaiBlocklistProperties{},
+			}
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if err := reconcileRaiBlocklistItems(ctx, client, id, nil, model.Items); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CognitiveAccountRaiBlocklistResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model cognitiveRaiBlocklistModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cognitive.RaiBlocklistsClient
+			id, err := raiblocklists.ParseRaiBlocklistID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			if metadata.ResourceData.HasChange("description") {
+				payload := raiblocklists.RaiBlocklist{
+					Properties: &raiblocklists.RaiBlocklistProperties{},
+				}
+				if model.Description != "" {
+					payload.Properties.Description = pointer.To(model.Description)
+				}
+
+				if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("item") {
+				existing, err := listRaiBlocklistItems(ctx, client, *id)
+				if err != nil {
+					return err
+				}
+
+				if err := reconcileRaiBlocklistItems(ctx, client, *id, existing, model.Items); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CognitiveAccountRaiBlocklistResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiBlocklistsClient
+
+			id, err := raiblocklists.ParseRaiBlocklistID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := cognitiveRaiBlocklistModel{
+				Name:               id.RaiBlocklistName,
+				CognitiveAccountId: cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.Description = pointer.From(model.Properties.Description)
+			}
+
+			items, err := listRaiBlocklistItems(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+			state.Items = items
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CognitiveAccountRaiBlocklistResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiBlocklistsClient
+
+			id, err := raiblocklists.ParseRaiBlocklistID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			// the items within the Blocklist are removed along with it
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func listRaiBlocklistItems(ctx context.Context, client *raiblocklists.RaiBlocklistsClient, id raiblocklists.RaiBlocklistId) ([]RaiBlocklistItemModel, error) {
+	resp, err := client.RaiBlocklistItemsListComplete(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("listing items for %s: %+v", id, err)
+	}
+
+	output := make([]RaiBlocklistItemModel, 0)
+	for _, item := range resp.Items {
+		model := RaiBlocklistItemModel{
+			Name: pointer.From(item.Name),
+		}
+		if props := item.Properties; props != nil {
+			model.Pattern = pointer.From(props.Pattern)
+			model.RegexEnabled = pointer.From(props.IsRegex)
+		}
+		output = append(output, model)
+	}
+
+	return output, nil
+}
+
+// reconcileRaiBlocklistItems removes the items which exist but are no longer desired and then creates or updates
+// the desired items which differ from those which exist
+func reconcileRaiBlocklistItems(ctx context.Context, client *raiblocklists.RaiBlocklistsClient, id raiblocklists.RaiBlocklistId, existing []RaiBlocklistItemModel, desired []RaiBlocklistItemModel) error {
+	desiredByName := make(map[string]RaiBlocklistItemModel, len(desired))
+	for _, item := range desired {
+		desiredByName[item.Name] = item
+	}
+
+	existingByName := make(map[string]RaiBlocklistItemModel, len(existing))
+	for _, item := range existing {
+		existingByName[item.Name] = item
+
+		if _, ok := desiredByName[item.Name]; ok {
+			continue
+		}
+
+		itemId := raiblocklists.NewRaiBlocklistItemID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.RaiBlocklistName, item.Name)
+		if err := client.RaiBlocklistItemsDeleteThenPoll(ctx, itemId); err != nil {
+			return fmt.Errorf("deleting %s: %+v", itemId, err)
+		}
+	}
+
+	for _, item := range desired {
+		if current, ok := existingByName[item.Name]; ok && current == item {
+			continue
+		}
+
+		itemId := raiblocklists.NewRaiBlocklistItemID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.RaiBlocklistName, item.Name)
+		payload := raiblocklists.RaiBlocklistItem{
+			Properties: &raiblocklists.RaiBlocklistItemProperties{
+				Pattern: pointer.To(item.Pattern),
+				IsRegex: pointer.To(item.RegexEnabled),
+			},
+		}
+		if _, err := client.RaiBlocklistItemsCreateOrUpdate(ctx, itemId, payload); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", itemId, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-10-01-preview/raiblocklists"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CognitiveRaiBlocklistTestResource struct{}
+
+func TestAccCognitiveRaiBlocklistSequential(t *testing.T) {
+	// Only two OpenAI resources could be created per region, so run the tests sequentially.
+	// Refer to : https://learn.microsoft.com/en-us/azure/cognitive-services/openai/quotas-limits
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"raiBlocklist": {
+			"basic":          testAccCognitiveRaiBlocklist_basic,
+			"requiresImport": testAccCognitiveRaiBlocklist_requiresImport,
+			"update":         testAccCognitiveRaiBlocklist_update,
+		},
+	})
+}
+
+func testAccCognitiveRaiBlocklist_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_rai_blocklist", "test")
+	r := CognitiveRaiBlocklistTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccCognitiveRaiBlocklist_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_rai_blocklist", "test")
+	r := CognitiveRaiBlocklistTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccCognitiveRaiBlocklist_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_rai_blocklist", "test")
+	r := CognitiveRaiBlocklistTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("item.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("item.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CognitiveRaiBlocklistTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := raiblocklists.ParseRaiBlocklistID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Cognitive.RaiBlocklistsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r CognitiveRaiBlocklistTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_rai_blocklist" "test" {
+  name                 = "acctestblocklist%d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+}
+`, CognitiveDeploymentTestResource{}.template(data), data.RandomInteger)
+}
+
+func (r CognitiveRaiBlocklistTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_rai_blocklist" "import" {
+  name                 = azurerm_cognitive_account_rai_blocklist.test.name
+  cognitive_account_id = azurerm_cognitive_account_rai_blocklist.test.cognitive_account_id
+}
+`, r.basic(data))
+}
+
+func (r CognitiveRaiBlocklistTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_rai_blocklist" "test" {
+  name                 = "acctestblocklist%d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  description          = "Blocks internal project names"
+
+  item {
+    name    = "codename"
+    pattern = "Project Nightingale"
+  }
+
+  item {
+    name          = "ticket"
+    pattern       = "TICKET-[0-9]+"
+    regex_enabled = true
+  }
+}
+`, CognitiveDeploymentTestResource{}.template(data), data.RandomInteger)
+}
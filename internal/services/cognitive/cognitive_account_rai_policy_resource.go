@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-05-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-10-01-preview/raipolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type cognitiveRaiPolicyModel struct {
+	Name               string                          `tfschema:"name"`
+	CognitiveAccountId string                          `tfschema:"cognitive_account_id"`
+	BasePolicyName     string                          `tfschema:"base_policy_name"`
+	Mode               string                          `tfschema:"mode"`
+	ContentFilters     []RaiPolicyContentFilterModel   `tfschema:"content_filter"`
+	CustomBlocklists   []RaiPolicyCustomBlocklistModel `tfschema:"custom_blocklist"`
+}
+
+type RaiPolicyContentFilterModel struct {
+	Name              string `tfschema:"name"`
+	Source            string `tfschema:"source"`
+	FilterEnabled     bool   `tfschema:"filter_enabled"`
+	BlockEnabled      bool   `tfschema:"block_enabled"`
+	SeverityThreshold string `tfschema:"severity_threshold"`
+}
+
+type RaiPolicyCustomBlocklistModel struct {
+	BlocklistName string `tfschema:"blocklist_name"`
+	Source        string `tfschema:"source"`
+	BlockEnabled  bool   `tfschema:"block_enabled"`
+}
+
+type CognitiveAccountRaiPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = CognitiveAccountRaiPolicyResource{}
+
+func (r CognitiveAccountRaiPolicyResource) ResourceType() string {
+	return "azurerm_cognitive_account_rai_policy"
+}
+
+func (r CognitiveAccountRaiPolicyResource) ModelObject() interface{} {
+	return &cognitiveRaiPolicyModel{}
+}
+
+func (r CognitiveAccountRaiPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return raipolicies.ValidateRaiPolicyID
+}
+
+func (r CognitiveAccountRaiPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cognitive_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: cognitiveservicesaccounts.ValidateAccountID,
+		},
+
+		"base_policy_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"content_filter": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"source": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForRaiPolicyContentSource(), false),
+					},
+
+					"filter_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"block_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"severity_threshold": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForAllowedContentLevel(), false),
+					},
+				},
+			},
+		},
+
+		"custom_blocklist": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"blocklist_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"source": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForRaiPolicyContentSource(), false),
+					},
+
+					"block_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(raipolicies.PossibleValuesForRaiPolicyMode(), false),
+		},
+	}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model cognitiveRaiPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+			accountId, err := cognitiveservicesaccounts.ParseAccountID(model.CognitiveAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			id := raipolicies.NewRaiPolicyID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandRaiPolicyModel(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model cognitiveRaiPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+			id, err := raipolicies.ParseRaiPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			// the content filters and blocklists are replaced in their entirety, so the complete payload is sent
+			if _, err := client.CreateOrUpdate(ctx, *id, expandRaiPolicyModel(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+
+			id, err := raipolicies.ParseRaiPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := cognitiveRaiPolicyModel{
+				Name:               id.RaiPolicyName,
+				CognitiveAccountId: cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.BasePolicyName = pointer.From(props.BasePolicyName)
+					state.Mode = string(pointer.From(props.Mode))
+					state.ContentFilters = flattenRaiPolicyContentFilters(props.ContentFilters)
+					state.CustomBlocklists = flattenRaiPolicyCustomBlocklists(props.PromptBlocklists, props.CompletionBlocklists)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CognitiveAccountRaiPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.RaiPoliciesClient
+
+			id, err := raipolicies.ParseRaiPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandRaiPolicyModel(model cognitiveRaiPolicyModel) raipolicies.RaiPolicy {
+	contentFilters := make([]raipolicies.RaiPolicyContentFilter, 0)
+	for _, filter := range model.ContentFilters {
+		item := raipolicies.RaiPolicyContentFilter{
+			Name:     pointer.To(filter.Name),
+			Source:   pointer.To(raipolicies.RaiPolicyContentSource(filter.Source)),
+			Enabled:  pointer.To(filter.FilterEnabled),
+			Blocking: pointer.To(filter.BlockEnabled),
+		}
+		if filter.SeverityThreshold != "" {
+			item.AllowedContentLevel = pointer.To(raipolicies.AllowedContentLevel(filter.SeverityThreshold))
+		}
+		contentFilters = append(contentFilters, item)
+	}
+
+	promptBlocklists := make([]raipolicies.RaiBlocklistConfig, 0)
+	completionBlocklists := make([]raipolicies.RaiBlocklistConfig, 0)
+	for _, blocklist := range model.CustomBlocklists {
+		item := raipolicies.RaiBlocklistConfig{
+			BlocklistName: pointer.To(blocklist.BlocklistName),
+			Blocking:      pointer.To(blocklist.BlockEnabled),
+		}
+		if blocklist.Source == string(raipolicies.RaiPolicyContentSourcePrompt) {
+			promptBlocklists = append(promptBlocklists, item)
+		} else {
+			completionBlocklists = append(completionBlocklists, item)
+		}
+	}
+
+	payload := raipolicies.RaiPolicy{
+		Properties: &raipolicies.RaiPolicyProperties{
+			BasePolicyName:       pointer.To(model.BasePolicyName),
+			ContentFilters:       &contentFilters,
+			PromptBlocklists:     &promptBlocklists,
+			CompletionBlocklists: &completionBlocklists,
+		},
+	}
+
+	if model.Mode != "" {
+		payload.Properties.Mode = pointer.To(raipolicies.RaiPolicyMode(model.Mode))
+	}
+
+	return payload
+}
+
+func flattenRaiPolicyContentFilters(input *[]raipolicies.RaiPolicyContentFilter) []RaiPolicyContentFilterModel {
+	output := make([]RaiPolicyContentFilterModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, filter := range *input {
+		output = append(output, RaiPolicyContentFilterModel{
+			Name:              pointer.From(filter.Name),
+			Source:            string(pointer.From(filter.Source)),
+			FilterEnabled:     pointer.From(filter.Enabled),
+			BlockEnabled:      pointer.From(filter.Blocking),
+			SeverityThreshold: string(pointer.From(filter.AllowedContentLevel)),
+		})
+	}
+
+	return output
+}
+
+func flattenRaiPolicyCustomBlocklists(prompt *[]raipolicies.RaiBlocklistConfig, completion *[]raipolicies.RaiBlocklistConfig) []RaiPolicyCustomBlocklistModel {
+	output := make([]RaiPolicyCustomBlocklistModel, 0)
+
+	if prompt != nil {
+		for _, blocklist := range *prompt {
+			output = append(output, RaiPolicyCustomBlocklistModel{
+				BlocklistName: pointer.From(blocklist.BlocklistName),
+				Source:        string(raipolicies.RaiPolicyContentSourcePrompt),
+				BlockEnabled:  pointer.From(blocklist.Blocking),
+			})
+		}
+	}
+
+	if completion != nil {
+		for _, blocklist := range *completion {
+			output = append(output, RaiPolicyCustomBlocklistModel{
+				BlocklistName: pointer.From(blocklist.BlocklistName),
+				Source:        string(raipolicies.RaiPolicyContentSourceCompletion),
+				BlockEnabled:  pointer.From(blocklist.Blocking),
+			})
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-10-01-preview/raipolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CognitiveRaiPolicyTestResource struct{}
+
+func TestAccCognitiveRaiPolicySequential(t *testing.T) {
+	// Only two OpenAI resources could be created per region, so run the tests sequentially.
+	// Refer to : https://learn.microsoft.com/en-us/azure/cognitive-services/openai/quotas-limits
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"raiPolicy": {
+			"basic":          testAccCognitiveRaiPolicy_basic,
+			"requiresImport": testAccCognitiveRaiPolicy_requiresImport,
+			"complete":       testAccCognitiveRaiPolicy_complete,
+			"update":         testAccCognitiveRaiPolicy_update,
+		},
+	})
+}
+
+func testAccCognitiveRaiPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_rai_policy", "test")
+	r := CognitiveRaiPolicyTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccCognitiveRaiPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_rai_policy", "test")
+	r := CognitiveRaiPolicyTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccCognitiveRaiPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_rai_policy", "test")
+	r := CognitiveRaiPolicyTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccCognitiveRaiPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_rai_policy", "test")
+	r := CognitiveRaiPolicyTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CognitiveRaiPolicyTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := raipolicies.ParseRaiPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Cognitive.RaiPoliciesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r CognitiveRaiPolicyTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_rai_policy" "test" {
+  name                 = "acctestraipolicy%d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  base_policy_name     = "Microsoft.Default"
+
+  content_filter {
+    name               = "Hate"
+    source             = "Prompt"
+    filter_enabled     = true
+    block_enabled      = true
+    severity_threshold = "High"
+  }
+
+  content_filter {
+    name               = "Hate"
+    source             = "Completion"
+    filter_enabled     = true
+    block_enabled      = true
+    severity_threshold = "High"
+  }
+}
+`, CognitiveDeploymentTestResource{}.template(data), data.RandomInteger)
+}
+
+func (r CognitiveRaiPolicyTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_rai_policy" "import" {
+  name                 = azurerm_cognitive_account_rai_policy.test.name
+  cognitive_account_id = azurerm_cognitive_account_rai_policy.test.cognitive_account_id
+  base_policy_name     = azurerm_cognitive_account_rai_policy.test.base_policy_name
+
+  content_filter {
+    name               = "Hate"
+    source             = "Prompt"
+    filter_enabled     = true
+    block_enabled      = true
+    severity_threshold = "High"
+  }
+
+  content_filter {
+    name               = "Hate"
+    source             = "Completion"
+    filter_enabled     = true
+    block_enabled      = true
+    severity_threshold = "High"
+  }
+}
+`, r.basic(data))
+}
+
+func (r CognitiveRaiPolicyTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_rai_blocklist" "test" {
+  name                 = "acctestblocklist%[2]d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+
+  item {
+    name    = "codename"
+    pattern = "Project Nightingale"
+  }
+}
+
+resource "azurerm_cognitive_account_rai_policy" "test" {
+  name                 = "acctestraipolicy%[2]d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  base_policy_name     = "Microsoft.Default"
+  mode                 = "Deferred"
+
+  content_filter {
+    name               = "Hate"
+    source             = "Prompt"
+    filter_enabled     = true
+    block_enabled      = true
+    severity_threshold = "Medium"
+  }
+
+  content_filter {
+    name               = "Hate"
+    source             = "Completion"
+    filter_enabled     = true
+    block_enabled      = true
+    severity_threshold = "Low"
+  }
+
+  content_filter {
+    name               = "Violence"
+    source             = "Prompt"
+    filter_enabled     = true
+    block_enabled      = false
+    severity_threshold = "High"
+  }
+
+  content_filter {
+    name           = "Jailbreak"
+    source         = "Prompt"
+    filter_enabled = true
+    block_enabled  = true
+  }
+
+  custom_blocklist {
+    blocklist_name = azurerm_cognitive_account_rai_blocklist.test.name
+    source         = "Prompt"
+    block_enabled  = true
+  }
+
+  custom_blocklist {
+    blocklist_name = azurerm_cognitive_account_rai_blocklist.test.name
+    source         = "Completion"
+    block_enabled  = true
+  }
+}
+`, CognitiveDeploymentTestResource{}.template(data), data.RandomInteger)
+}
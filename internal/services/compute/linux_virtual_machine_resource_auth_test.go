@@ -94,6 +94,30 @@ func TestAccLinuxVirtualMachine_linuxPatchModeSetting(t *testing.T) {
 	})
 }
 
+func TestAccLinuxVirtualMachine_authPasswordWriteOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_virtual_machine", "test")
+	r := LinuxVirtualMachineResource{}
+
+	data.ResourceTestWriteOnly(t, r, []acceptance.TestStep{
+		{
+			Config: r.authPasswordWriteOnly(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("admin_password").IsEmpty(),
+			),
+		},
+		data.ImportStep("admin_password", "admin_password_wo_version"),
+		{
+			// changing the version recreates the Virtual Machine with the new password
+			Config: r.authPasswordWriteOnly(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password", "admin_password_wo_version"),
+	})
+}
+
 func (r LinuxVirtualMachineResource) authPassword(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -267,3 +291,35 @@ resource "azurerm_linux_virtual_machine" "test" {
 }
 `, r.template(data), data.RandomInteger, patchMode)
 }
+
+func (r LinuxVirtualMachineResource) authPasswordWriteOnly(data acceptance.TestData, version int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestVM-%d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_F2"
+  admin_username                  = "adminuser"
+  admin_password_wo               = "P@$$w0rd1234!"
+  admin_password_wo_version       = %d
+  disable_password_authentication = false
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, r.template(data), data.RandomInteger, version)
+}
@@ -69,11 +69,29 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 			// Required
 			"admin_password": {
 				Type:             pluginsdk.TypeString,
-				Required:         true,
+				Optional:         true,
 				ForceNew:         true,
 				Sensitive:        true,
 				DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 				ValidateFunc:     computeValidate.WindowsAdminPassword,
+				ExactlyOneOf:     []string{"admin_password", "admin_password_wo"},
+			},
+
+			"admin_password_wo": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				ValidateFunc: computeValidate.WindowsAdminPassword,
+				ExactlyOneOf: []string{"admin_password", "admin_password_wo"},
+			},
+
+			// the Admin Password can only be set when the Virtual Machine is created, so changing this recreates it
+			"admin_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"admin_password_wo"},
 			},
 
 			"admin_username": {
@@ -467,6 +485,12 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 	additionalUnattendContent := expandAdditionalUnattendContent(additionalUnattendContentRaw)
 
 	adminPassword := d.Get("admin_password").(string)
+	if adminPassword == "" {
+		adminPassword, err = pluginsdk.GetWriteOnlyString(d, "admin_password_wo")
+		if err != nil {
+			return err
+		}
+	}
 	adminUsername := d.Get("admin_username").(string)
 	allowExtensionOperations := d.Get("allow_extension_operations").(bool)
 
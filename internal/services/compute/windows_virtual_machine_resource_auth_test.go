@@ -26,6 +26,30 @@ func TestAccWindowsVirtualMachine_authPassword(t *testing.T) {
 	})
 }
 
+func TestAccWindowsVirtualMachine_authPasswordWriteOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_windows_virtual_machine", "test")
+	r := WindowsVirtualMachineResource{}
+
+	data.ResourceTestWriteOnly(t, r, []acceptance.TestStep{
+		{
+			Config: r.authPasswordWriteOnly(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("admin_password").IsEmpty(),
+			),
+		},
+		data.ImportStep("admin_password", "admin_password_wo_version"),
+		{
+			// changing the version recreates the Virtual Machine with the new password
+			Config: r.authPasswordWriteOnly(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password", "admin_password_wo_version"),
+	})
+}
+
 func (r WindowsVirtualMachineResource) authPassword(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -55,3 +79,34 @@ resource "azurerm_windows_virtual_machine" "test" {
 }
 `, r.template(data))
 }
+
+func (r WindowsVirtualMachineResource) authPasswordWriteOnly(data acceptance.TestData, version int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                      = local.vm_name
+  resource_group_name       = azurerm_resource_group.test.name
+  location                  = azurerm_resource_group.test.location
+  size                      = "Standard_F2"
+  admin_username            = "adminuser"
+  admin_password_wo         = "P@$$w0rd1234!"
+  admin_password_wo_version = %d
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+}
+`, r.template(data), version)
+}
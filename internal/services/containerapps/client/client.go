@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerappsrevisions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/daprcomponents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/jobs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedcertificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedenvironmentsstorages"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -21,6 +22,7 @@ type Client struct {
 	ContainerAppClient         *containerapps.ContainerAppsClient
 	ContainerAppRevisionClient *containerappsrevisions.ContainerAppsRevisionsClient
 	DaprComponentsClient       *daprcomponents.DaprComponentsClient
+	ManagedCertificatesClient  *managedcertificates.ManagedCertificatesClient
 	ManagedEnvironmentClient   *managedenvironments.ManagedEnvironmentsClient
 	StorageClient              *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
 	JobClient                  *jobs.JobsClient
@@ -45,6 +47,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(containerAppsRevisionsClient.Client, o.Authorizers.ResourceManager)
 
+	managedCertificatesClient, err := managedcertificates.NewManagedCertificatesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Managed Certificates client : %+v", err)
+	}
+	o.Configure(managedCertificatesClient.Client, o.Authorizers.ResourceManager)
+
 	managedEnvironmentClient, err := managedenvironments.NewManagedEnvironmentsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Managed Environment client : %+v", err)
@@ -74,6 +82,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ContainerAppClient:         containerAppsClient,
 		ContainerAppRevisionClient: containerAppsRevisionsClient,
 		DaprComponentsClient:       daprComponentClient,
+		ManagedCertificatesClient:  managedCertificatesClient,
 		ManagedEnvironmentClient:   managedEnvironmentClient,
 		StorageClient:              managedEnvironmentStoragesClient,
 		JobClient:                  jobsClient,
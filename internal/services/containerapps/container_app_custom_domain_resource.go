@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedcertificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -28,10 +29,11 @@ type ContainerAppCustomDomainResource struct{}
 var _ sdk.Resource = ContainerAppCustomDomainResource{}
 
 type ContainerAppCustomDomainResourceModel struct {
-	Name           string `tfschema:"name"`
-	ContainerAppId string `tfschema:"container_app_id"`
-	CertificateId  string `tfschema:"container_app_environment_certificate_id"`
-	BindingType    string `tfschema:"certificate_binding_type"`
+	Name                 string `tfschema:"name"`
+	ContainerAppId       string `tfschema:"container_app_id"`
+	CertificateId        string `tfschema:"container_app_environment_certificate_id"`
+	ManagedCertificateId string `tfschema:"container_app_environment_managed_certificate_id"`
+	BindingType          string `tfschema:"certificate_binding_type"`
 }
 
 func (a ContainerAppCustomDomainResource) Arguments() map[string]*pluginsdk.Schema {
@@ -52,11 +54,22 @@ func (a ContainerAppCustomDomainResource) Arguments() map[string]*pluginsdk.Sche
 		},
 
 		"container_app_environment_certificate_id": {
-			Type:         pluginsdk.TypeString,
-			Optional:     true,
-			ForceNew:     true,
-			RequiredWith: []string{"certificate_binding_type"},
-			ValidateFunc: managedenvironments.ValidateCertificateID,
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			RequiredWith:  []string{"certificate_binding_type"},
+			ConflictsWith: []string{"container_app_environment_managed_certificate_id"},
+			ValidateFunc:  managedenvironments.ValidateCertificateID,
+		},
+
+		"container_app_environment_managed_certificate_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			RequiredWith:  []string{"certificate_binding_type"},
+			ConflictsWith: []string{"container_app_environment_certificate_id"},
+			ValidateFunc:  managedenvironments.ValidateManagedCertificateID,
+			Description:   "The ID of the Container App Environment Managed Certificate to bind to this Custom Domain. The Managed Certificate is bound once it has been issued.",
 		},
 
 		"certificate_binding_type": {
@@ -87,7 +100,7 @@ func (a ContainerAppCustomDomainResource) IDValidationFunc() pluginsdk.SchemaVal
 
 func (a ContainerAppCustomDomainResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
-		Timeout: 30 * time.Minute,
+		Timeout: 60 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.ContainerApps.ContainerAppClient
 
@@ -107,12 +120,26 @@ func (a ContainerAppCustomDomainResource) Create() sdk.ResourceFunc {
 
 			id := parse.NewContainerAppCustomDomainId(containerAppId.SubscriptionId, containerAppId.ResourceGroupName, containerAppId.ContainerAppName, model.Name)
 
-			var certificateId *managedenvironments.CertificateId
+			certificateId := ""
 			if model.CertificateId != "" {
-				certificateId, err = managedenvironments.ParseCertificateID(model.CertificateId)
+				parsed, err := managedenvironments.ParseCertificateID(model.CertificateId)
+				if err != nil {
+					return err
+				}
+				certificateId = parsed.ID()
+			}
+
+			if model.ManagedCertificateId != "" {
+				managedCertificateId, err := managedenvironments.ParseManagedCertificateID(model.ManagedCertificateId)
 				if err != nil {
 					return err
 				}
+				certificateId = managedCertificateId.ID()
+
+				// a Managed Certificate can only be bound once the domain has been validated and it's been issued
+				if err := waitForContainerAppManagedCertificateIssued(ctx, metadata.Client.ContainerApps.ManagedCertificatesClient, *managedCertificateId); err != nil {
+					return err
+				}
 			}
 
 			containerApp, err := client.Get(ctx, *containerAppId)
@@ -146,11 +173,16 @@ func (a ContainerAppCustomDomainResource) Create() sdk.ResourceFunc {
 			if existingCustomDomains := ingress.CustomDomains; existingCustomDomains != nil {
 				for _, v := range *existingCustomDomains {
 					if strings.EqualFold(v.Name, model.Name) {
+						// the hostname is added without a Certificate before a Managed Certificate is requested for it
+						// (e.g. by `azurerm_container_app_environment_managed_certificate`), so that entry is replaced
+						if model.ManagedCertificateId != "" && pointer.From(v.CertificateId) == "" {
+							continue
+						}
 						return metadata.ResourceRequiresImport(ContainerAppCustomDomainResource{}.ResourceType(), id)
 					}
-				}
 
-				customDomains = *existingCustomDomains
+					customDomains = append(customDomains, v)
+				}
 			}
 
 			customDomain := containerapps.CustomDomain{
@@ -158,8 +190,8 @@ func (a ContainerAppCustomDomainResource) Create() sdk.ResourceFunc {
 				BindingType: pointer.To(containerapps.BindingTypeDisabled),
 			}
 
-			if certificateId != nil {
-				customDomain.CertificateId = pointer.To(certificateId.ID())
+			if certificateId != "" {
+				customDomain.CertificateId = pointer.To(certificateId)
 				customDomain.BindingType = pointer.To(containerapps.BindingType(model.BindingType))
 			}
 
@@ -210,12 +242,16 @@ func (a ContainerAppCustomDomainResource) Read() sdk.ResourceFunc {
 						found = true
 						state.Name = id.CustomDomainName
 						state.ContainerAppId = containerAppId.ID()
-						if pointer.From(v.CertificateId) != "" {
-							certId, err := managedenvironments.ParseCertificateIDInsensitively(pointer.From(v.CertificateId))
-							if err != nil {
-								return err
+						if certificateId := pointer.From(v.CertificateId); certificateId != "" {
+							if managedCertificateId, err := managedenvironments.ParseManagedCertificateIDInsensitively(certificateId); err == nil {
+								state.ManagedCertificateId = managedCertificateId.ID()
+							} else {
+								certId, err := managedenvironments.ParseCertificateIDInsensitively(certificateId)
+								if err != nil {
+									return err
+								}
+								state.CertificateId = certId.ID()
 							}
-							state.CertificateId = certId.ID()
 						}
 
 						state.BindingType = string(pointer.From(v.BindingType))
@@ -250,6 +286,12 @@ func (a ContainerAppCustomDomainResource) Delete() sdk.ResourceFunc {
 					defer locks.UnlockByID(certId.ID())
 				}
 			}
+			if certIdRaw := metadata.ResourceData.Get("container_app_environment_managed_certificate_id").(string); certIdRaw != "" {
+				if certId, err := managedenvironments.ParseManagedCertificateID(certIdRaw); err == nil {
+					locks.ByID(certId.ID())
+					defer locks.UnlockByID(certId.ID())
+				}
+			}
 
 			containerAppId := containerapps.NewContainerAppID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName)
 
@@ -293,3 +335,46 @@ func (a ContainerAppCustomDomainResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+func waitForContainerAppManagedCertificateIssued(ctx context.Context, client *managedcertificates.ManagedCertificatesClient, id managedenvironments.ManagedCertificateId) error {
+	certificateId := managedcertificates.NewManagedCertificateID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName, id.ManagedCertificateName)
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(managedcertificates.CertificateProvisioningStatePending),
+		},
+		Target: []string{
+			string(managedcertificates.CertificateProvisioningStateSucceeded),
+		},
+		MinTimeout:   30 * time.Second,
+		PollInterval: 30 * time.Second,
+		Timeout:      time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, certificateId)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", certificateId, err)
+			}
+			if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.ProvisioningState == nil {
+				return nil, "", fmt.Errorf("retrieving %s: `properties.provisioningState` was nil", certificateId)
+			}
+
+			state := *resp.Model.Properties.ProvisioningState
+			if state != managedcertificates.CertificateProvisioningStatePending && state != managedcertificates.CertificateProvisioningStateSucceeded {
+				return resp, string(state), fmt.Errorf("%s failed to be issued: %s", certificateId, pointer.From(resp.Model.Properties.Error))
+			}
+
+			return resp, string(state), nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be issued: %+v", certificateId, err)
+	}
+
+	return nil
+}
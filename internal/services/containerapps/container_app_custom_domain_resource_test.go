@@ -94,6 +94,25 @@ func TestAccContainerAppCustomDomainResource_managedCertificate(t *testing.T) {
 	})
 }
 
+func TestAccContainerAppCustomDomainResource_managedCertificateBinding(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skipf("Skipping as either ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_container_app_custom_domain", "test")
+	r := ContainerAppCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedCertificateBinding(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccContainerAppCustomDomainResource_multiple(t *testing.T) {
 	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
 		t.Skipf("Skipping as either ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
@@ -177,7 +196,7 @@ resource "azurerm_container_app_custom_domain" "test" {
   container_app_id = azurerm_container_app.test.id
 
   lifecycle {
-    ignore_changes = [certificate_binding_type, container_app_environment_certificate_id]
+    ignore_changes = [certificate_binding_type, container_app_environment_certificate_id, container_app_environment_managed_certificate_id]
   }
 }
 
@@ -185,6 +204,44 @@ resource "azurerm_container_app_custom_domain" "test" {
 `, r.template(data))
 }
 
+func (r ContainerAppCustomDomainResource) managedCertificateBinding(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider azurerm {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_container_app_environment_managed_certificate" "test" {
+  name                         = "acctest-camcert%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  container_app_id             = azurerm_container_app.test.id
+  subject_name                 = trimprefix(azurerm_dns_txt_record.test.fqdn, "asuid.")
+  domain_control_validation    = "TXT"
+}
+
+resource "azurerm_dns_txt_record" "validation" {
+  name                = "_dnsauth.containerapp%[2]d"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    value = azurerm_container_app_environment_managed_certificate.test.validation_token
+  }
+}
+
+resource "azurerm_container_app_custom_domain" "test" {
+  name                                             = azurerm_container_app_environment_managed_certificate.test.subject_name
+  container_app_id                                 = azurerm_container_app.test.id
+  container_app_environment_managed_certificate_id = azurerm_container_app_environment_managed_certificate.test.id
+  certificate_binding_type                         = "SniEnabled"
+
+  depends_on = [azurerm_dns_txt_record.validation]
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r ContainerAppCustomDomainResource) multiple(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider azurerm {
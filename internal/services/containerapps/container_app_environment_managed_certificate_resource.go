@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedcertificates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppEnvironmentManagedCertificateResource struct{}
+
+type ContainerAppManagedCertificateModel struct {
+	Name                    string                 `tfschema:"name"`
+	ManagedEnvironmentId    string                 `tfschema:"container_app_environment_id"`
+	SubjectName             string                 `tfschema:"subject_name"`
+	DomainControlValidation string                 `tfschema:"domain_control_validation"`
+	ContainerAppId          string                 `tfschema:"container_app_id"`
+	Tags                    map[string]interface{} `tfschema:"tags"`
+
+	ValidationToken string `tfschema:"validation_token"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentManagedCertificateResource{}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) ModelObject() interface{} {
+	return &ContainerAppManagedCertificateModel{}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) ResourceType() string {
+	return "azurerm_container_app_environment_managed_certificate"
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedcertificates.ValidateManagedCertificateID
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CertificateName,
+			Description:  "The name of the Container Apps Environment Managed Certificate.",
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedcertificates.ValidateManagedEnvironmentID,
+			Description:  "The Container App Managed Environment ID to configure this Managed Certificate on.",
+		},
+
+		"subject_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The hostname to issue the Managed Certificate for.",
+		},
+
+		"domain_control_validation": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(managedcertificates.ManagedCertificateDomainControlValidationHTTP),
+				string(managedcertificates.ManagedCertificateDomainControlValidationTXT),
+			}, false),
+			Description: "How the ownership of the hostname is validated. Possible values are `HTTP` and `TXT`.",
+		},
+
+		"container_app_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: containerapps.ValidateContainerAppID,
+			Description:  "The ID of a Container App in the Environment to add the `subject_name` to as a Custom Domain without a Certificate before the Managed Certificate is requested.",
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"validation_token": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The token to add as a `TXT` record named `_dnsauth.{subject_name}` when `domain_control_validation` is `TXT`.",
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedCertificatesClient
+			environmentsClient := metadata.Client.ContainerApps.ManagedEnvironmentClient
+
+			var cert ContainerAppManagedCertificateModel
+			if err := metadata.Decode(&cert); err != nil {
+				return err
+			}
+
+			envId, err := managedenvironments.ParseManagedEnvironmentID(cert.ManagedEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := managedcertificates.NewManagedCertificateID(envId.SubscriptionId, envId.ResourceGroupName, envId.ManagedEnvironmentName, cert.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			env, err := environmentsClient.Get(ctx, *envId)
+			if err != nil {
+				return fmt.Errorf("reading %s for %s: %+v", *envId, id, err)
+			}
+			if env.Model == nil {
+				return fmt.Errorf("reading %s for %s: model was nil", *envId, id)
+			}
+
+			// the hostname has to be added to a Container App in the Environment before a Managed Certificate can be
+			// requested for it
+			if cert.ContainerAppId != "" {
+				containerAppId, err := containerapps.ParseContainerAppID(cert.ContainerAppId)
+				if err != nil {
+					return err
+				}
+
+				if err := addContainerAppCustomDomainWithoutCertificate(ctx, metadata.Client.ContainerApps.ContainerAppClient, *containerAppId, cert.SubjectName); err != nil {
+					return err
+				}
+			}
+
+			model := managedcertificates.ManagedCertificate{
+				Location: env.Model.Location,
+				Name:     pointer.To(id.ManagedCertificateName),
+				Properties: &managedcertificates.ManagedCertificateProperties{
+					SubjectName:             pointer.To(cert.SubjectName),
+					DomainControlValidation: pointer.To(managedcertificates.ManagedCertificateDomainControlValidation(cert.DomainControlValidation)),
+				},
+				Tags: tags.Expand(cert.Tags),
+			}
+
+			// the Managed Certificate is only issued once the domain has been validated, which for `TXT` validation
+			// needs the `validation_token` from this resource - so this doesn't wait for the Certificate to be issued,
+			// `azurerm_container_app_custom_domain` waits for this when binding the Managed Certificate instead
+			if _, err := client.CreateOrUpdate(ctx, id, model); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedCertificatesClient
+
+			id, err := managedcertificates.ParseManagedCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			var state ContainerAppManagedCertificateModel
+
+			state.Name = id.ManagedCertificateName
+			state.ManagedEnvironmentId = managedcertificates.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID()
+
+			// the Container App isn't returned by the API
+			if v, ok := metadata.ResourceData.GetOk("container_app_id"); ok {
+				state.ContainerAppId = v.(string)
+			}
+
+			if model := existing.Model; model != nil {
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.SubjectName = pointer.From(props.SubjectName)
+					state.DomainControlValidation = string(pointer.From(props.DomainControlValidation))
+					state.ValidationToken = pointer.From(props.ValidationToken)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedCertificatesClient
+
+			var cert ContainerAppManagedCertificateModel
+			if err := metadata.Decode(&cert); err != nil {
+				return err
+			}
+
+			id, err := managedcertificates.ParseManagedCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				patch := managedcertificates.ManagedCertificatePatch{
+					Tags: tags.Expand(cert.Tags),
+				}
+
+				if _, err = client.Update(ctx, *id, patch); err != nil {
+					return fmt.Errorf("updating tags for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedCertificatesClient
+
+			var cert ContainerAppManagedCertificateModel
+			if err := metadata.Decode(&cert); err != nil {
+				return err
+			}
+
+			id, err := managedcertificates.ParseManagedCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if cert.ContainerAppId != "" {
+				containerAppId, err := containerapps.ParseContainerAppID(cert.ContainerAppId)
+				if err != nil {
+					return err
+				}
+
+				if err := removeContainerAppCustomDomainWithoutCertificate(ctx, metadata.Client.ContainerApps.ContainerAppClient, *containerAppId, cert.SubjectName); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// addContainerAppCustomDomainWithoutCertificate adds the hostname to the Container App as a Custom Domain without a
+// Certificate, unless the hostname has already been added.
+func addContainerAppCustomDomainWithoutCertificate(ctx context.Context, client *containerapps.ContainerAppsClient, id containerapps.ContainerAppId, hostName string) error {
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	containerApp, err := client.Get(ctx, id)
+	if err != nil || containerApp.Model == nil {
+		return fmt.Errorf("retrieving %s to add the Custom Domain %q: %+v", id, hostName, err)
+	}
+
+	props := containerApp.Model.Properties
+	if props == nil || props.Configuration == nil || props.Configuration.Ingress == nil {
+		return fmt.Errorf("specified Container App (%s) has no Ingress configuration for Custom Domains", id)
+	}
+
+	customDomains := pointer.From(props.Configuration.Ingress.CustomDomains)
+	for _, v := range customDomains {
+		if strings.EqualFold(v.Name, hostName) {
+			return nil
+		}
+	}
+
+	// Delta-updates need the secrets back from the list API, or we'll end up removing them or erroring out.
+	secretsResp, err := client.ListSecrets(ctx, id)
+	if err != nil || secretsResp.Model == nil {
+		if !response.WasStatusCode(secretsResp.HttpResponse, http.StatusNoContent) {
+			return fmt.Errorf("retrieving secrets for update for %s: %+v", id, err)
+		}
+	}
+	props.Configuration.Secrets = helpers.UnpackContainerSecretsCollection(secretsResp.Model)
+
+	customDomains = append(customDomains, containerapps.CustomDomain{
+		Name:        hostName,
+		BindingType: pointer.To(containerapps.BindingTypeDisabled),
+	})
+	props.Configuration.Ingress.CustomDomains = pointer.To(customDomains)
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, *containerApp.Model); err != nil {
+		return fmt.Errorf("adding the Custom Domain %q to %s: %+v", hostName, id, err)
+	}
+
+	return nil
+}
+
+// removeContainerAppCustomDomainWithoutCertificate removes the hostname from the Container App, if it's still there and
+// no Certificate has been bound to it.
+func removeContainerAppCustomDomainWithoutCertificate(ctx context.Context, client *containerapps.ContainerAppsClient, id containerapps.ContainerAppId, hostName string) error {
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	containerApp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(containerApp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s to remove the Custom Domain %q: %+v", id, hostName, err)
+	}
+
+	if containerApp.Model == nil {
+		return nil
+	}
+	props := containerApp.Model.Properties
+	if props == nil || props.Configuration == nil || props.Configuration.Ingress == nil {
+		return nil
+	}
+
+	found := false
+	updatedCustomDomains := make([]containerapps.CustomDomain, 0)
+	for _, v := range pointer.From(props.Configuration.Ingress.CustomDomains) {
+		if strings.EqualFold(v.Name, hostName) && pointer.From(v.CertificateId) == "" {
+			found = true
+			continue
+		}
+		updatedCustomDomains = append(updatedCustomDomains, v)
+	}
+	if !found {
+		return nil
+	}
+
+	// Delta-updates need the secrets back from the list API, or we'll end up removing them or erroring out.
+	secretsResp, err := client.ListSecrets(ctx, id)
+	if err != nil || secretsResp.Model == nil {
+		if !response.WasStatusCode(secretsResp.HttpResponse, http.StatusNoContent) {
+			return fmt.Errorf("retrieving secrets for update for %s: %+v", id, err)
+		}
+	}
+	props.Configuration.Secrets = helpers.UnpackContainerSecretsCollection(secretsResp.Model)
+	props.Configuration.Ingress.CustomDomains = pointer.To(updatedCustomDomains)
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, *containerApp.Model); err != nil {
+		return fmt.Errorf("removing the Custom Domain %q from %s: %+v", hostName, id, err)
+	}
+
+	return nil
+}
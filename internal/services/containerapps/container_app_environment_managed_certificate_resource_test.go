@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedcertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppEnvironmentManagedCertificateResource struct{}
+
+func TestAccContainerAppEnvironmentManagedCertificate_txt(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skipf("Skipping as either ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_managed_certificate", "test")
+	r := ContainerAppEnvironmentManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.txt(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("validation_token").IsSet(),
+			),
+		},
+		data.ImportStep("container_app_id"),
+	})
+}
+
+func TestAccContainerAppEnvironmentManagedCertificate_updateTags(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skipf("Skipping as either ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_managed_certificate", "test")
+	r := ContainerAppEnvironmentManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.txt(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("container_app_id"),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("container_app_id"),
+	})
+}
+
+func TestAccContainerAppEnvironmentManagedCertificate_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skipf("Skipping as either ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_managed_certificate", "test")
+	r := ContainerAppEnvironmentManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.txt(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := managedcertificates.ParseManagedCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.ManagedCertificatesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) txt(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_container_app_environment_managed_certificate" "test" {
+  name                         = "acctest-camcert%d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  container_app_id             = azurerm_container_app.test.id
+  subject_name                 = trimprefix(azurerm_dns_txt_record.test.fqdn, "asuid.")
+  domain_control_validation    = "TXT"
+}
+`, ContainerAppCustomDomainResource{}.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_container_app_environment_managed_certificate" "test" {
+  name                         = "acctest-camcert%d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  container_app_id             = azurerm_container_app.test.id
+  subject_name                 = trimprefix(azurerm_dns_txt_record.test.fqdn, "asuid.")
+  domain_control_validation    = "TXT"
+
+  tags = {
+    env = "testAcc"
+  }
+}
+`, ContainerAppCustomDomainResource{}.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_environment_managed_certificate" "import" {
+  name                         = azurerm_container_app_environment_managed_certificate.test.name
+  container_app_environment_id = azurerm_container_app_environment_managed_certificate.test.container_app_environment_id
+  container_app_id             = azurerm_container_app_environment_managed_certificate.test.container_app_id
+  subject_name                 = azurerm_container_app_environment_managed_certificate.test.subject_name
+  domain_control_validation    = azurerm_container_app_environment_managed_certificate.test.domain_control_validation
+}
+`, r.txt(data))
+}
@@ -34,6 +34,7 @@ type ContainerAppModel struct {
 
 	RevisionMode string                      `tfschema:"revision_mode"`
 	Ingress      []helpers.Ingress           `tfschema:"ingress"`
+	TrafficShift []helpers.TrafficShift      `tfschema:"traffic_shift"`
 	Registries   []helpers.Registry          `tfschema:"registry"`
 	Secrets      []helpers.Secret            `tfschema:"secret"`
 	Dapr         []helpers.Dapr              `tfschema:"dapr"`
@@ -98,6 +99,8 @@ func (r ContainerAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"ingress": helpers.ContainerAppIngressSchema(),
 
+		"traffic_shift": helpers.ContainerAppTrafficShiftSchema(),
+
 		"registry": helpers.ContainerAppRegistrySchema(),
 
 		"secret": helpers.SecretsSchema(),
@@ -250,6 +253,14 @@ func (r ContainerAppResource) Read() sdk.ResourceFunc {
 			}
 
 			var state ContainerAppModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			// `traffic_shift` only controls how updates are applied, so it's kept from the configuration
+			state = ContainerAppModel{
+				TrafficShift: state.TrafficShift,
+			}
 
 			state.Name = id.ContainerAppName
 			state.ResourceGroup = id.ResourceGroupName
@@ -362,8 +373,24 @@ func (r ContainerAppResource) Update() sdk.ResourceFunc {
 				model.Properties.Configuration.ActiveRevisionsMode = pointer.To(containerapps.ActiveRevisionsMode(state.RevisionMode))
 			}
 
+			// when shifting traffic in steps the update is applied with the current traffic weights first, the new
+			// traffic weights are then applied gradually once the (new) latest revision has been provisioned
+			shiftTraffic := len(state.TrafficShift) > 0 && len(state.Ingress) > 0 &&
+				state.RevisionMode == string(containerapps.ActiveRevisionsModeMultiple) &&
+				!metadata.ResourceData.HasChange("revision_mode") &&
+				metadata.ResourceData.HasChange("ingress.0.traffic_weight") &&
+				model.Properties.Configuration.Ingress != nil
+			var currentTraffic, targetTraffic []containerapps.TrafficWeight
+			if shiftTraffic {
+				currentTraffic = helpers.PinContainerAppIngressTraffic(model.Properties.Configuration.Ingress.Traffic, pointer.From(model.Properties.LatestRevisionName))
+			}
+
 			if metadata.ResourceData.HasChange("ingress") {
 				model.Properties.Configuration.Ingress = helpers.ExpandContainerAppIngress(state.Ingress, id.ContainerAppName)
+				if shiftTraffic && model.Properties.Configuration.Ingress != nil {
+					targetTraffic = pointer.From(model.Properties.Configuration.Ingress.Traffic)
+					model.Properties.Configuration.Ingress.Traffic = pointer.To(currentTraffic)
+				}
 			}
 
 			if metadata.ResourceData.HasChange("registry") {
@@ -420,6 +447,12 @@ func (r ContainerAppResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
+			if len(targetTraffic) > 0 {
+				if err := helpers.ShiftContainerAppTraffic(ctx, client, metadata.Client.ContainerApps.ContainerAppRevisionClient, *id, currentTraffic, targetTraffic, state.TrafficShift[0]); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -472,6 +505,10 @@ func (r ContainerAppResource) CustomizeDiff() sdk.ResourceFunc {
 				}
 			}
 
+			if len(app.TrafficShift) > 0 && app.RevisionMode != string(containerapps.ActiveRevisionsModeMultiple) {
+				return fmt.Errorf("`traffic_shift` can only be specified when `revision_mode` is `Multiple`")
+			}
+
 			for _, s := range app.Secrets {
 				if s.KeyVaultSecretId != "" && s.Identity == "" {
 					return fmt.Errorf("secret %s must supply identity for key vault secret id", s.Name)
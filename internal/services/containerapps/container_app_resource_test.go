@@ -584,6 +584,28 @@ func TestAccContainerAppResource_ingressTrafficValidation(t *testing.T) {
 	})
 }
 
+func TestAccContainerAppResource_trafficShift(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app", "test")
+	r := ContainerAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.trafficShift(data, "rev1", r.trafficBlockLatestRevisionOnly()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("traffic_shift"),
+		{
+			Config: r.trafficShift(data, "rev2", r.trafficBlockShiftToLatestRevision("rev1")),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("traffic_shift"),
+	})
+}
+
 func (r ContainerAppResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := containerapps.ParseContainerAppID(state.ID)
 	if err != nil {
@@ -2842,6 +2864,66 @@ resource "azurerm_container_app" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ContainerAppResource) trafficShift(data acceptance.TestData, revisionSuffix string, trafficBlock string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Multiple"
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+
+    revision_suffix = "%[3]s"
+  }
+
+  ingress {
+    allow_insecure_connections = true
+    external_enabled           = true
+    target_port                = 5000
+    transport                  = "http"
+	%[4]s
+  }
+
+  traffic_shift {
+    step_percentage          = 50
+    step_interval_in_seconds = 30
+  }
+}
+`, r.template(data), data.RandomInteger, revisionSuffix, trafficBlock)
+}
+
+func (r ContainerAppResource) trafficBlockLatestRevisionOnly() string {
+	return `
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+`
+}
+
+func (r ContainerAppResource) trafficBlockShiftToLatestRevision(previousRevisionSuffix string) string {
+	return fmt.Sprintf(`
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+
+    traffic_weight {
+      revision_suffix = "%s"
+      percentage      = 0
+    }
+`, previousRevisionSuffix)
+}
+
 func (r ContainerAppResource) trafficBlockMoreThanOne() string {
 	return `
 traffic_weight {
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerappsrevisions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type TrafficShift struct {
+	StepPercentage        int64 `tfschema:"step_percentage"`
+	StepIntervalInSeconds int64 `tfschema:"step_interval_in_seconds"`
+}
+
+func ContainerAppTrafficShiftSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Shift traffic to the new `traffic_weight` configuration in steps when `revision_mode` is `Multiple`, rather than all at once.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"step_percentage": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 100),
+					Description:  "The maximum percentage of traffic to move between revisions in each step.",
+				},
+
+				"step_interval_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      60,
+					ValidateFunc: validation.IntBetween(0, 3600),
+					Description:  "The number of seconds to wait between two steps. Defaults to `60`.",
+				},
+			},
+		},
+	}
+}
+
+// PinContainerAppIngressTraffic replaces the `latestRevision` traffic weights with the name of the current latest
+// revision, so that a new revision created by an update doesn't receive the traffic of the latest revision straight away.
+func PinContainerAppIngressTraffic(input *[]containerapps.TrafficWeight, latestRevisionName string) []containerapps.TrafficWeight {
+	if input == nil || len(*input) == 0 {
+		return []containerapps.TrafficWeight{
+			{
+				RevisionName:   pointer.To(latestRevisionName),
+				LatestRevision: pointer.To(false),
+				Weight:         pointer.To(int64(100)),
+			},
+		}
+	}
+
+	result := make([]containerapps.TrafficWeight, 0)
+	for _, v := range *input {
+		if pointer.From(v.LatestRevision) {
+			v.RevisionName = pointer.To(latestRevisionName)
+			v.LatestRevision = pointer.To(false)
+		}
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// ContainerAppTrafficShiftSteps returns the intermediate traffic weights to move from `current` to `target`, moving at
+// most `stepPercentage` between revisions in each step. Both `current` and `target` must reference revisions by name.
+// The final step (`target` itself) isn't included.
+func ContainerAppTrafficShiftSteps(current []containerapps.TrafficWeight, target []containerapps.TrafficWeight, stepPercentage int64) [][]containerapps.TrafficWeight {
+	if stepPercentage <= 0 {
+		return nil
+	}
+
+	revisions := make([]string, 0)
+	currentWeights := make(map[string]int64)
+	targetWeights := make(map[string]int64)
+	labels := make(map[string]string)
+	for _, v := range target {
+		name := strings.ToLower(pointer.From(v.RevisionName))
+		if _, ok := targetWeights[name]; !ok {
+			revisions = append(revisions, pointer.From(v.RevisionName))
+		}
+		targetWeights[name] += pointer.From(v.Weight)
+		if label := pointer.From(v.Label); label != "" {
+			labels[name] = label
+		}
+	}
+	for _, v := range current {
+		name := strings.ToLower(pointer.From(v.RevisionName))
+		if _, ok := targetWeights[name]; !ok {
+			if _, ok := currentWeights[name]; !ok {
+				revisions = append(revisions, pointer.From(v.RevisionName))
+			}
+		}
+		currentWeights[name] += pointer.From(v.Weight)
+		if label := pointer.From(v.Label); label != "" && labels[name] == "" {
+			labels[name] = label
+		}
+	}
+
+	var maxDelta int64
+	for _, revision := range revisions {
+		name := strings.ToLower(revision)
+		if delta := targetWeights[name] - currentWeights[name]; delta > maxDelta {
+			maxDelta = delta
+		} else if -delta > maxDelta {
+			maxDelta = -delta
+		}
+	}
+
+	stepCount := int64(math.Ceil(float64(maxDelta) / float64(stepPercentage)))
+	steps := make([][]containerapps.TrafficWeight, 0)
+	for step := int64(1); step < stepCount; step++ {
+		weights := make([]int64, len(revisions))
+		var total int64
+		var increasingIndex int
+		var increasingDelta int64
+		for i, revision := range revisions {
+			name := strings.ToLower(revision)
+			delta := targetWeights[name] - currentWeights[name]
+			weights[i] = currentWeights[name] + int64(math.Round(float64(delta*step)/float64(stepCount)))
+			total += weights[i]
+			if delta > increasingDelta {
+				increasingIndex = i
+				increasingDelta = delta
+			}
+		}
+
+		// rounding can leave the total slightly off 100, so the difference is given to the revision gaining the most
+		weights[increasingIndex] += 100 - total
+
+		weight := make([]containerapps.TrafficWeight, 0)
+		for i, revision := range revisions {
+			trafficWeight := containerapps.TrafficWeight{
+				RevisionName:   pointer.To(revision),
+				LatestRevision: pointer.To(false),
+				Weight:         pointer.To(weights[i]),
+			}
+			if label := labels[strings.ToLower(revision)]; label != "" {
+				trafficWeight.Label = pointer.To(label)
+			}
+			weight = append(weight, trafficWeight)
+		}
+		steps = append(steps, weight)
+	}
+
+	return steps
+}
+
+// ShiftContainerAppTraffic moves the traffic of the Container App from `current` to `target` in steps, waiting between
+// each step. The traffic is moved back to `current` if any revision receiving traffic becomes unhealthy along the way.
+func ShiftContainerAppTraffic(ctx context.Context, client *containerapps.ContainerAppsClient, revisionsClient *containerappsrevisions.ContainerAppsRevisionsClient, id containerapps.ContainerAppId, current []containerapps.TrafficWeight, target []containerapps.TrafficWeight, config TrafficShift) error {
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+	latestRevisionName := pointer.From(existing.Model.Properties.LatestRevisionName)
+
+	// the latest revision is only known once the update has been applied, so it's resolved here for the steps
+	resolvedTarget := make([]containerapps.TrafficWeight, 0)
+	for _, v := range target {
+		if pointer.From(v.LatestRevision) {
+			v.RevisionName = pointer.To(latestRevisionName)
+			v.LatestRevision = pointer.To(false)
+		}
+		resolvedTarget = append(resolvedTarget, v)
+	}
+
+	steps := ContainerAppTrafficShiftSteps(current, resolvedTarget, config.StepPercentage)
+	steps = append(steps, target)
+
+	for i, step := range steps {
+		if i > 0 && config.StepIntervalInSeconds > 0 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("waiting to shift the traffic of %s: %+v", id, ctx.Err())
+			case <-time.After(time.Duration(config.StepIntervalInSeconds) * time.Second):
+			}
+		}
+
+		if err := checkContainerAppTrafficRevisionsHealthy(ctx, revisionsClient, id, resolvedTarget); err != nil {
+			log.Printf("[DEBUG] shifting the traffic of %s failed, moving the traffic back: %+v", id, err)
+			if rollbackErr := updateContainerAppTraffic(ctx, client, id, current); rollbackErr != nil {
+				return fmt.Errorf("moving the traffic of %s back after shifting failed with %+v: %+v", id, err, rollbackErr)
+			}
+			return fmt.Errorf("shifting the traffic of %s was cancelled: %+v", id, err)
+		}
+
+		log.Printf("[DEBUG] shifting the traffic of %s (step %d of %d)", id, i+1, len(steps))
+		if err := updateContainerAppTraffic(ctx, client, id, step); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkContainerAppTrafficRevisionsHealthy(ctx context.Context, client *containerappsrevisions.ContainerAppsRevisionsClient, id containerapps.ContainerAppId, traffic []containerapps.TrafficWeight) error {
+	for _, v := range traffic {
+		if pointer.From(v.Weight) == 0 || pointer.From(v.RevisionName) == "" {
+			continue
+		}
+
+		revisionId := containerappsrevisions.NewRevisionID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName, *v.RevisionName)
+		resp, err := client.GetRevision(ctx, revisionId)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", revisionId, err)
+		}
+		if resp.Model != nil && resp.Model.Properties != nil && pointer.From(resp.Model.Properties.HealthState) == containerappsrevisions.RevisionHealthStateUnhealthy {
+			return fmt.Errorf("%s is unhealthy", revisionId)
+		}
+	}
+
+	return nil
+}
+
+func updateContainerAppTraffic(ctx context.Context, client *containerapps.ContainerAppsClient, id containerapps.ContainerAppId, traffic []containerapps.TrafficWeight) error {
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	model := existing.Model
+	if model == nil || model.Properties == nil || model.Properties.Configuration == nil || model.Properties.Configuration.Ingress == nil {
+		return fmt.Errorf("retrieving %s: `properties.configuration.ingress` was nil", id)
+	}
+
+	// Delta-updates need the secrets back from the list API, or we'll end up removing them or erroring out.
+	secretsResp, err := client.ListSecrets(ctx, id)
+	if err != nil || secretsResp.Model == nil {
+		if !response.WasStatusCode(secretsResp.HttpResponse, http.StatusNoContent) {
+			return fmt.Errorf("retrieving secrets for update for %s: %+v", id, err)
+		}
+	}
+	model.Properties.Configuration.Secrets = UnpackContainerSecretsCollection(secretsResp.Model)
+	model.Properties.Configuration.Ingress.Traffic = pointer.To(traffic)
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, *model); err != nil {
+		return fmt.Errorf("updating the traffic weights for %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerapps"
+)
+
+func TestContainerAppTrafficShiftSteps(t *testing.T) {
+	weight := func(revision string, percentage int64) containerapps.TrafficWeight {
+		return containerapps.TrafficWeight{
+			RevisionName: pointer.To(revision),
+			Weight:       pointer.To(percentage),
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Current  []containerapps.TrafficWeight
+		Target   []containerapps.TrafficWeight
+		Step     int64
+		Expected [][]int64
+	}{
+		{
+			Name:     "single step",
+			Current:  []containerapps.TrafficWeight{weight("app--one", 100)},
+			Target:   []containerapps.TrafficWeight{weight("app--two", 100)},
+			Step:     100,
+			Expected: [][]int64{},
+		},
+		{
+			Name:    "even steps",
+			Current: []containerapps.TrafficWeight{weight("app--one", 100)},
+			Target:  []containerapps.TrafficWeight{weight("app--two", 100)},
+			Step:    25,
+			Expected: [][]int64{
+				{25, 75},
+				{50, 50},
+				{75, 25},
+			},
+		},
+		{
+			Name:    "uneven steps",
+			Current: []containerapps.TrafficWeight{weight("app--one", 100)},
+			Target:  []containerapps.TrafficWeight{weight("app--one", 70), weight("app--two", 30)},
+			Step:    20,
+			Expected: [][]int64{
+				{85, 15},
+			},
+		},
+		{
+			Name:    "three revisions",
+			Current: []containerapps.TrafficWeight{weight("app--one", 50), weight("app--two", 50)},
+			Target:  []containerapps.TrafficWeight{weight("app--three", 100)},
+			Step:    50,
+			Expected: [][]int64{
+				{50, 25, 25},
+			},
+		},
+		{
+			Name:     "no change",
+			Current:  []containerapps.TrafficWeight{weight("app--one", 100)},
+			Target:   []containerapps.TrafficWeight{weight("APP--ONE", 100)},
+			Step:     10,
+			Expected: [][]int64{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			steps := ContainerAppTrafficShiftSteps(tc.Current, tc.Target, tc.Step)
+			if len(steps) != len(tc.Expected) {
+				t.Fatalf("expected %d steps but got %d", len(tc.Expected), len(steps))
+			}
+
+			for i, step := range steps {
+				var total int64
+				for j, v := range step {
+					total += pointer.From(v.Weight)
+					if pointer.From(v.Weight) != tc.Expected[i][j] {
+						t.Fatalf("expected weight %d for %q in step %d but got %d", tc.Expected[i][j], pointer.From(v.RevisionName), i, pointer.From(v.Weight))
+					}
+				}
+				if total != 100 {
+					t.Fatalf("expected the weights of step %d to total 100 but got %d", i, total)
+				}
+			}
+		})
+	}
+}
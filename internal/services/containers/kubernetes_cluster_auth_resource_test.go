@@ -371,6 +371,30 @@ func TestAccKubernetesCluster_servicePrincipal(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCluster_servicePrincipalWriteOnlySecret(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+	clientData := data.Client()
+
+	data.ResourceTestWriteOnly(t, r, []acceptance.TestStep{
+		{
+			Config: r.servicePrincipalWriteOnlySecretConfig(data, clientData.Default.ClientID, clientData.Default.ClientSecret, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_principal.0.client_secret").IsEmpty(),
+			),
+		},
+		data.ImportStep("service_principal.0.client_secret", "service_principal.0.client_secret_wo_version"),
+		{
+			Config: r.servicePrincipalWriteOnlySecretConfig(data, clientData.Alternate.ClientID, clientData.Alternate.ClientSecret, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("service_principal.0.client_secret", "service_principal.0.client_secret_wo_version"),
+	})
+}
+
 func TestAccKubernetesCluster_servicePrincipalToSystemAssignedIdentity(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
 	r := KubernetesClusterResource{}
@@ -1139,3 +1163,38 @@ resource "azurerm_kubernetes_cluster" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, clientId, clientSecret)
 }
+
+func (KubernetesClusterResource) servicePrincipalWriteOnlySecretConfig(data acceptance.TestData, clientId, clientSecret string, version int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+
+  service_principal {
+    client_id                = "%s"
+    client_secret_wo         = "%s"
+    client_secret_wo_version = %d
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, clientId, clientSecret, version)
+}
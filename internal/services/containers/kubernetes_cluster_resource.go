@@ -1323,9 +1323,25 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 
 						"client_secret": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"service_principal.0.client_secret", "service_principal.0.client_secret_wo"},
+						},
+
+						"client_secret_wo": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							WriteOnly:    true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"service_principal.0.client_secret", "service_principal.0.client_secret_wo"},
+						},
+
+						"client_secret_wo_version": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							RequiredWith: []string{"service_principal.0.client_secret_wo"},
 						},
 					},
 				},
@@ -1973,9 +1989,16 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 	servicePrincipalSet := false
 	if len(servicePrincipalProfileRaw) > 0 {
 		servicePrincipalProfileVal := servicePrincipalProfileRaw[0].(map[string]interface{})
+		clientSecret := servicePrincipalProfileVal["client_secret"].(string)
+		if clientSecret == "" {
+			clientSecret, err = pluginsdk.GetWriteOnlyString(d, "service_principal.0.client_secret_wo")
+			if err != nil {
+				return err
+			}
+		}
 		parameters.Properties.ServicePrincipalProfile = &managedclusters.ManagedClusterServicePrincipalProfile{
 			ClientId: servicePrincipalProfileVal["client_id"].(string),
-			Secret:   utils.String(servicePrincipalProfileVal["client_secret"].(string)),
+			Secret:   utils.String(clientSecret),
 		}
 		servicePrincipalSet = true
 	}
@@ -2098,6 +2121,12 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 		clientId := servicePrincipalRaw["client_id"].(string)
 		clientSecret := servicePrincipalRaw["client_secret"].(string)
+		if clientSecret == "" {
+			clientSecret, err = pluginsdk.GetWriteOnlyString(d, "service_principal.0.client_secret_wo")
+			if err != nil {
+				return err
+			}
+		}
 		params := managedclusters.ManagedClusterServicePrincipalProfile{
 			ClientId: clientId,
 			Secret:   utils.String(clientSecret),
@@ -3977,6 +4006,7 @@ func flattenAzureRmKubernetesClusterServicePrincipalProfile(profile *managedclus
 
 	// client secret isn't returned by the API so pass the existing value along
 	clientSecret := ""
+	clientSecretWoVersion := 0
 	if sp, ok := d.GetOk("service_principal"); ok {
 		var val []interface{}
 
@@ -3990,15 +4020,23 @@ func flattenAzureRmKubernetesClusterServicePrincipalProfile(profile *managedclus
 		if len(val) > 0 && val[0] != nil {
 			raw := val[0].(map[string]interface{})
 			clientSecret = raw["client_secret"].(string)
+			if v, ok := raw["client_secret_wo_version"].(int); ok {
+				clientSecretWoVersion = v
+			}
 		}
 	}
 
-	return []interface{}{
-		map[string]interface{}{
-			"client_id":     clientId,
-			"client_secret": clientSecret,
-		},
+	servicePrincipal := map[string]interface{}{
+		"client_id":     clientId,
+		"client_secret": clientSecret,
 	}
+
+	// the version is only tracked when the write-only `client_secret_wo` is being used
+	if clientSecretWoVersion != 0 {
+		servicePrincipal["client_secret_wo_version"] = clientSecretWoVersion
+	}
+
+	return []interface{}{servicePrincipal}
 }
 
 func flattenKubernetesClusterAutoScalerProfile(profile *managedclusters.ManagedClusterPropertiesAutoScalerProfile) ([]interface{}, error) {
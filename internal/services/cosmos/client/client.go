@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-11-15/mongorbacs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2023-04-15/cosmosdb"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2023-04-15/managedcassandras"
+	cosmosdbV20240515 "github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/cosmosdb"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/restorables"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/clusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/configurations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/firewallrules"
@@ -23,6 +25,7 @@ type Client struct {
 	ClustersClient                   *clusters.ClustersClient
 	ConfigurationsClient             *configurations.ConfigurationsClient
 	CosmosDBClient                   *cosmosdb.CosmosDBClient
+	CosmosDBV20240515Client          *cosmosdbV20240515.CosmosDBClient
 	DatabaseClient                   *documentdb.DatabaseAccountsClient
 	FirewallRulesClient              *firewallrules.FirewallRulesClient
 	GremlinClient                    *documentdb.GremlinResourcesClient
@@ -31,6 +34,7 @@ type Client struct {
 	MongoRBACClient                  *mongorbacs.MongorbacsClient
 	NotebookWorkspaceClient          *documentdb.NotebookWorkspacesClient
 	RestorableDatabaseAccountsClient *documentdb.RestorableDatabaseAccountsClient
+	RestorablesClient                *restorables.RestorablesClient
 	RolesClient                      *roles.RolesClient
 	SqlDedicatedGatewayClient        *sqldedicatedgateway.SqlDedicatedGatewayClient
 	SqlClient                        *documentdb.SQLResourcesClient
@@ -66,6 +70,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(cosmosdbClient.Client, o.Authorizers.ResourceManager)
 
+	cosmosdbV20240515Client, err := cosmosdbV20240515.NewCosmosDBClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building CosmosDB client: %+v", err)
+	}
+	o.Configure(cosmosdbV20240515Client.Client, o.Authorizers.ResourceManager)
+
 	databaseClient := documentdb.NewDatabaseAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&databaseClient.Client, o.ResourceManagerAuthorizer)
 
@@ -93,6 +103,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	restorableDatabaseAccountsClient := documentdb.NewRestorableDatabaseAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&restorableDatabaseAccountsClient.Client, o.ResourceManagerAuthorizer)
 
+	restorablesClient, err := restorables.NewRestorablesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Restorables client: %+v", err)
+	}
+	o.Configure(restorablesClient.Client, o.Authorizers.ResourceManager)
+
 	rolesClient, err := roles.NewRolesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Roles client: %+v", err)
@@ -120,6 +136,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ClustersClient:                   clustersClient,
 		ConfigurationsClient:             configurationsClient,
 		CosmosDBClient:                   cosmosdbClient,
+		CosmosDBV20240515Client:          cosmosdbV20240515Client,
 		DatabaseClient:                   &databaseClient,
 		FirewallRulesClient:              firewallRulesClient,
 		GremlinClient:                    &gremlinClient,
@@ -127,6 +144,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		MongoRBACClient:                  mongorbacsClient,
 		NotebookWorkspaceClient:          &notebookWorkspaceClient,
 		RestorableDatabaseAccountsClient: &restorableDatabaseAccountsClient,
+		RestorablesClient:                restorablesClient,
 		RolesClient:                      rolesClient,
 		SqlDedicatedGatewayClient:        sqlDedicatedGatewayClient,
 		SqlClient:                        &sqlClient,
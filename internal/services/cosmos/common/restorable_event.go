@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// RestorableEvent is a create, replace or delete event of a database, container, collection, graph or table within a
+// Cosmos DB account which has continuous backups enabled.
+type RestorableEvent struct {
+	Id                string `tfschema:"id"`
+	Name              string `tfschema:"name"`
+	Rid               string `tfschema:"rid"`
+	OperationType     string `tfschema:"operation_type"`
+	EventTimestamp    string `tfschema:"event_timestamp"`
+	CanUndelete       string `tfschema:"can_undelete"`
+	CanUndeleteReason string `tfschema:"can_undelete_reason"`
+}
+
+func SchemaRestorableEvents() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"rid": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"operation_type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"event_timestamp": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"can_undelete": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"can_undelete_reason": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func SchemaRestorableEventsTimeFilter() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsRFC3339Time,
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CosmosDbRestorableGremlinDatabasesDataSource struct{}
+
+func TestAccDataSourceCosmosDbRestorableGremlinDatabases_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cosmosdb_restorable_gremlin_databases", "test")
+	r := CosmosDbRestorableGremlinDatabasesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).Key("databases.#").Exists(),
+				check.That(data.ResourceName).Key("databases.0.operation_type").HasValue("Create"),
+			),
+		},
+	})
+}
+
+func (CosmosDbRestorableGremlinDatabasesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cosmos-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-ca-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "GlobalDocumentDB"
+
+  capabilities {
+    name = "EnableGremlin"
+  }
+
+  consistency_policy {
+    consistency_level = "Eventual"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+
+  backup {
+    type = "Continuous"
+  }
+}
+
+resource "azurerm_cosmosdb_gremlin_database" "test" {
+  name                = "acctest-gremlindb-%[1]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+resource "azurerm_cosmosdb_gremlin_graph" "test" {
+  name                = "acctest-gremlingraph-%[1]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_gremlin_database.test.name
+  partition_key_path  = "/test"
+}
+
+data "azurerm_cosmosdb_restorable_database_accounts" "test" {
+  name     = azurerm_cosmosdb_account.test.name
+  location = azurerm_resource_group.test.location
+
+  depends_on = [azurerm_cosmosdb_gremlin_graph.test]
+}
+
+data "azurerm_cosmosdb_restorable_gremlin_databases" "test" {
+  restorable_database_account_id = data.azurerm_cosmosdb_restorable_database_accounts.test.accounts[0].id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/restorables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CosmosDbRestorableGremlinGraphsDataSource struct{}
+
+var _ sdk.DataSource = CosmosDbRestorableGremlinGraphsDataSource{}
+
+type CosmosDbRestorableGremlinGraphsDataSourceModel struct {
+	RestorableDatabaseAccountId string                   `tfschema:"restorable_database_account_id"`
+	DatabaseRid                 string                   `tfschema:"database_rid"`
+	StartTime                   string                   `tfschema:"start_time"`
+	EndTime                     string                   `tfschema:"end_time"`
+	Graphs                      []common.RestorableEvent `tfschema:"graphs"`
+}
+
+func (r CosmosDbRestorableGremlinGraphsDataSource) ResourceType() string {
+	return "azurerm_cosmosdb_restorable_gremlin_graphs"
+}
+
+func (r CosmosDbRestorableGremlinGraphsDataSource) ModelObject() interface{} {
+	return &CosmosDbRestorableGremlinGraphsDataSourceModel{}
+}
+
+func (r CosmosDbRestorableGremlinGraphsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"restorable_database_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: restorables.ValidateRestorableDatabaseAccountID,
+		},
+
+		"database_rid": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"start_time": common.SchemaRestorableEventsTimeFilter(),
+
+		"end_time": common.SchemaRestorableEventsTimeFilter(),
+	}
+}
+
+func (r CosmosDbRestorableGremlinGraphsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"graphs": common.SchemaRestorableEvents(),
+	}
+}
+
+func (r CosmosDbRestorableGremlinGraphsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.RestorablesClient
+
+			var state CosmosDbRestorableGremlinGraphsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := restorables.ParseRestorableDatabaseAccountID(state.RestorableDatabaseAccountId)
+			if err != nil {
+				return err
+			}
+
+			options := restorables.DefaultRestorableGremlinGraphsListOperationOptions()
+			if state.DatabaseRid != "" {
+				options.RestorableGremlinDatabaseRid = pointer.To(state.DatabaseRid)
+			}
+			if state.StartTime != "" {
+				options.StartTime = pointer.To(state.StartTime)
+			}
+			if state.EndTime != "" {
+				options.EndTime = pointer.To(state.EndTime)
+			}
+
+			resp, err := client.RestorableGremlinGraphsList(ctx, *id, options)
+			if err != nil {
+				return fmt.Errorf("listing restorable Gremlin Graphs for %s: %+v", *id, err)
+			}
+
+			state.Graphs = make([]common.RestorableEvent, 0)
+			if model := resp.Model; model != nil {
+				state.Graphs = flattenCosmosDbRestorableGremlinGraphs(model.Value)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenCosmosDbRestorableGremlinGraphs(input *[]restorables.RestorableGremlinGraphGetResult) []common.RestorableEvent {
+	output := make([]common.RestorableEvent, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		event := common.RestorableEvent{
+			Id: pointer.From(item.Id),
+		}
+
+		if props := item.Properties; props != nil && props.Resource != nil {
+			event.Name = pointer.From(props.Resource.OwnerId)
+			event.Rid = pointer.From(props.Resource.OwnerResourceId)
+			event.OperationType = string(pointer.From(props.Resource.OperationType))
+			event.EventTimestamp = pointer.From(props.Resource.EventTimestamp)
+			event.CanUndelete = pointer.From(props.Resource.CanUndelete)
+			event.CanUndeleteReason = pointer.From(props.Resource.CanUndeleteReason)
+		}
+
+		output = append(output, event)
+	}
+
+	return output
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/restorables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CosmosDbRestorableMongoDbCollectionsDataSource struct{}
+
+var _ sdk.DataSource = CosmosDbRestorableMongoDbCollectionsDataSource{}
+
+type CosmosDbRestorableMongoDbCollectionsDataSourceModel struct {
+	RestorableDatabaseAccountId string                   `tfschema:"restorable_database_account_id"`
+	DatabaseRid                 string                   `tfschema:"database_rid"`
+	StartTime                   string                   `tfschema:"start_time"`
+	EndTime                     string                   `tfschema:"end_time"`
+	Collections                 []common.RestorableEvent `tfschema:"collections"`
+}
+
+func (r CosmosDbRestorableMongoDbCollectionsDataSource) ResourceType() string {
+	return "azurerm_cosmosdb_restorable_mongodb_collections"
+}
+
+func (r CosmosDbRestorableMongoDbCollectionsDataSource) ModelObject() interface{} {
+	return &CosmosDbRestorableMongoDbCollectionsDataSourceModel{}
+}
+
+func (r CosmosDbRestorableMongoDbCollectionsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"restorable_database_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: restorables.ValidateRestorableDatabaseAccountID,
+		},
+
+		"database_rid": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"start_time": common.SchemaRestorableEventsTimeFilter(),
+
+		"end_time": common.SchemaRestorableEventsTimeFilter(),
+	}
+}
+
+func (r CosmosDbRestorableMongoDbCollectionsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"collections": common.SchemaRestorableEvents(),
+	}
+}
+
+func (r CosmosDbRestorableMongoDbCollectionsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.RestorablesClient
+
+			var state CosmosDbRestorableMongoDbCollectionsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := restorables.ParseRestorableDatabaseAccountID(state.RestorableDatabaseAccountId)
+			if err != nil {
+				return err
+			}
+
+			options := restorables.DefaultRestorableMongodbCollectionsListOperationOptions()
+			if state.DatabaseRid != "" {
+				options.RestorableMongodbDatabaseRid = pointer.To(state.DatabaseRid)
+			}
+			if state.StartTime != "" {
+				options.StartTime = pointer.To(state.StartTime)
+			}
+			if state.EndTime != "" {
+				options.EndTime = pointer.To(state.EndTime)
+			}
+
+			resp, err := client.RestorableMongodbCollectionsList(ctx, *id, options)
+			if err != nil {
+				return fmt.Errorf("listing restorable MongoDB Collections for %s: %+v", *id, err)
+			}
+
+			state.Collections = make([]common.RestorableEvent, 0)
+			if model := resp.Model; model != nil {
+				state.Collections = flattenCosmosDbRestorableMongoDbCollections(model.Value)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenCosmosDbRestorableMongoDbCollections(input *[]restorables.RestorableMongodbCollectionGetResult) []common.RestorableEvent {
+	output := make([]common.RestorableEvent, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		event := common.RestorableEvent{
+			Id: pointer.From(item.Id),
+		}
+
+		if props := item.Properties; props != nil && props.Resource != nil {
+			event.Name = pointer.From(props.Resource.OwnerId)
+			event.Rid = pointer.From(props.Resource.OwnerResourceId)
+			event.OperationType = string(pointer.From(props.Resource.OperationType))
+			event.EventTimestamp = pointer.From(props.Resource.EventTimestamp)
+			event.CanUndelete = pointer.From(props.Resource.CanUndelete)
+			event.CanUndeleteReason = pointer.From(props.Resource.CanUndeleteReason)
+		}
+
+		output = append(output, event)
+	}
+
+	return output
+}
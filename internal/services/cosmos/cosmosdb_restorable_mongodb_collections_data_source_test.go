@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CosmosDbRestorableMongoDbCollectionsDataSource struct{}
+
+func TestAccDataSourceCosmosDbRestorableMongoDbCollections_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cosmosdb_restorable_mongodb_collections", "test")
+	r := CosmosDbRestorableMongoDbCollectionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).Key("collections.#").Exists(),
+				check.That(data.ResourceName).Key("collections.0.operation_type").HasValue("Create"),
+			),
+		},
+	})
+}
+
+func (CosmosDbRestorableMongoDbCollectionsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cosmos-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-ca-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "MongoDB"
+
+  capabilities {
+    name = "EnableMongo"
+  }
+
+  consistency_policy {
+    consistency_level = "Eventual"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+
+  backup {
+    type = "Continuous"
+  }
+}
+
+resource "azurerm_cosmosdb_mongo_database" "test" {
+  name                = "acctest-mongodb-%[1]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+resource "azurerm_cosmosdb_mongo_collection" "test" {
+  name                = "acctest-mongodb-coll-%[1]d"
+  resource_group_name = azurerm_cosmosdb_mongo_database.test.resource_group_name
+  account_name        = azurerm_cosmosdb_mongo_database.test.account_name
+  database_name       = azurerm_cosmosdb_mongo_database.test.name
+
+  index {
+    keys   = ["_id"]
+    unique = true
+  }
+}
+
+data "azurerm_cosmosdb_restorable_database_accounts" "test" {
+  name     = azurerm_cosmosdb_account.test.name
+  location = azurerm_resource_group.test.location
+
+  depends_on = [azurerm_cosmosdb_mongo_collection.test]
+}
+
+data "azurerm_cosmosdb_restorable_mongodb_collections" "test" {
+  restorable_database_account_id = data.azurerm_cosmosdb_restorable_database_accounts.test.accounts[0].id
+  start_time                     = "2020-01-01T00:00:00Z"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
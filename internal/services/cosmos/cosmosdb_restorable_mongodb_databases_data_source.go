@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/restorables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type CosmosDbRestorableMongoDbDatabasesDataSource struct{}
+
+var _ sdk.DataSource = CosmosDbRestorableMongoDbDatabasesDataSource{}
+
+type CosmosDbRestorableMongoDbDatabasesDataSourceModel struct {
+	RestorableDatabaseAccountId string                   `tfschema:"restorable_database_account_id"`
+	Databases                   []common.RestorableEvent `tfschema:"databases"`
+}
+
+func (r CosmosDbRestorableMongoDbDatabasesDataSource) ResourceType() string {
+	return "azurerm_cosmosdb_restorable_mongodb_databases"
+}
+
+func (r CosmosDbRestorableMongoDbDatabasesDataSource) ModelObject() interface{} {
+	return &CosmosDbRestorableMongoDbDatabasesDataSourceModel{}
+}
+
+func (r CosmosDbRestorableMongoDbDatabasesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"restorable_database_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: restorables.ValidateRestorableDatabaseAccountID,
+		},
+	}
+}
+
+func (r CosmosDbRestorableMongoDbDatabasesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"databases": common.SchemaRestorableEvents(),
+	}
+}
+
+func (r CosmosDbRestorableMongoDbDatabasesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.RestorablesClient
+
+			var state CosmosDbRestorableMongoDbDatabasesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := restorables.ParseRestorableDatabaseAccountID(state.RestorableDatabaseAccountId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.RestorableMongodbDatabasesList(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing restorable MongoDB Databases for %s: %+v", *id, err)
+			}
+
+			state.Databases = make([]common.RestorableEvent, 0)
+			if model := resp.Model; model != nil {
+				state.Databases = flattenCosmosDbRestorableMongoDbDatabases(model.Value)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenCosmosDbRestorableMongoDbDatabases(input *[]restorables.RestorableMongodbDatabaseGetResult) []common.RestorableEvent {
+	output := make([]common.RestorableEvent, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		event := common.RestorableEvent{
+			Id: pointer.From(item.Id),
+		}
+
+		if props := item.Properties; props != nil && props.Resource != nil {
+			event.Name = pointer.From(props.Resource.OwnerId)
+			event.Rid = pointer.From(props.Resource.OwnerResourceId)
+			event.OperationType = string(pointer.From(props.Resource.OperationType))
+			event.EventTimestamp = pointer.From(props.Resource.EventTimestamp)
+			event.CanUndelete = pointer.From(props.Resource.CanUndelete)
+			event.CanUndeleteReason = pointer.From(props.Resource.CanUndeleteReason)
+		}
+
+		output = append(output, event)
+	}
+
+	return output
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/restorables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type CosmosDbRestorableTablesDataSource struct{}
+
+var _ sdk.DataSource = CosmosDbRestorableTablesDataSource{}
+
+type CosmosDbRestorableTablesDataSourceModel struct {
+	RestorableDatabaseAccountId string                   `tfschema:"restorable_database_account_id"`
+	StartTime                   string                   `tfschema:"start_time"`
+	EndTime                     string                   `tfschema:"end_time"`
+	Tables                      []common.RestorableEvent `tfschema:"tables"`
+}
+
+func (r CosmosDbRestorableTablesDataSource) ResourceType() string {
+	return "azurerm_cosmosdb_restorable_tables"
+}
+
+func (r CosmosDbRestorableTablesDataSource) ModelObject() interface{} {
+	return &CosmosDbRestorableTablesDataSourceModel{}
+}
+
+func (r CosmosDbRestorableTablesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"restorable_database_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: restorables.ValidateRestorableDatabaseAccountID,
+		},
+
+		"start_time": common.SchemaRestorableEventsTimeFilter(),
+
+		"end_time": common.SchemaRestorableEventsTimeFilter(),
+	}
+}
+
+func (r CosmosDbRestorableTablesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"tables": common.SchemaRestorableEvents(),
+	}
+}
+
+func (r CosmosDbRestorableTablesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.RestorablesClient
+
+			var state CosmosDbRestorableTablesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := restorables.ParseRestorableDatabaseAccountID(state.RestorableDatabaseAccountId)
+			if err != nil {
+				return err
+			}
+
+			options := restorables.DefaultRestorableTablesListOperationOptions()
+			if state.StartTime != "" {
+				options.StartTime = pointer.To(state.StartTime)
+			}
+			if state.EndTime != "" {
+				options.EndTime = pointer.To(state.EndTime)
+			}
+
+			resp, err := client.RestorableTablesList(ctx, *id, options)
+			if err != nil {
+				return fmt.Errorf("listing restorable Tables for %s: %+v", *id, err)
+			}
+
+			state.Tables = make([]common.RestorableEvent, 0)
+			if model := resp.Model; model != nil {
+				state.Tables = flattenCosmosDbRestorableTables(model.Value)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenCosmosDbRestorableTables(input *[]restorables.RestorableTableGetResult) []common.RestorableEvent {
+	output := make([]common.RestorableEvent, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		event := common.RestorableEvent{
+			Id: pointer.From(item.Id),
+		}
+
+		if props := item.Properties; props != nil && props.Resource != nil {
+			event.Name = pointer.From(props.Resource.OwnerId)
+			event.Rid = pointer.From(props.Resource.OwnerResourceId)
+			event.OperationType = string(pointer.From(props.Resource.OperationType))
+			event.EventTimestamp = pointer.From(props.Resource.EventTimestamp)
+			event.CanUndelete = pointer.From(props.Resource.CanUndelete)
+			event.CanUndeleteReason = pointer.From(props.Resource.CanUndeleteReason)
+		}
+
+		output = append(output, event)
+	}
+
+	return output
+}
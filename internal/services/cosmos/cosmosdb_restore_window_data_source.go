@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/restorables"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type CosmosDbRestoreWindowDataSource struct{}
+
+var _ sdk.DataSource = CosmosDbRestoreWindowDataSource{}
+
+type CosmosDbRestoreWindowDataSourceModel struct {
+	RestorableDatabaseAccountId string `tfschema:"restorable_database_account_id"`
+	Location                    string `tfschema:"location"`
+	SqlContainerId              string `tfschema:"sql_container_id"`
+	MongoCollectionId           string `tfschema:"mongo_collection_id"`
+	GremlinGraphId              string `tfschema:"gremlin_graph_id"`
+	TableId                     string `tfschema:"table_id"`
+	EarliestRestorableTimestamp string `tfschema:"earliest_restorable_timestamp"`
+	LatestRestorableTimestamp   string `tfschema:"latest_restorable_timestamp"`
+}
+
+func (r CosmosDbRestoreWindowDataSource) ResourceType() string {
+	return "azurerm_cosmosdb_restore_window"
+}
+
+func (r CosmosDbRestoreWindowDataSource) ModelObject() interface{} {
+	return &CosmosDbRestoreWindowDataSourceModel{}
+}
+
+func (r CosmosDbRestoreWindowDataSource) Arguments() map[string]*pluginsdk.Schema {
+	resourceIds := []string{
+		"sql_container_id",
+		"mongo_collection_id",
+		"gremlin_graph_id",
+		"table_id",
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"restorable_database_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: restorables.ValidateRestorableDatabaseAccountID,
+		},
+
+		"location": commonschema.LocationWithoutForceNew(),
+
+		"sql_container_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: restorables.ValidateContainerID,
+			ExactlyOneOf: resourceIds,
+		},
+
+		"mongo_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: restorables.ValidateMongodbDatabaseCollectionID,
+			ExactlyOneOf: resourceIds,
+		},
+
+		"gremlin_graph_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: restorables.ValidateGraphID,
+			ExactlyOneOf: resourceIds,
+		},
+
+		"table_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: restorables.ValidateTableID,
+			ExactlyOneOf: resourceIds,
+		},
+	}
+}
+
+func (r CosmosDbRestoreWindowDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"earliest_restorable_timestamp": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"latest_restorable_timestamp": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r CosmosDbRestoreWindowDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.RestorablesClient
+
+			var state CosmosDbRestoreWindowDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := restorables.ParseRestorableDatabaseAccountID(state.RestorableDatabaseAccountId)
+			if err != nil {
+				return err
+			}
+
+			account, err := client.RestorableDatabaseAccountsGetByLocation(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if model := account.Model; model != nil && model.Properties != nil {
+				// the oldest restorable time is only returned once the account is older than the retention period
+				state.EarliestRestorableTimestamp = pointer.From(model.Properties.OldestRestorableTime)
+				if state.EarliestRestorableTimestamp == "" {
+					state.EarliestRestorableTimestamp = pointer.From(model.Properties.CreationTime)
+				}
+			}
+
+			restoreLocation := restorables.ContinuousBackupRestoreLocation{
+				Location: pointer.To(location.Normalize(state.Location)),
+			}
+
+			var poller pollers.Poller
+			var resourceId string
+			switch {
+			case state.SqlContainerId != "":
+				containerId, err := restorables.ParseContainerID(state.SqlContainerId)
+				if err != nil {
+					return err
+				}
+				resp, err := client.SqlResourcesRetrieveContinuousBackupInformation(ctx, *containerId, restoreLocation)
+				if err != nil {
+					return fmt.Errorf("retrieving continuous backup information for %s: %+v", *containerId, err)
+				}
+				poller, resourceId = resp.Poller, containerId.String()
+
+			case state.MongoCollectionId != "":
+				collectionId, err := restorables.ParseMongodbDatabaseCollectionID(state.MongoCollectionId)
+				if err != nil {
+					return err
+				}
+				resp, err := client.MongoDBResourcesRetrieveContinuousBackupInformation(ctx, *collectionId, restoreLocation)
+				if err != nil {
+					return fmt.Errorf("retrieving continuous backup information for %s: %+v", *collectionId, err)
+				}
+				poller, resourceId = resp.Poller, collectionId.String()
+
+			case state.GremlinGraphId != "":
+				graphId, err := restorables.ParseGraphID(state.GremlinGraphId)
+				if err != nil {
+					return err
+				}
+				resp, err := client.GremlinResourcesRetrieveContinuousBackupInformation(ctx, *graphId, restoreLocation)
+				if err != nil {
+					return fmt.Errorf("retrieving continuous backup information for %s: %+v", *graphId, err)
+				}
+				poller, resourceId = resp.Poller, graphId.String()
+
+			default:
+				tableId, err := restorables.ParseTableID(state.TableId)
+				if err != nil {
+					return err
+				}
+				resp, err := client.TableResourcesRetrieveContinuousBackupInformation(ctx, *tableId, restoreLocation)
+				if err != nil {
+					return fmt.Errorf("retrieving continuous backup information for %s: %+v", *tableId, err)
+				}
+				poller, resourceId = resp.Poller, tableId.String()
+			}
+
+			if err := poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the continuous backup information for %s: %+v", resourceId, err)
+			}
+
+			var backupInformation restorables.BackupInformation
+			if err := poller.FinalResult(&backupInformation); err != nil {
+				return fmt.Errorf("retrieving continuous backup information for %s: %+v", resourceId, err)
+			}
+			if info := backupInformation.ContinuousBackupInformation; info != nil {
+				state.LatestRestorableTimestamp = pointer.From(info.LatestRestorableTimestamp)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/cosmosdb"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/restorables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CosmosDbSqlContainerRestoreModel struct {
+	Name                  string   `tfschema:"name"`
+	SqlDatabaseId         string   `tfschema:"sql_database_id"`
+	RestoreSource         string   `tfschema:"restore_source"`
+	RestoreTimestampInUtc string   `tfschema:"restore_timestamp_in_utc"`
+	PartitionKeyPaths     []string `tfschema:"partition_key_paths"`
+}
+
+type CosmosDbSqlContainerRestoreResource struct{}
+
+var _ sdk.Resource = CosmosDbSqlContainerRestoreResource{}
+
+func (r CosmosDbSqlContainerRestoreResource) ResourceType() string {
+	return "azurerm_cosmosdb_sql_container_restore"
+}
+
+func (r CosmosDbSqlContainerRestoreResource) ModelObject() interface{} {
+	return &CosmosDbSqlContainerRestoreModel{}
+}
+
+func (r CosmosDbSqlContainerRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return cosmosdb.ValidateContainerID
+}
+
+func (r CosmosDbSqlContainerRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CosmosEntityName,
+		},
+
+		"sql_database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: cosmosdb.ValidateSqlDatabaseID,
+		},
+
+		"restore_source": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: restorables.ValidateRestorableDatabaseAccountID,
+		},
+
+		"restore_timestamp_in_utc": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppress.RFC3339Time,
+			ValidateFunc:     validation.IsRFC3339Time,
+		},
+	}
+}
+
+func (r CosmosDbSqlContainerRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"partition_key_paths": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r CosmosDbSqlContainerRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.CosmosDBV20240515Client
+
+			var model CosmosDbSqlContainerRestoreModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := cosmosdb.ParseSqlDatabaseID(model.SqlDatabaseId)
+			if err != nil {
+				return err
+			}
+
+			id := cosmosdb.NewContainerID(databaseId.SubscriptionId, databaseId.ResourceGroupName, databaseId.DatabaseAccountName, databaseId.SqlDatabaseName, model.Name)
+
+			existing, err := client.SqlResourcesGetSqlContainer(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the container is restored in-place into the same account, the source being the restorable database
+			// account which holds the continuous backups of this account
+			parameters := cosmosdb.SqlContainerCreateUpdateParameters{
+				Properties: cosmosdb.SqlContainerCreateUpdateProperties{
+					Resource: cosmosdb.SqlContainerResource{
+						Id:         model.Name,
+						CreateMode: pointer.To(cosmosdb.CreateModeRestore),
+						RestoreParameters: &cosmosdb.RestoreParametersBase{
+							RestoreSource:         pointer.To(model.RestoreSource),
+							RestoreTimestampInUtc: pointer.To(model.RestoreTimestampInUtc),
+						},
+					},
+				},
+			}
+
+			if err := client.SqlResourcesCreateUpdateSqlContainerThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("restoring %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbSqlContainerRestoreResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.CosmosDBV20240515Client
+
+			id, err := cosmosdb.ParseContainerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.SqlResourcesGetSqlContainer(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the restore parameters aren't always returned once the restore has completed, so fall back to the
+			// values from the configuration when they're missing
+			var state CosmosDbSqlContainerRestoreModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Name = id.ContainerName
+			state.SqlDatabaseId = cosmosdb.NewSqlDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.DatabaseAccountName, id.SqlDatabaseName).ID()
+			state.PartitionKeyPaths = make([]string, 0)
+
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Resource != nil {
+				if partitionKey := model.Properties.Resource.PartitionKey; partitionKey != nil {
+					state.PartitionKeyPaths = pointer.From(partitionKey.Paths)
+				}
+
+				if restoreParameters := model.Properties.Resource.RestoreParameters; restoreParameters != nil {
+					if v := pointer.From(restoreParameters.RestoreSource); v != "" {
+						state.RestoreSource = v
+					}
+					if v := pointer.From(restoreParameters.RestoreTimestampInUtc); v != "" {
+						state.RestoreTimestampInUtc = v
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbSqlContainerRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.CosmosDBV20240515Client
+
+			id, err := cosmosdb.ParseContainerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.SqlResourcesDeleteSqlContainerThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/cosmosdb"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CosmosDbSqlContainerRestoreResource struct{}
+
+func TestAccCosmosDbSqlContainerRestore_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container_restore", "test")
+	r := CosmosDbSqlContainerRestoreResource{}
+
+	// the container must exist at the restore timestamp, and be deleted before it can be restored
+	restoreTimestamp := time.Now().Add(20 * time.Minute).UTC()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withContainer(data),
+		},
+		{
+			PreConfig: func() { time.Sleep(time.Until(restoreTimestamp.Add(5 * time.Minute))) },
+			Config:    r.template(data),
+		},
+		{
+			Config: r.basic(data, restoreTimestamp.Format(time.RFC3339)),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("partition_key_paths.0").HasValue("/id"),
+			),
+		},
+		data.ImportStep("restore_source", "restore_timestamp_in_utc"),
+	})
+}
+
+func TestAccCosmosDbSqlContainerRestore_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container_restore", "test")
+	r := CosmosDbSqlContainerRestoreResource{}
+
+	restoreTimestamp := time.Now().Add(20 * time.Minute).UTC()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withContainer(data),
+		},
+		{
+			PreConfig: func() { time.Sleep(time.Until(restoreTimestamp.Add(5 * time.Minute))) },
+			Config:    r.template(data),
+		},
+		{
+			Config: r.basic(data, restoreTimestamp.Format(time.RFC3339)),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, restoreTimestamp.Format(time.RFC3339))
+		}),
+	})
+}
+
+func (r CosmosDbSqlContainerRestoreResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := cosmosdb.ParseContainerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Cosmos.CosmosDBV20240515Client.SqlResourcesGetSqlContainer(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r CosmosDbSqlContainerRestoreResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cosmos-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-ca-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "GlobalDocumentDB"
+
+  consistency_policy {
+    consistency_level = "Eventual"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+
+  backup {
+    type = "Continuous"
+  }
+}
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-sqldb-%[1]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+data "azurerm_cosmosdb_restorable_database_accounts" "test" {
+  name     = azurerm_cosmosdb_account.test.name
+  location = azurerm_resource_group.test.location
+
+  depends_on = [azurerm_cosmosdb_sql_database.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r CosmosDbSqlContainerRestoreResource) withContainer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-sqlcontainer-%d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+  partition_key_path  = "/id"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CosmosDbSqlContainerRestoreResource) basic(data acceptance.TestData, restoreTimestamp string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_container_restore" "test" {
+  name                     = "acctest-sqlcontainer-%d"
+  sql_database_id          = azurerm_cosmosdb_sql_database.test.id
+  restore_source           = data.azurerm_cosmosdb_restorable_database_accounts.test.accounts[0].id
+  restore_timestamp_in_utc = "%s"
+}
+`, r.template(data), data.RandomInteger, restoreTimestamp)
+}
+
+func (r CosmosDbSqlContainerRestoreResource) requiresImport(data acceptance.TestData, restoreTimestamp string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_container_restore" "import" {
+  name                     = azurerm_cosmosdb_sql_container_restore.test.name
+  sql_database_id          = azurerm_cosmosdb_sql_container_restore.test.sql_database_id
+  restore_source           = azurerm_cosmosdb_sql_container_restore.test.restore_source
+  restore_timestamp_in_utc = azurerm_cosmosdb_sql_container_restore.test.restore_timestamp_in_utc
+}
+`, r.basic(data, restoreTimestamp))
+}
@@ -21,7 +21,16 @@ func (r Registration) AssociatedGitHubLabel() string {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		CosmosDbRestorableGremlinDatabasesDataSource{},
+		CosmosDbRestorableGremlinGraphsDataSource{},
+		CosmosDbRestorableMongoDbCollectionsDataSource{},
+		CosmosDbRestorableMongoDbDatabasesDataSource{},
+		CosmosDbRestorableSqlContainersDataSource{},
+		CosmosDbRestorableSqlDatabasesDataSource{},
+		CosmosDbRestorableTablesDataSource{},
+		CosmosDbRestoreWindowDataSource{},
+	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
@@ -32,6 +41,7 @@ func (r Registration) Resources() []sdk.Resource {
 		CosmosDbPostgreSQLFirewallRuleResource{},
 		CosmosDbPostgreSQLNodeConfigurationResource{},
 		CosmosDbPostgreSQLRoleResource{},
+		CosmosDbSqlContainerRestoreResource{},
 		CosmosDbSqlDedicatedGatewayResource{},
 		CosmosDbMongoRoleDefinitionResource{},
 	}
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/attachednetworkconnections"
+)
+
+// NOTE: this workaround is needed since the `attachednetworkconnections` package within the SDK only exposes the
+// List operations for Attached Networks, the Create/Get/Delete operations are defined under a separate tag within
+// the API Specification - as such these are implemented here, reusing the configured client for the same API Version.
+// This can be removed once these operations are available within the SDK.
+
+type AttachedNetworksClient struct {
+	Client attachednetworkconnections.AttachedNetworkConnectionsClient
+}
+
+func NewAttachedNetworksClient(client attachednetworkconnections.AttachedNetworkConnectionsClient) AttachedNetworksClient {
+	return AttachedNetworksClient{
+		Client: client,
+	}
+}
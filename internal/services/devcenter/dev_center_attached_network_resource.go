@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/attachednetworkconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/networkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DevCenterAttachedNetworkResourceModel struct {
+	Name                string `tfschema:"name"`
+	DevCenterId         string `tfschema:"dev_center_id"`
+	NetworkConnectionId string `tfschema:"network_connection_id"`
+}
+
+type DevCenterAttachedNetworkResource struct{}
+
+var _ sdk.Resource = DevCenterAttachedNetworkResource{}
+
+func (r DevCenterAttachedNetworkResource) ModelObject() interface{} {
+	return &DevCenterAttachedNetworkResourceModel{}
+}
+
+func (r DevCenterAttachedNetworkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateAttachedNetworkID
+}
+
+func (r DevCenterAttachedNetworkResource) ResourceType() string {
+	return "azurerm_dev_center_attached_network"
+}
+
+func (r DevCenterAttachedNetworkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dev_center_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: attachednetworkconnections.ValidateDevCenterID,
+		},
+
+		"network_connection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkconnections.ValidateNetworkConnectionID,
+		},
+	}
+}
+
+func (r DevCenterAttachedNetworkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterAttachedNetworkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DevCenterAttachedNetworkResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := azuresdkhacks.NewAttachedNetworksClient(*metadata.Client.DevCenter.V20230401.AttachedNetworkConnections)
+			devCenterId, err := attachednetworkconnections.ParseDevCenterID(model.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewAttachedNetworkID(devCenterId.SubscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := attachednetworkconnections.AttachedNetworkConnection{
+				Properties: &attachednetworkconnections.AttachedNetworkConnectionProperties{
+					NetworkConnectionId: model.NetworkConnectionId,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterAttachedNetworkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewAttachedNetworksClient(*metadata.Client.DevCenter.V20230401.AttachedNetworkConnections)
+
+			id, err := azuresdkhacks.ParseAttachedNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterAttachedNetworkResourceModel{
+				Name:        id.AttachedNetworkName,
+				DevCenterId: attachednetworkconnections.NewDevCenterID(id.SubscriptionId, id.ResourceGroupName, id.DevCenterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					networkConnectionId, err := networkconnections.ParseNetworkConnectionIDInsensitively(props.NetworkConnectionId)
+					if err != nil {
+						return err
+					}
+					state.NetworkConnectionId = networkConnectionId.ID()
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterAttachedNetworkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewAttachedNetworksClient(*metadata.Client.DevCenter.V20230401.AttachedNetworkConnections)
+
+			id, err := azuresdkhacks.ParseAttachedNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterAttachedNetworkTestResource struct{}
+
+func TestAccDevCenterAttachedNetwork_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_attached_network", "test")
+	r := DevCenterAttachedNetworkTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterAttachedNetwork_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_attached_network", "test")
+	r := DevCenterAttachedNetworkTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r DevCenterAttachedNetworkTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParseAttachedNetworkID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := azuresdkhacks.NewAttachedNetworksClient(*clients.DevCenter.V20230401.AttachedNetworkConnections)
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DevCenterAttachedNetworkTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_attached_network" "test" {
+  name                  = "acctest-dcan-%d"
+  dev_center_id         = azurerm_dev_center.test.id
+  network_connection_id = azurerm_dev_center_network_connection.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DevCenterAttachedNetworkTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_attached_network" "import" {
+  name                  = azurerm_dev_center_attached_network.test.name
+  dev_center_id         = azurerm_dev_center_attached_network.test.dev_center_id
+  network_connection_id = azurerm_dev_center_attached_network.test.network_connection_id
+}
+`, r.basic(data))
+}
+
+func (r DevCenterAttachedNetworkTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_dev_center" "test" {
+  name                = "acctestdc-%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_dev_center_network_connection" "test" {
+  name                = "acctest-dcnc-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  domain_join_type    = "AzureADJoin"
+  subnet_id           = azurerm_subnet.test.id
+}
+`, DevCenterNetworkConnectionTestResource{}.template(data), data.RandomString, data.RandomInteger)
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/devboxdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DevCenterDevBoxDefinitionResourceModel struct {
+	Name                    string                 `tfschema:"name"`
+	Location                string                 `tfschema:"location"`
+	DevCenterId             string                 `tfschema:"dev_center_id"`
+	ImageReferenceId        string                 `tfschema:"image_reference_id"`
+	SkuName                 string                 `tfschema:"sku_name"`
+	HibernateSupportEnabled bool                   `tfschema:"hibernate_support_enabled"`
+	Tags                    map[string]interface{} `tfschema:"tags"`
+}
+
+type DevCenterDevBoxDefinitionResource struct{}
+
+var _ sdk.ResourceWithUpdate = DevCenterDevBoxDefinitionResource{}
+
+func (r DevCenterDevBoxDefinitionResource) ModelObject() interface{} {
+	return &DevCenterDevBoxDefinitionResourceModel{}
+}
+
+func (r DevCenterDevBoxDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return devboxdefinitions.ValidateDevCenterDevBoxDefinitionID
+}
+
+func (r DevCenterDevBoxDefinitionResource) ResourceType() string {
+	return "azurerm_dev_center_dev_box_definition"
+}
+
+func (r DevCenterDevBoxDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": commonschema.Location(),
+
+		"dev_center_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: devboxdefinitions.ValidateDevCenterID,
+		},
+
+		"image_reference_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"hibernate_support_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DevCenterDevBoxDefinitionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.DevCenter.V20230401.DevBoxDefinitions
+			devCenterId, err := devboxdefinitions.ParseDevCenterID(model.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := devboxdefinitions.NewDevCenterDevBoxDefinitionID(devCenterId.SubscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := devboxdefinitions.DevBoxDefinition{
+				Location: location.Normalize(model.Location),
+				Properties: &devboxdefinitions.DevBoxDefinitionProperties{
+					HibernateSupport: expandDevCenterHibernateSupport(model.HibernateSupportEnabled),
+					ImageReference: &devboxdefinitions.ImageReference{
+						Id: pointer.To(model.ImageReferenceId),
+					},
+					Sku: &devboxdefinitions.Sku{
+						Name: model.SkuName,
+					},
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.DevBoxDefinitions
+
+			id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterDevBoxDefinitionResourceModel{
+				Name:        id.DevBoxDefinitionName,
+				DevCenterId: devboxdefinitions.NewDevCenterID(id.SubscriptionId, id.ResourceGroupName, id.DevCenterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.HibernateSupportEnabled = pointer.From(props.HibernateSupport) == devboxdefinitions.HibernateSupportEnabled
+
+					if imageReference := props.ImageReference; imageReference != nil {
+						state.ImageReferenceId = pointer.From(imageReference.Id)
+					}
+
+					if sku := props.Sku; sku != nil {
+						state.SkuName = sku.Name
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.DevBoxDefinitions
+
+			id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterDevBoxDefinitionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := devboxdefinitions.DevBoxDefinitionUpdate{
+				Properties: &devboxdefinitions.DevBoxDefinitionUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("image_reference_id") {
+				payload.Properties.ImageReference = &devboxdefinitions.ImageReference{
+					Id: pointer.To(model.ImageReferenceId),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("sku_name") {
+				payload.Properties.Sku = &devboxdefinitions.Sku{
+					Name: model.SkuName,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("hibernate_support_enabled") {
+				payload.Properties.HibernateSupport = expandDevCenterHibernateSupport(model.HibernateSupportEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.DevBoxDefinitions
+
+			id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterHibernateSupport(input bool) *devboxdefinitions.HibernateSupport {
+	if input {
+		return pointer.To(devboxdefinitions.HibernateSupportEnabled)
+	}
+
+	return pointer.To(devboxdefinitions.HibernateSupportDisabled)
+}
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/networkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DevCenterNetworkConnectionResourceModel struct {
+	Name              string                 `tfschema:"name"`
+	ResourceGroupName string                 `tfschema:"resource_group_name"`
+	Location          string                 `tfschema:"location"`
+	DomainJoinType    string                 `tfschema:"domain_join_type"`
+	SubnetId          string                 `tfschema:"subnet_id"`
+	DomainName        string                 `tfschema:"domain_name"`
+	DomainPassword    string                 `tfschema:"domain_password"`
+	DomainUsername    string                 `tfschema:"domain_username"`
+	OrganizationUnit  string                 `tfschema:"organization_unit"`
+	Tags              map[string]interface{} `tfschema:"tags"`
+}
+
+type DevCenterNetworkConnectionResource struct{}
+
+var _ sdk.ResourceWithUpdate = DevCenterNetworkConnectionResource{}
+
+func (r DevCenterNetworkConnectionResource) ModelObject() interface{} {
+	return &DevCenterNetworkConnectionResourceModel{}
+}
+
+func (r DevCenterNetworkConnectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkconnections.ValidateNetworkConnectionID
+}
+
+func (r DevCenterNetworkConnectionResource) ResourceType() string {
+	return "azurerm_dev_center_network_connection"
+}
+
+func (r DevCenterNetworkConnectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"domain_join_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(networkconnections.PossibleValuesForDomainJoinType(), false),
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateSubnetID,
+		},
+
+		"domain_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"domain_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"domain_username"},
+		},
+
+		"domain_username": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"domain_password"},
+		},
+
+		"organization_unit": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterNetworkConnectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DevCenterNetworkConnectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.DevCenter.V20230401.NetworkConnections
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := networkconnections.NewNetworkConnectionID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networkconnections.NetworkConnection{
+				Location: location.Normalize(model.Location),
+				Properties: &networkconnections.NetworkProperties{
+					DomainJoinType: networkconnections.DomainJoinType(model.DomainJoinType),
+					SubnetId:       pointer.To(model.SubnetId),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			if model.DomainName != "" {
+				payload.Properties.DomainName = pointer.To(model.DomainName)
+			}
+
+			if model.DomainPassword != "" {
+				payload.Properties.DomainPassword = pointer.To(model.DomainPassword)
+			}
+
+			if model.DomainUsername != "" {
+				payload.Properties.DomainUsername = pointer.To(model.DomainUsername)
+			}
+
+			if model.OrganizationUnit != "" {
+				payload.Properties.OrganizationUnit = pointer.To(model.OrganizationUnit)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.NetworkConnections
+
+			id, err := networkconnections.ParseNetworkConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterNetworkConnectionResourceModel{
+				Name:              id.NetworkConnectionName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.DomainJoinType = string(props.DomainJoinType)
+					state.SubnetId = pointer.From(props.SubnetId)
+					state.DomainName = pointer.From(props.DomainName)
+					state.DomainUsername = pointer.From(props.DomainUsername)
+					state.OrganizationUnit = pointer.From(props.OrganizationUnit)
+				}
+			}
+
+			// the API doesn't return the `domain_password` so we pull it from the config
+			if v, ok := metadata.ResourceData.GetOk("domain_password"); ok {
+				state.DomainPassword = v.(string)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.NetworkConnections
+
+			id, err := networkconnections.ParseNetworkConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterNetworkConnectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := networkconnections.NetworkConnectionUpdate{
+				Properties: &networkconnections.NetworkConnectionUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("subnet_id") {
+				payload.Properties.SubnetId = pointer.To(model.SubnetId)
+			}
+
+			if metadata.ResourceData.HasChange("domain_name") {
+				payload.Properties.DomainName = pointer.To(model.DomainName)
+			}
+
+			if metadata.ResourceData.HasChange("domain_password") {
+				payload.Properties.DomainPassword = pointer.To(model.DomainPassword)
+			}
+
+			if metadata.ResourceData.HasChange("domain_username") {
+				payload.Properties.DomainUsername = pointer.To(model.DomainUsername)
+			}
+
+			if metadata.ResourceData.HasChange("organization_unit") {
+				payload.Properties.OrganizationUnit = pointer.To(model.OrganizationUnit)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.NetworkConnections
+
+			id, err := networkconnections.ParseNetworkConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
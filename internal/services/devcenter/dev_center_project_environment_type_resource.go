@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/environmenttypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DevCenterProjectEnvironmentTypeResourceModel struct {
+	Name                       string                                     `tfschema:"name"`
+	Location                   string                                     `tfschema:"location"`
+	DevCenterProjectId         string                                     `tfschema:"dev_center_project_id"`
+	DeploymentTargetId         string                                     `tfschema:"deployment_target_id"`
+	Identity                   []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	CreatorRoleAssignmentRoles []string                                   `tfschema:"creator_role_assignment_roles"`
+	UserRoleAssignment         []UserRoleAssignmentModel                  `tfschema:"user_role_assignment"`
+	Tags                       map[string]interface{}                     `tfschema:"tags"`
+}
+
+type UserRoleAssignmentModel struct {
+	UserId string   `tfschema:"user_id"`
+	Roles  []string `tfschema:"roles"`
+}
+
+type DevCenterProjectEnvironmentTypeResource struct{}
+
+var _ sdk.ResourceWithUpdate = DevCenterProjectEnvironmentTypeResource{}
+
+func (r DevCenterProjectEnvironmentTypeResource) ModelObject() interface{} {
+	return &DevCenterProjectEnvironmentTypeResourceModel{}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return environmenttypes.ValidateEnvironmentTypeID
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) ResourceType() string {
+	return "azurerm_dev_center_project_environment_type"
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": commonschema.Location(),
+
+		"dev_center_project_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: environmenttypes.ValidateProjectID,
+		},
+
+		"deployment_target_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateSubscriptionID,
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityRequired(),
+
+		"creator_role_assignment_roles": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+
+		"user_role_assignment": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"user_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"roles": {
+						Type:     pluginsdk.TypeSet,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DevCenterProjectEnvironmentTypeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.DevCenter.V20230401.EnvironmentTypes
+			projectId, err := environmenttypes.ParseProjectID(model.DevCenterProjectId)
+			if err != nil {
+				return err
+			}
+
+			id := environmenttypes.NewEnvironmentTypeID(projectId.SubscriptionId, projectId.ResourceGroupName, projectId.ProjectName, model.Name)
+
+			existing, err := client.ProjectEnvironmentTypesGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := environmenttypes.ProjectEnvironmentType{
+				Identity: identityValue,
+				Location: pointer.To(location.Normalize(model.Location)),
+				Properties: &environmenttypes.ProjectEnvironmentTypeProperties{
+					CreatorRoleAssignment: &environmenttypes.ProjectEnvironmentTypeUpdatePropertiesCreatorRoleAssignment{
+						Roles: expandDevCenterEnvironmentRoles(model.CreatorRoleAssignmentRoles),
+					},
+					DeploymentTargetId:  pointer.To(model.DeploymentTargetId),
+					Status:              pointer.To(environmenttypes.EnvironmentTypeEnableStatusEnabled),
+					UserRoleAssignments: expandDevCenterUserRoleAssignments(model.UserRoleAssignment),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			if _, err := client.ProjectEnvironmentTypesCreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.EnvironmentTypes
+
+			id, err := environmenttypes.ParseEnvironmentTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ProjectEnvironmentTypesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterProjectEnvironmentTypeResourceModel{
+				Name:               id.EnvironmentTypeName,
+				DevCenterProjectId: environmenttypes.NewProjectID(id.SubscriptionId, id.ResourceGroupName, id.ProjectName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(pointer.From(model.Location))
+				state.Tags = tags.Flatten(model.Tags)
+
+				identityValue, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(identityValue)
+
+				if props := model.Properties; props != nil {
+					state.DeploymentTargetId = pointer.From(props.DeploymentTargetId)
+					state.UserRoleAssignment = flattenDevCenterUserRoleAssignments(props.UserRoleAssignments)
+
+					if creator := props.CreatorRoleAssignment; creator != nil {
+						state.CreatorRoleAssignmentRoles = flattenDevCenterEnvironmentRoles(creator.Roles)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.EnvironmentTypes
+
+			id, err := environmenttypes.ParseEnvironmentTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterProjectEnvironmentTypeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := environmenttypes.ProjectEnvironmentTypeUpdate{
+				Properties: &environmenttypes.ProjectEnvironmentTypeUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = identityValue
+			}
+
+			if metadata.ResourceData.HasChange("deployment_target_id") {
+				payload.Properties.DeploymentTargetId = pointer.To(model.DeploymentTargetId)
+			}
+
+			if metadata.ResourceData.HasChange("creator_role_assignment_roles") {
+				payload.Properties.CreatorRoleAssignment = &environmenttypes.ProjectEnvironmentTypeUpdatePropertiesCreatorRoleAssignment{
+					Roles: expandDevCenterEnvironmentRoles(model.CreatorRoleAssignmentRoles),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("user_role_assignment") {
+				payload.Properties.UserRoleAssignments = expandDevCenterUserRoleAssignments(model.UserRoleAssignment)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(model.Tags)
+			}
+
+			if _, err := client.ProjectEnvironmentTypesUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.EnvironmentTypes
+
+			id, err := environmenttypes.ParseEnvironmentTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.ProjectEnvironmentTypesDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterEnvironmentRoles(input []string) *map[string]environmenttypes.EnvironmentRole {
+	result := make(map[string]environmenttypes.EnvironmentRole)
+	for _, roleId := range input {
+		result[roleId] = environmenttypes.EnvironmentRole{}
+	}
+
+	return &result
+}
+
+func flattenDevCenterEnvironmentRoles(input *map[string]environmenttypes.EnvironmentRole) []string {
+	result := make([]string, 0)
+	if input == nil {
+		return result
+	}
+
+	for roleId := range *input {
+		result = append(result, roleId)
+	}
+
+	return result
+}
+
+func expandDevCenterUserRoleAssignments(input []UserRoleAssignmentModel) *map[string]environmenttypes.UserRoleAssignment {
+	result := make(map[string]environmenttypes.UserRoleAssignment)
+	for _, v := range input {
+		result[v.UserId] = environmenttypes.UserRoleAssignment{
+			Roles: expandDevCenterEnvironmentRoles(v.Roles),
+		}
+	}
+
+	return &result
+}
+
+func flattenDevCenterUserRoleAssignments(input *map[string]environmenttypes.UserRoleAssignment) []UserRoleAssignmentModel {
+	result := make([]UserRoleAssignmentModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for userId, v := range *input {
+		result = append(result, UserRoleAssignmentModel{
+			UserId: userId,
+			Roles:  flattenDevCenterEnvironmentRoles(v.Roles),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/environmenttypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterProjectEnvironmentTypeTestResource struct{}
+
+func TestAccDevCenterProjectEnvironmentType_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_environment_type", "test")
+	r := DevCenterProjectEnvironmentTypeTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterProjectEnvironmentType_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_environment_type", "test")
+	r := DevCenterProjectEnvironmentTypeTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevCenterProjectEnvironmentType_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_environment_type", "test")
+	r := DevCenterProjectEnvironmentTypeTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterProjectEnvironmentType_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_environment_type", "test")
+	r := DevCenterProjectEnvironmentTypeTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DevCenterProjectEnvironmentTypeTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := environmenttypes.ParseEnvironmentTypeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevCenter.V20230401.EnvironmentTypes.ProjectEnvironmentTypesGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DevCenterProjectEnvironmentTypeTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_environment_type" "test" {
+  name                  = azurerm_dev_center_environment_type.test.name
+  location              = azurerm_resource_group.test.location
+  dev_center_project_id = azurerm_dev_center_project.test.id
+  deployment_target_id  = "/subscriptions/${data.azurerm_client_config.current.subscription_id}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.template(data))
+}
+
+func (r DevCenterProjectEnvironmentTypeTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_environment_type" "import" {
+  name                  = azurerm_dev_center_project_environment_type.test.name
+  location              = azurerm_dev_center_project_environment_type.test.location
+  dev_center_project_id = azurerm_dev_center_project_environment_type.test.dev_center_project_id
+  deployment_target_id  = azurerm_dev_center_project_environment_type.test.deployment_target_id
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.basic(data))
+}
+
+func (r DevCenterProjectEnvironmentTypeTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dev_center_project_environment_type" "test" {
+  name                  = azurerm_dev_center_environment_type.test.name
+  location              = azurerm_resource_group.test.location
+  dev_center_project_id = azurerm_dev_center_project.test.id
+  deployment_target_id  = "/subscriptions/${data.azurerm_client_config.current.subscription_id}"
+
+  identity {
+    type         = "SystemAssigned, UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  creator_role_assignment_roles = ["acdd72a7-3385-48ef-bd42-f606fba81ae7"]
+
+  user_role_assignment {
+    user_id = data.azurerm_client_config.current.object_id
+    roles   = ["b24988ac-6180-42a0-ab88-20f7382dd24c"]
+  }
+
+  tags = {
+    Env = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DevCenterProjectEnvironmentTypeTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+%s
+
+resource "azurerm_dev_center_environment_type" "test" {
+  name          = "acctest-et-%d"
+  dev_center_id = azurerm_dev_center.test.id
+}
+
+resource "azurerm_dev_center_project" "test" {
+  name                = "acctestdcp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  dev_center_id       = azurerm_dev_center.test.id
+}
+`, DevCenterEnvironmentTypeTestResource{}.template(data), data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/schedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterProjectPoolScheduleTestResource struct{}
+
+func TestAccDevCenterProjectPoolSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_pool_schedule", "test")
+	r := DevCenterProjectPoolScheduleTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterProjectPoolSchedule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_pool_schedule", "test")
+	r := DevCenterProjectPoolScheduleTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevCenterProjectPoolSchedule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_pool_schedule", "test")
+	r := DevCenterProjectPoolScheduleTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DevCenterProjectPoolScheduleTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := schedules.ParseScheduleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevCenter.V20230401.Schedules.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DevCenterProjectPoolScheduleTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_pool_schedule" "test" {
+  dev_center_project_pool_id = azurerm_dev_center_project_pool.test.id
+  time                       = "23:00"
+  time_zone                  = "Etc/UTC"
+}
+`, DevCenterProjectPoolTestResource{}.basic(data))
+}
+
+func (r DevCenterProjectPoolScheduleTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_pool_schedule" "import" {
+  dev_center_project_pool_id = azurerm_dev_center_project_pool_schedule.test.dev_center_project_pool_id
+  time                       = azurerm_dev_center_project_pool_schedule.test.time
+  time_zone                  = azurerm_dev_center_project_pool_schedule.test.time_zone
+}
+`, r.basic(data))
+}
+
+func (r DevCenterProjectPoolScheduleTestResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_pool_schedule" "test" {
+  dev_center_project_pool_id = azurerm_dev_center_project_pool.test.id
+  time                       = "19:30"
+  time_zone                  = "America/Los_Angeles"
+  enabled                    = false
+}
+`, DevCenterProjectPoolTestResource{}.basic(data))
+}
@@ -33,6 +33,13 @@ func (r Registration) Resources() []sdk.Resource {
 	resources := []sdk.Resource{
 		DevCenterGalleryResource{},
 		DevCenterCatalogsResource{},
+		DevCenterAttachedNetworkResource{},
+		DevCenterDevBoxDefinitionResource{},
+		DevCenterEnvironmentTypeResource{},
+		DevCenterNetworkConnectionResource{},
+		DevCenterProjectEnvironmentTypeResource{},
+		DevCenterProjectPoolResource{},
+		DevCenterProjectPoolScheduleResource{},
 	}
 	return append(resources, r.autoRegistration.Resources()...)
 }
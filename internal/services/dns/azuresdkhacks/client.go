@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// NOTE: this workaround is needed since DNSSEC Configs are only available from API Version `2023-07-01-preview`
+// onwards, which isn't available within the SDK - as such these operations are implemented here, reusing the
+// configured DNS Zones client and overriding the API Version on a per-request basis.
+// This can be removed once the SDK includes a DNS API Version supporting DNSSEC.
+
+const dnssecConfigsApiVersion = "2023-07-01-preview"
+
+type DnssecConfigsClient struct {
+	Client zones.ZonesClient
+}
+
+func NewDnssecConfigsClient(client zones.ZonesClient) DnssecConfigsClient {
+	return DnssecConfigsClient{
+		Client: client,
+	}
+}
+
+// dnssecConfigsOptions overrides the API Version used by the configured client
+type dnssecConfigsOptions struct{}
+
+func (o dnssecConfigsOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+	return &out
+}
+
+func (o dnssecConfigsOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	return &out
+}
+
+func (o dnssecConfigsOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	out.Append("api-version", dnssecConfigsApiVersion)
+	return &out
+}
@@ -0,0 +1,132 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+func init() {
+	recaser.RegisterResourceId(&DnssecConfigId{})
+}
+
+var _ resourceids.ResourceId = &DnssecConfigId{}
+
+// DnssecConfigId is a struct representing the Resource ID for a Dnssec Config
+type DnssecConfigId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	DnsZoneName       string
+}
+
+// NewDnssecConfigID returns a new DnssecConfigId struct
+func NewDnssecConfigID(subscriptionId string, resourceGroupName string, dnsZoneName string) DnssecConfigId {
+	return DnssecConfigId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		DnsZoneName:       dnsZoneName,
+	}
+}
+
+// ParseDnssecConfigID parses 'input' into a DnssecConfigId
+func ParseDnssecConfigID(input string) (*DnssecConfigId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&DnssecConfigId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := DnssecConfigId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseDnssecConfigIDInsensitively parses 'input' case-insensitively into a DnssecConfigId
+// note: this method should only be used for API response data and not user input
+func ParseDnssecConfigIDInsensitively(input string) (*DnssecConfigId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&DnssecConfigId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := DnssecConfigId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *DnssecConfigId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.DnsZoneName, ok = input.Parsed["dnsZoneName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "dnsZoneName", input)
+	}
+
+	return nil
+}
+
+// ValidateDnssecConfigID checks that 'input' can be parsed as a Dnssec Config ID
+func ValidateDnssecConfigID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseDnssecConfigID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Dnssec Config ID
+func (id DnssecConfigId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsZones/%s/dnssecConfigs/default"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Dnssec Config ID
+func (id DnssecConfigId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticDnsZones", "dnsZones", "dnsZones"),
+		resourceids.UserSpecifiedSegment("dnsZoneName", "dnsZoneValue"),
+		resourceids.StaticSegment("staticDnssecConfigs", "dnssecConfigs", "dnssecConfigs"),
+		resourceids.StaticSegment("staticDefault", "default", "default"),
+	}
+}
+
+// String returns a human-readable description of this Dnssec Config ID
+func (id DnssecConfigId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Dns Zone Name: %q", id.DnsZoneName),
+	}
+	return fmt.Sprintf("Dnssec Config (%s)", strings.Join(components, "\n"))
+}
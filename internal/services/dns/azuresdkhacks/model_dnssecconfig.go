@@ -0,0 +1,32 @@
+package azuresdkhacks
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DnssecConfig struct {
+	Etag       *string           `json:"etag,omitempty"`
+	Id         *string           `json:"id,omitempty"`
+	Name       *string           `json:"name,omitempty"`
+	Properties *DnssecProperties `json:"properties,omitempty"`
+	Type       *string           `json:"type,omitempty"`
+}
+
+type DnssecProperties struct {
+	ProvisioningState *string       `json:"provisioningState,omitempty"`
+	SigningKeys       *[]SigningKey `json:"signingKeys,omitempty"`
+}
+
+type SigningKey struct {
+	DelegationSignerInfo  *[]DelegationSignerInfo `json:"delegationSignerInfo,omitempty"`
+	Flags                 *int64                  `json:"flags,omitempty"`
+	KeyTag                *int64                  `json:"keyTag,omitempty"`
+	Protocol              *int64                  `json:"protocol,omitempty"`
+	PublicKey             *string                 `json:"publicKey,omitempty"`
+	SecurityAlgorithmType *int64                  `json:"securityAlgorithmType,omitempty"`
+}
+
+type DelegationSignerInfo struct {
+	DigestAlgorithmType *int64  `json:"digestAlgorithmType,omitempty"`
+	DigestValue         *string `json:"digestValue,omitempty"`
+	Record              *string `json:"record,omitempty"`
+}
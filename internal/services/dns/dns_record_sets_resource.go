@@ -0,0 +1,418 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// the name used by the API for Record Sets at the apex of the DNS Zone
+const dnsZoneApexRecordSetName = "@"
+
+type DnsRecordSetsResourceModel struct {
+	DnsZoneId string              `tfschema:"dns_zone_id"`
+	RecordSet []DnsRecordSetModel `tfschema:"record_set"`
+}
+
+type DnsRecordSetModel struct {
+	Name    string   `tfschema:"name"`
+	Type    string   `tfschema:"type"`
+	Ttl     int64    `tfschema:"ttl"`
+	Records []string `tfschema:"records"`
+}
+
+type DnsRecordSetsResource struct{}
+
+var _ sdk.ResourceWithUpdate = DnsRecordSetsResource{}
+
+func (r DnsRecordSetsResource) ModelObject() interface{} {
+	return &DnsRecordSetsResourceModel{}
+}
+
+func (r DnsRecordSetsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.RecordSetsID
+}
+
+func (r DnsRecordSetsResource) ResourceType() string {
+	return "azurerm_dns_record_sets"
+}
+
+func (r DnsRecordSetsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_zone_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: zones.ValidateDnsZoneID,
+		},
+
+		"record_set": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(bulkManageableRecordTypes, false),
+					},
+
+					"ttl": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 2147483647),
+					},
+
+					"records": {
+						Type:     pluginsdk.TypeSet,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DnsRecordSetsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DnsRecordSetsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DnsRecordSetsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Dns.RecordSets
+			zoneId, err := zones.ParseDnsZoneID(model.DnsZoneId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewRecordSetsID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.DnsZoneName)
+
+			desired, err := expandDnsRecordSets(model.RecordSet)
+			if err != nil {
+				return err
+			}
+
+			existing, _, err := listDnsRecordSets(ctx, client, id)
+			if err != nil {
+				return err
+			}
+
+			for key, recordSet := range desired {
+				if _, ok := existing[key]; ok {
+					recordSetId := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroup, id.DnsZoneName, recordSet.Type, recordSet.Name)
+					return fmt.Errorf("%s already exists - to be managed via Terraform it must be removed from the DNS Zone, or this resource must be imported using the ID %q", recordSetId, id.ID())
+				}
+			}
+
+			for _, recordSet := range desired {
+				if err := createOrUpdateDnsRecordSet(ctx, client, id, recordSet); err != nil {
+					return err
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DnsRecordSetsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.RecordSets
+
+			id, err := parse.RecordSetsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DnsRecordSetsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, httpResp, err := listDnsRecordSets(ctx, client, *id)
+			if err != nil {
+				if response.WasNotFound(httpResp) {
+					return metadata.MarkAsGone(*id)
+				}
+				return err
+			}
+
+			state := DnsRecordSetsResourceModel{
+				DnsZoneId: id.DnsZoneId().ID(),
+				RecordSet: make([]DnsRecordSetModel, 0),
+			}
+
+			if len(model.RecordSet) == 0 {
+				// when importing there are no known Record Sets, so everything within the DNS Zone is taken over
+				// other than the SOA and apex NS Record Sets which are managed by the DNS Zone itself
+				for _, recordSet := range existing {
+					if recordSet.Type == recordsets.RecordTypeSOA || isDnsZoneApexNameServers(recordSet.Type, recordSet.Name) {
+						continue
+					}
+					state.RecordSet = append(state.RecordSet, flattenDnsRecordSet(recordSet))
+				}
+			} else {
+				for _, item := range model.RecordSet {
+					recordSet, ok := existing[dnsRecordSetKey(recordsets.RecordType(item.Type), item.Name)]
+					if !ok {
+						log.Printf("[DEBUG] %s Record Set %q was not found within %s - removing from state", item.Type, item.Name, *id)
+						continue
+					}
+					flattened := flattenDnsRecordSet(recordSet)
+					// retain the casing of the name as specified in the configuration
+					flattened.Name = item.Name
+					state.RecordSet = append(state.RecordSet, flattened)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DnsRecordSetsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.RecordSets
+
+			id, err := parse.RecordSetsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DnsRecordSetsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			desired, err := expandDnsRecordSets(model.RecordSet)
+			if err != nil {
+				return err
+			}
+
+			existing, _, err := listDnsRecordSets(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+
+			old, _ := metadata.ResourceData.GetChange("record_set")
+			for _, raw := range old.(*pluginsdk.Set).List() {
+				item := raw.(map[string]interface{})
+				recordType := recordsets.RecordType(item["type"].(string))
+				key := dnsRecordSetKey(recordType, item["name"].(string))
+				if _, ok := desired[key]; ok {
+					continue
+				}
+
+				recordSet, ok := existing[key]
+				if !ok || isDnsZoneApexNameServers(recordType, recordSet.Name) {
+					continue
+				}
+
+				if err := deleteDnsRecordSet(ctx, client, *id, recordSet); err != nil {
+					return err
+				}
+			}
+
+			for key, recordSet := range desired {
+				if current, ok := existing[key]; ok && current.Ttl == recordSet.Ttl && reflect.DeepEqual(current.Records, recordSet.Records) {
+					continue
+				}
+
+				if err := createOrUpdateDnsRecordSet(ctx, client, *id, recordSet); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DnsRecordSetsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.RecordSets
+
+			id, err := parse.RecordSetsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DnsRecordSetsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, item := range model.RecordSet {
+				recordType := recordsets.RecordType(item.Type)
+				// the apex NS Record Set can't be deleted, it's removed alongside the DNS Zone
+				if isDnsZoneApexNameServers(recordType, item.Name) {
+					continue
+				}
+
+				recordSet := dnsRecordSet{
+					Name: item.Name,
+					Type: recordType,
+				}
+				if err := deleteDnsRecordSet(ctx, client, *id, recordSet); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// dnsRecordSet is a Record Set within the DNS Zone, with its records in their presentation format
+type dnsRecordSet struct {
+	Name    string
+	Type    recordsets.RecordType
+	Ttl     int64
+	Records []string
+}
+
+func dnsRecordSetKey(recordType recordsets.RecordType, name string) string {
+	return fmt.Sprintf("%s/%s", recordType, strings.ToLower(name))
+}
+
+func isDnsZoneApexNameServers(recordType recordsets.RecordType, name string) bool {
+	return recordType == recordsets.RecordTypeNS && name == dnsZoneApexRecordSetName
+}
+
+func expandDnsRecordSets(input []DnsRecordSetModel) (map[string]dnsRecordSet, error) {
+	results := make(map[string]dnsRecordSet)
+
+	for _, item := range input {
+		recordType := recordsets.RecordType(item.Type)
+		key := dnsRecordSetKey(recordType, item.Name)
+		if _, ok := results[key]; ok {
+			return nil, fmt.Errorf("the %s Record Set %q is defined more than once within `record_set`", item.Type, item.Name)
+		}
+
+		props, err := expandDnsRecordSetValues(recordType, item.Records, item.Ttl)
+		if err != nil {
+			return nil, fmt.Errorf("expanding the %s Record Set %q: %+v", item.Type, item.Name, err)
+		}
+
+		results[key] = dnsRecordSet{
+			Name: item.Name,
+			Type: recordType,
+			Ttl:  item.Ttl,
+			// the records are normalized so that they can be compared to those returned from the API
+			Records: flattenDnsRecordSetValues(recordType, props),
+		}
+	}
+
+	return results, nil
+}
+
+func flattenDnsRecordSet(input dnsRecordSet) DnsRecordSetModel {
+	return DnsRecordSetModel{
+		Name:    input.Name,
+		Type:    string(input.Type),
+		Ttl:     input.Ttl,
+		Records: input.Records,
+	}
+}
+
+// listDnsRecordSets retrieves all of the Record Sets within the DNS Zone using a single List operation
+func listDnsRecordSets(ctx context.Context, client *recordsets.RecordSetsClient, id parse.RecordSetsId) (map[string]dnsRecordSet, *http.Response, error) {
+	zoneId := recordsets.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnsZoneName)
+
+	resp, err := client.ListAllByDnsZoneComplete(ctx, zoneId, recordsets.DefaultListAllByDnsZoneOperationOptions())
+	if err != nil {
+		return nil, resp.LatestHttpResponse, fmt.Errorf("listing Record Sets within %s: %+v", zoneId, err)
+	}
+
+	results := make(map[string]dnsRecordSet)
+	for _, item := range resp.Items {
+		if item.Id == nil {
+			continue
+		}
+
+		recordSetId, err := recordsets.ParseRecordTypeIDInsensitively(*item.Id)
+		if err != nil {
+			return nil, resp.LatestHttpResponse, err
+		}
+
+		results[dnsRecordSetKey(recordSetId.RecordType, recordSetId.RelativeRecordSetName)] = dnsRecordSet{
+			Name:    recordSetId.RelativeRecordSetName,
+			Type:    recordSetId.RecordType,
+			Ttl:     pointer.From(pointer.From(item.Properties).TTL),
+			Records: flattenDnsRecordSetValues(recordSetId.RecordType, item.Properties),
+		}
+	}
+
+	return results, resp.LatestHttpResponse, nil
+}
+
+func createOrUpdateDnsRecordSet(ctx context.Context, client *recordsets.RecordSetsClient, id parse.RecordSetsId, input dnsRecordSet) error {
+	recordSetId := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroup, id.DnsZoneName, input.Type, input.Name)
+
+	props, err := expandDnsRecordSetValues(input.Type, input.Records, input.Ttl)
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", recordSetId, err)
+	}
+
+	payload := recordsets.RecordSet{
+		Properties: props,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, recordSetId, payload, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", recordSetId, err)
+	}
+
+	return nil
+}
+
+func deleteDnsRecordSet(ctx context.Context, client *recordsets.RecordSetsClient, id parse.RecordSetsId, input dnsRecordSet) error {
+	recordSetId := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroup, id.DnsZoneName, input.Type, input.Name)
+
+	if _, err := client.Delete(ctx, recordSetId, recordsets.DefaultDeleteOperationOptions()); err != nil {
+		return fmt.Errorf("deleting %s: %+v", recordSetId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DnsRecordSetsResource struct{}
+
+func TestAccDnsRecordSets_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_record_sets", "test")
+	r := DnsRecordSetsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsRecordSets_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_record_sets", "test")
+	r := DnsRecordSetsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: regexp.MustCompile("already exists - to be managed via Terraform"),
+		},
+	})
+}
+
+func TestAccDnsRecordSets_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_record_sets", "test")
+	r := DnsRecordSetsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("9"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsRecordSets_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_record_sets", "test")
+	r := DnsRecordSetsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.recordSetRemoved(recordsets.RecordTypeMX, "@")),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DnsRecordSetsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RecordSetsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneId := recordsets.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnsZoneName)
+	resp, err := clients.Dns.RecordSets.ListAllByDnsZoneComplete(ctx, zoneId, recordsets.DefaultListAllByDnsZoneOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Record Sets within %s: %+v", zoneId, err)
+	}
+
+	return utils.Bool(len(resp.Items) > 0), nil
+}
+
+func (DnsRecordSetsResource) recordSetRemoved(recordType recordsets.RecordType, name string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.RecordSetsID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		recordSetId := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroup, id.DnsZoneName, recordType, name)
+		resp, err := clients.Dns.RecordSets.Get(ctx, recordSetId)
+		if err == nil {
+			return fmt.Errorf("%s still exists", recordSetId)
+		}
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("retrieving %s: %+v", recordSetId, err)
+		}
+
+		return nil
+	}
+}
+
+func (DnsRecordSetsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DnsRecordSetsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_record_sets" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+
+  record_set {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["10.0.0.1", "10.0.0.2"]
+  }
+
+  record_set {
+    name    = "www"
+    type    = "TXT"
+    ttl     = 300
+    records = ["Quick brown fox"]
+  }
+}
+`, r.template(data))
+}
+
+func (r DnsRecordSetsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_record_sets" "import" {
+  dns_zone_id = azurerm_dns_record_sets.test.dns_zone_id
+
+  record_set {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["10.0.0.1", "10.0.0.2"]
+  }
+}
+`, r.basic(data))
+}
+
+func (r DnsRecordSetsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_record_sets" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+
+  record_set {
+    name    = "www"
+    type    = "A"
+    ttl     = 600
+    records = ["10.0.0.1", "10.0.0.3"]
+  }
+
+  record_set {
+    name    = "www"
+    type    = "TXT"
+    ttl     = 300
+    records = ["Quick brown fox", "v=spf1 include:contoso.com -all"]
+  }
+
+  record_set {
+    name    = "www"
+    type    = "AAAA"
+    ttl     = 300
+    records = ["2607:f8b0:4009:1803::1005"]
+  }
+
+  record_set {
+    name    = "@"
+    type    = "CAA"
+    ttl     = 300
+    records = ["0 issue example.com", "0 iodef mailto:terraform@nonexisting.tld"]
+  }
+
+  record_set {
+    name    = "alias"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["www.contoso.com"]
+  }
+
+  record_set {
+    name    = "@"
+    type    = "MX"
+    ttl     = 300
+    records = ["10 mail1.contoso.com", "20 mail2.contoso.com"]
+  }
+
+  record_set {
+    name    = "delegated"
+    type    = "NS"
+    ttl     = 300
+    records = ["ns1.contoso.com.", "ns2.contoso.com."]
+  }
+
+  record_set {
+    name    = "10"
+    type    = "PTR"
+    ttl     = 300
+    records = ["hashicorp.com"]
+  }
+
+  record_set {
+    name    = "_sip._tcp"
+    type    = "SRV"
+    ttl     = 300
+    records = ["1 5 8080 target1.contoso.com", "2 10 8080 target2.contoso.com"]
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsZoneDnssecConfigResourceModel struct {
+	DnsZoneId  string                    `tfschema:"dns_zone_id"`
+	SigningKey []DnsZoneDnssecSigningKey `tfschema:"signing_key"`
+}
+
+type DnsZoneDnssecSigningKey struct {
+	DelegationSignerInfo  []DnsZoneDnssecDelegationSignerInfo `tfschema:"delegation_signer_info"`
+	Flags                 int64                               `tfschema:"flags"`
+	KeyTag                int64                               `tfschema:"key_tag"`
+	Protocol              int64                               `tfschema:"protocol"`
+	PublicKey             string                              `tfschema:"public_key"`
+	SecurityAlgorithmType int64                               `tfschema:"security_algorithm_type"`
+}
+
+type DnsZoneDnssecDelegationSignerInfo struct {
+	DigestAlgorithmType int64  `tfschema:"digest_algorithm_type"`
+	DigestValue         string `tfschema:"digest_value"`
+	Record              string `tfschema:"record"`
+}
+
+type DnsZoneDnssecConfigResource struct{}
+
+var _ sdk.Resource = DnsZoneDnssecConfigResource{}
+
+func (r DnsZoneDnssecConfigResource) ModelObject() interface{} {
+	return &DnsZoneDnssecConfigResourceModel{}
+}
+
+func (r DnsZoneDnssecConfigResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateDnssecConfigID
+}
+
+func (r DnsZoneDnssecConfigResource) ResourceType() string {
+	return "azurerm_dns_zone_dnssec_config"
+}
+
+func (r DnsZoneDnssecConfigResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_zone_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: zones.ValidateDnsZoneID,
+		},
+	}
+}
+
+func (r DnsZoneDnssecConfigResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"signing_key": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"delegation_signer_info": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"digest_algorithm_type": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"digest_value": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"record": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+
+					"flags": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"key_tag": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"protocol": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"public_key": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"security_algorithm_type": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DnsZoneDnssecConfigResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DnsZoneDnssecConfigResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := azuresdkhacks.NewDnssecConfigsClient(*metadata.Client.Dns.Zones)
+			zoneId, err := zones.ParseDnsZoneID(model.DnsZoneId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewDnssecConfigID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.DnsZoneName)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DnsZoneDnssecConfigResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewDnssecConfigsClient(*metadata.Client.Dns.Zones)
+
+			id, err := azuresdkhacks.ParseDnssecConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DnsZoneDnssecConfigResourceModel{
+				DnsZoneId: zones.NewDnsZoneID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.SigningKey = flattenDnsZoneDnssecSigningKeys(props.SigningKeys)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DnsZoneDnssecConfigResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewDnssecConfigsClient(*metadata.Client.Dns.Zones)
+
+			id, err := azuresdkhacks.ParseDnssecConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenDnsZoneDnssecSigningKeys(input *[]azuresdkhacks.SigningKey) []DnsZoneDnssecSigningKey {
+	results := make([]DnsZoneDnssecSigningKey, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		delegationSignerInfo := make([]DnsZoneDnssecDelegationSignerInfo, 0)
+		if item.DelegationSignerInfo != nil {
+			for _, info := range *item.DelegationSignerInfo {
+				delegationSignerInfo = append(delegationSignerInfo, DnsZoneDnssecDelegationSignerInfo{
+					DigestAlgorithmType: pointer.From(info.DigestAlgorithmType),
+					DigestValue:         pointer.From(info.DigestValue),
+					Record:              pointer.From(info.Record),
+				})
+			}
+		}
+
+		results = append(results, DnsZoneDnssecSigningKey{
+			DelegationSignerInfo:  delegationSignerInfo,
+			Flags:                 pointer.From(item.Flags),
+			KeyTag:                pointer.From(item.KeyTag),
+			Protocol:              pointer.From(item.Protocol),
+			PublicKey:             pointer.From(item.PublicKey),
+			SecurityAlgorithmType: pointer.From(item.SecurityAlgorithmType),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DnsZoneDnssecConfigResource struct{}
+
+func TestAccDnsZoneDnssecConfig_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_dnssec_config", "test")
+	r := DnsZoneDnssecConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("signing_key.#").Exists(),
+				check.That(data.ResourceName).Key("signing_key.0.public_key").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsZoneDnssecConfig_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_dnssec_config", "test")
+	r := DnsZoneDnssecConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (DnsZoneDnssecConfigResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParseDnssecConfigID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := azuresdkhacks.NewDnssecConfigsClient(*clients.Dns.Zones)
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (DnsZoneDnssecConfigResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone_dnssec_config" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DnsZoneDnssecConfigResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_dnssec_config" "import" {
+  dns_zone_id = azurerm_dns_zone_dnssec_config.test.dns_zone_id
+}
+`, r.basic(data))
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+)
+
+var _ resourceids.Id = RecordSetsId{}
+
+// RecordSetsId identifies the collection of Record Sets managed in bulk within a DNS Zone
+type RecordSetsId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnsZoneName    string
+}
+
+const recordSetsSuffix = "/recordsets"
+
+func NewRecordSetsID(subscriptionId, resourceGroup, dnsZoneName string) RecordSetsId {
+	return RecordSetsId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnsZoneName:    dnsZoneName,
+	}
+}
+
+func (id RecordSetsId) String() string {
+	segments := []string{
+		fmt.Sprintf("Dns Zone Name %q", id.DnsZoneName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Record Sets", segmentsStr)
+}
+
+func (id RecordSetsId) ID() string {
+	return id.DnsZoneId().ID() + recordSetsSuffix
+}
+
+// DnsZoneId returns the ID of the DNS Zone containing these Record Sets
+func (id RecordSetsId) DnsZoneId() zones.DnsZoneId {
+	return zones.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnsZoneName)
+}
+
+// RecordSetsID parses a Record Sets ID into a RecordSetsId struct
+func RecordSetsID(input string) (*RecordSetsId, error) {
+	if !strings.HasSuffix(input, recordSetsSuffix) {
+		return nil, fmt.Errorf("expected the Record Sets ID %q to end with %q", input, recordSetsSuffix)
+	}
+
+	zoneId, err := zones.ParseDnsZoneID(strings.TrimSuffix(input, recordSetsSuffix))
+	if err != nil {
+		return nil, fmt.Errorf("parsing Record Sets ID %q: %+v", input, err)
+	}
+
+	resourceId := NewRecordSetsID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.DnsZoneName)
+	return &resourceId, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestRecordSetsIDFormatter(t *testing.T) {
+	actual := NewRecordSetsID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1.com").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsZones/zone1.com/recordsets"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestRecordSetsID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *RecordSetsId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing the recordsets suffix
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsZones/zone1.com",
+			Error: true,
+		},
+
+		{
+			// missing value for DnsZoneName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsZones/recordsets",
+			Error: true,
+		},
+
+		{
+			// a single record set rather than the collection
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsZones/zone1.com/A/www",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsZones/zone1.com/recordsets",
+			Expected: &RecordSetsId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				DnsZoneName:    "zone1.com",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := RecordSetsID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.DnsZoneName != v.Expected.DnsZoneName {
+			t.Fatalf("Expected %q but got %q for DnsZoneName", v.Expected.DnsZoneName, actual.DnsZoneName)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+)
+
+// the record types which can be managed via `azurerm_dns_record_sets`, the SOA record is managed via the DNS Zone
+var bulkManageableRecordTypes = []string{
+	string(recordsets.RecordTypeA),
+	string(recordsets.RecordTypeAAAA),
+	string(recordsets.RecordTypeCAA),
+	string(recordsets.RecordTypeCNAME),
+	string(recordsets.RecordTypeMX),
+	string(recordsets.RecordTypeNS),
+	string(recordsets.RecordTypePTR),
+	string(recordsets.RecordTypeSRV),
+	string(recordsets.RecordTypeTXT),
+}
+
+// expandDnsRecordSetValues converts a list of values in their presentation format (e.g. `10 mail.example.com` for
+// an MX record) into the Record Set Properties for the specified Record Type.
+func expandDnsRecordSetValues(recordType recordsets.RecordType, values []string, ttl int64) (*recordsets.RecordSetProperties, error) {
+	props := &recordsets.RecordSetProperties{
+		TTL: pointer.To(ttl),
+	}
+
+	switch recordType {
+	case recordsets.RecordTypeA:
+		records := make([]recordsets.ARecord, 0)
+		for _, v := range values {
+			ip := net.ParseIP(v)
+			if ip == nil || ip.To4() == nil {
+				return nil, fmt.Errorf("%q is not a valid IPv4 Address", v)
+			}
+			records = append(records, recordsets.ARecord{
+				IPv4Address: pointer.To(v),
+			})
+		}
+		props.ARecords = &records
+
+	case recordsets.RecordTypeAAAA:
+		records := make([]recordsets.AaaaRecord, 0)
+		for _, v := range values {
+			ip := net.ParseIP(v)
+			if ip == nil || ip.To4() != nil {
+				return nil, fmt.Errorf("%q is not a valid IPv6 Address", v)
+			}
+			if normalized := NormalizeIPv6Address(v); normalized != v {
+				return nil, fmt.Errorf("expected the IPv6 Address %q to be specified in its compressed form %q", v, normalized)
+			}
+			records = append(records, recordsets.AaaaRecord{
+				IPv6Address: pointer.To(v),
+			})
+		}
+		props.AAAARecords = &records
+
+	case recordsets.RecordTypeCAA:
+		records := make([]recordsets.CaaRecord, 0)
+		for _, v := range values {
+			segments := strings.SplitN(v, " ", 3)
+			if len(segments) != 3 {
+				return nil, fmt.Errorf("expected the CAA record %q to be in the format `flags tag value`", v)
+			}
+			flags, err := strconv.ParseInt(segments[0], 10, 64)
+			if err != nil || flags < 0 || flags > 255 {
+				return nil, fmt.Errorf("expected the flags of the CAA record %q to be an integer between 0 and 255", v)
+			}
+			records = append(records, recordsets.CaaRecord{
+				Flags: pointer.To(flags),
+				Tag:   pointer.To(segments[1]),
+				Value: pointer.To(segments[2]),
+			})
+		}
+		props.CaaRecords = &records
+
+	case recordsets.RecordTypeCNAME:
+		if len(values) != 1 {
+			return nil, fmt.Errorf("a CNAME record set must contain exactly one record but got %d", len(values))
+		}
+		props.CNAMERecord = &recordsets.CnameRecord{
+			Cname: pointer.To(values[0]),
+		}
+
+	case recordsets.RecordTypeMX:
+		records := make([]recordsets.MxRecord, 0)
+		for _, v := range values {
+			segments := strings.Fields(v)
+			if len(segments) != 2 {
+				return nil, fmt.Errorf("expected the MX record %q to be in the format `preference exchange`", v)
+			}
+			preference, err := strconv.ParseInt(segments[0], 10, 64)
+			if err != nil || preference < 0 || preference > 65535 {
+				return nil, fmt.Errorf("expected the preference of the MX record %q to be an integer between 0 and 65535", v)
+			}
+			records = append(records, recordsets.MxRecord{
+				Exchange:   pointer.To(segments[1]),
+				Preference: pointer.To(preference),
+			})
+		}
+		props.MXRecords = &records
+
+	case recordsets.RecordTypeNS:
+		records := make([]recordsets.NsRecord, 0)
+		for _, v := range values {
+			records = append(records, recordsets.NsRecord{
+				Nsdname: pointer.To(v),
+			})
+		}
+		props.NSRecords = &records
+
+	case recordsets.RecordTypePTR:
+		records := make([]recordsets.PtrRecord, 0)
+		for _, v := range values {
+			records = append(records, recordsets.PtrRecord{
+				Ptrdname: pointer.To(v),
+			})
+		}
+		props.PTRRecords = &records
+
+	case recordsets.RecordTypeSRV:
+		records := make([]recordsets.SrvRecord, 0)
+		for _, v := range values {
+			segments := strings.Fields(v)
+			if len(segments) != 4 {
+				return nil, fmt.Errorf("expected the SRV record %q to be in the format `priority weight port target`", v)
+			}
+			numbers := make([]int64, 3)
+			for i, name := range []string{"priority", "weight", "port"} {
+				number, err := strconv.ParseInt(segments[i], 10, 64)
+				if err != nil || number < 0 || number > 65535 {
+					return nil, fmt.Errorf("expected the %s of the SRV record %q to be an integer between 0 and 65535", name, v)
+				}
+				numbers[i] = number
+			}
+			records = append(records, recordsets.SrvRecord{
+				Priority: pointer.To(numbers[0]),
+				Weight:   pointer.To(numbers[1]),
+				Port:     pointer.To(numbers[2]),
+				Target:   pointer.To(segments[3]),
+			})
+		}
+		props.SRVRecords = &records
+
+	case recordsets.RecordTypeTXT:
+		records := make([]recordsets.TxtRecord, 0)
+		segmentLen := 254
+		for _, v := range values {
+			if len(v) == 0 || len(v) > 4096 {
+				return nil, fmt.Errorf("expected the length of the TXT record %q to be between 1 and 4096", v)
+			}
+
+			var value []string
+			for len(v) > segmentLen {
+				value = append(value, v[:segmentLen])
+				v = v[segmentLen:]
+			}
+			value = append(value, v)
+
+			records = append(records, recordsets.TxtRecord{
+				Value: &value,
+			})
+		}
+		props.TXTRecords = &records
+
+	default:
+		return nil, fmt.Errorf("record type %q is not supported", string(recordType))
+	}
+
+	return props, nil
+}
+
+// flattenDnsRecordSetValues returns the values of the specified Record Type in their presentation format, sorted
+// so that they can be compared against one another.
+func flattenDnsRecordSetValues(recordType recordsets.RecordType, props *recordsets.RecordSetProperties) []string {
+	results := make([]string, 0)
+	if props == nil {
+		return results
+	}
+
+	switch recordType {
+	case recordsets.RecordTypeA:
+		if props.ARecords != nil {
+			for _, r := range *props.ARecords {
+				results = append(results, pointer.From(r.IPv4Address))
+			}
+		}
+
+	case recordsets.RecordTypeAAAA:
+		if props.AAAARecords != nil {
+			for _, r := range *props.AAAARecords {
+				results = append(results, NormalizeIPv6Address(pointer.From(r.IPv6Address)))
+			}
+		}
+
+	case recordsets.RecordTypeCAA:
+		if props.CaaRecords != nil {
+			for _, r := range *props.CaaRecords {
+				results = append(results, fmt.Sprintf("%d %s %s", pointer.From(r.Flags), pointer.From(r.Tag), pointer.From(r.Value)))
+			}
+		}
+
+	case recordsets.RecordTypeCNAME:
+		if props.CNAMERecord != nil && props.CNAMERecord.Cname != nil {
+			results = append(results, *props.CNAMERecord.Cname)
+		}
+
+	case recordsets.RecordTypeMX:
+		if props.MXRecords != nil {
+			for _, r := range *props.MXRecords {
+				results = append(results, fmt.Sprintf("%d %s", pointer.From(r.Preference), pointer.From(r.Exchange)))
+			}
+		}
+
+	case recordsets.RecordTypeNS:
+		if props.NSRecords != nil {
+			for _, r := range *props.NSRecords {
+				results = append(results, pointer.From(r.Nsdname))
+			}
+		}
+
+	case recordsets.RecordTypePTR:
+		if props.PTRRecords != nil {
+			for _, r := range *props.PTRRecords {
+				results = append(results, pointer.From(r.Ptrdname))
+			}
+		}
+
+	case recordsets.RecordTypeSRV:
+		if props.SRVRecords != nil {
+			for _, r := range *props.SRVRecords {
+				results = append(results, fmt.Sprintf("%d %d %d %s", pointer.From(r.Priority), pointer.From(r.Weight), pointer.From(r.Port), pointer.From(r.Target)))
+			}
+		}
+
+	case recordsets.RecordTypeTXT:
+		if props.TXTRecords != nil {
+			for _, r := range *props.TXTRecords {
+				value := ""
+				if r.Value != nil {
+					value = strings.Join(*r.Value, "")
+				}
+				results = append(results, value)
+			}
+		}
+	}
+
+	sort.Strings(results)
+	return results
+}
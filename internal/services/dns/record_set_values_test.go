@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+)
+
+func TestDnsRecordSetValuesRoundTrip(t *testing.T) {
+	cases := []struct {
+		Name       string
+		RecordType recordsets.RecordType
+		Input      []string
+		Expected   []string
+		Valid      bool
+	}{
+		{
+			Name:       "A records",
+			RecordType: recordsets.RecordTypeA,
+			Input:      []string{"10.0.0.2", "10.0.0.1"},
+			Expected:   []string{"10.0.0.1", "10.0.0.2"},
+			Valid:      true,
+		},
+		{
+			Name:       "A record with an IPv6 address",
+			RecordType: recordsets.RecordTypeA,
+			Input:      []string{"2001:db8::1"},
+			Valid:      false,
+		},
+		{
+			Name:       "AAAA record",
+			RecordType: recordsets.RecordTypeAAAA,
+			Input:      []string{"2001:db8::1"},
+			Expected:   []string{"2001:db8::1"},
+			Valid:      true,
+		},
+		{
+			Name:       "AAAA record which isn't compressed",
+			RecordType: recordsets.RecordTypeAAAA,
+			Input:      []string{"2001:0db8:0:0:0:0:0:1"},
+			Valid:      false,
+		},
+		{
+			Name:       "CAA record with a value containing spaces",
+			RecordType: recordsets.RecordTypeCAA,
+			Input:      []string{"0 iodef mailto:terraform@example.com some text"},
+			Expected:   []string{"0 iodef mailto:terraform@example.com some text"},
+			Valid:      true,
+		},
+		{
+			Name:       "CAA record with invalid flags",
+			RecordType: recordsets.RecordTypeCAA,
+			Input:      []string{"256 issue example.com"},
+			Valid:      false,
+		},
+		{
+			Name:       "CNAME record",
+			RecordType: recordsets.RecordTypeCNAME,
+			Input:      []string{"contoso.com"},
+			Expected:   []string{"contoso.com"},
+			Valid:      true,
+		},
+		{
+			Name:       "CNAME record with multiple values",
+			RecordType: recordsets.RecordTypeCNAME,
+			Input:      []string{"contoso.com", "example.com"},
+			Valid:      false,
+		},
+		{
+			Name:       "MX records",
+			RecordType: recordsets.RecordTypeMX,
+			Input:      []string{"20 mail2.contoso.com", "10 mail1.contoso.com"},
+			Expected:   []string{"10 mail1.contoso.com", "20 mail2.contoso.com"},
+			Valid:      true,
+		},
+		{
+			Name:       "MX record missing the preference",
+			RecordType: recordsets.RecordTypeMX,
+			Input:      []string{"mail1.contoso.com"},
+			Valid:      false,
+		},
+		{
+			Name:       "SRV record",
+			RecordType: recordsets.RecordTypeSRV,
+			Input:      []string{"1 5 8080 target1.contoso.com"},
+			Expected:   []string{"1 5 8080 target1.contoso.com"},
+			Valid:      true,
+		},
+		{
+			Name:       "SRV record with an invalid port",
+			RecordType: recordsets.RecordTypeSRV,
+			Input:      []string{"1 5 65536 target1.contoso.com"},
+			Valid:      false,
+		},
+		{
+			Name:       "TXT record longer than a single segment",
+			RecordType: recordsets.RecordTypeTXT,
+			Input:      []string{strings.Repeat("a", 300)},
+			Expected:   []string{strings.Repeat("a", 300)},
+			Valid:      true,
+		},
+		{
+			Name:       "SOA record",
+			RecordType: recordsets.RecordTypeSOA,
+			Input:      []string{"ns1-01.azure-dns.com."},
+			Valid:      false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		props, err := expandDnsRecordSetValues(tc.RecordType, tc.Input, 300)
+		if err != nil {
+			if tc.Valid {
+				t.Fatalf("expected %q to be valid but got an error: %+v", tc.Name, err)
+			}
+			continue
+		}
+		if !tc.Valid {
+			t.Fatalf("expected %q to be invalid but didn't get an error", tc.Name)
+		}
+
+		if actual := flattenDnsRecordSetValues(tc.RecordType, props); !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %q to flatten to %+v but got %+v", tc.Name, tc.Expected, actual)
+		}
+	}
+}
+
+func TestDnsRecordSetValuesTxtSegments(t *testing.T) {
+	props, err := expandDnsRecordSetValues(recordsets.RecordTypeTXT, []string{strings.Repeat("a", 600)}, 300)
+	if err != nil {
+		t.Fatalf("expanding TXT record: %+v", err)
+	}
+
+	segments := *(*props.TXTRecords)[0].Value
+	if len(segments) != 3 || len(segments[0]) != 254 || len(segments[1]) != 254 || len(segments[2]) != 92 {
+		t.Fatalf("expected the TXT record to be split into segments of 254 characters but got %d segments", len(segments))
+	}
+}
@@ -11,6 +11,7 @@ import (
 type Registration struct{}
 
 var _ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/dns"
@@ -60,3 +61,16 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_dns_zone":         resourceDnsZone(),
 	}
 }
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		DnsRecordSetsResource{},
+		DnsZoneDnssecConfigResource{},
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/cacertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceCaCertificateModel struct {
+	Name               string `tfschema:"name"`
+	NamespaceId        string `tfschema:"namespace_id"`
+	EncodedCertificate string `tfschema:"encoded_certificate"`
+	Description        string `tfschema:"description"`
+	ExpiryTimeInUtc    string `tfschema:"expiry_time_in_utc"`
+	IssueTimeInUtc     string `tfschema:"issue_time_in_utc"`
+}
+
+type EventGridNamespaceCaCertificateResource struct{}
+
+var (
+	_ sdk.Resource           = EventGridNamespaceCaCertificateResource{}
+	_ sdk.ResourceWithUpdate = EventGridNamespaceCaCertificateResource{}
+)
+
+func (r EventGridNamespaceCaCertificateResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_ca_certificate"
+}
+
+func (r EventGridNamespaceCaCertificateResource) ModelObject() interface{} {
+	return &EventGridNamespaceCaCertificateModel{}
+}
+
+func (r EventGridNamespaceCaCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return cacertificates.ValidateCaCertificateID
+}
+
+func (r EventGridNamespaceCaCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: eventGridNamespaceChildName("CA Certificate"),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: cacertificates.ValidateNamespaceID,
+		},
+
+		"encoded_certificate": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"expiry_time_in_utc": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"issue_time_in_utc": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceCaCertificatesClient
+
+			var model EventGridNamespaceCaCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := cacertificates.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := cacertificates.NewCaCertificateID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := cacertificates.CaCertificate{
+				Properties: &cacertificates.CaCertificateProperties{
+					EncodedCertificate: pointer.To(model.EncodedCertificate),
+				},
+			}
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceCaCertificatesClient
+
+			id, err := cacertificates.ParseCaCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceCaCertificateModel{
+				Name:        id.CaCertificateName,
+				NamespaceId: cacertificates.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EncodedCertificate = pointer.From(props.EncodedCertificate)
+					state.Description = pointer.From(props.Description)
+					state.ExpiryTimeInUtc = pointer.From(props.ExpiryTimeInUtc)
+					state.IssueTimeInUtc = pointer.From(props.IssueTimeInUtc)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceCaCertificatesClient
+
+			id, err := cacertificates.ParseCaCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceCaCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+			payload := existing.Model
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceCaCertificatesClient
+
+			id, err := cacertificates.ParseCaCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
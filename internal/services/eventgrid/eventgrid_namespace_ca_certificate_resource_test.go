@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/cacertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventGridNamespaceCaCertificateResource struct{}
+
+func TestAccEventGridNamespaceCaCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "root certificate"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("expiry_time_in_utc").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "rotated description"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespaceCaCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "root certificate"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (EventGridNamespaceCaCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := cacertificates.ParseCaCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.NamespaceCaCertificatesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r EventGridNamespaceCaCertificateResource) basic(data acceptance.TestData, description string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "tls_private_key" "test" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+
+resource "tls_self_signed_cert" "test" {
+  private_key_pem       = tls_private_key.test.private_key_pem
+  is_ca_certificate     = true
+  validity_period_hours = 48
+
+  subject {
+    common_name = "acctest-ca-%[2]d"
+  }
+
+  allowed_uses = [
+    "cert_signing",
+    "crl_signing",
+  ]
+}
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "test" {
+  name                = "acctest-egca-%[2]d"
+  namespace_id        = azurerm_eventgrid_namespace.test.id
+  encoded_certificate = tls_self_signed_cert.test.cert_pem
+  description         = "%[3]s"
+}
+`, EventGridNamespaceResource{}.basic(data), data.RandomInteger, description)
+}
+
+func (r EventGridNamespaceCaCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "import" {
+  name                = azurerm_eventgrid_namespace_ca_certificate.test.name
+  namespace_id        = azurerm_eventgrid_namespace_ca_certificate.test.namespace_id
+  encoded_certificate = azurerm_eventgrid_namespace_ca_certificate.test.encoded_certificate
+}
+`, r.basic(data, "root certificate"))
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/clientgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceClientGroupModel struct {
+	Name        string `tfschema:"name"`
+	NamespaceId string `tfschema:"namespace_id"`
+	Query       string `tfschema:"query"`
+	Description string `tfschema:"description"`
+}
+
+type EventGridNamespaceClientGroupResource struct{}
+
+var (
+	_ sdk.Resource           = EventGridNamespaceClientGroupResource{}
+	_ sdk.ResourceWithUpdate = EventGridNamespaceClientGroupResource{}
+)
+
+func (r EventGridNamespaceClientGroupResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_client_group"
+}
+
+func (r EventGridNamespaceClientGroupResource) ModelObject() interface{} {
+	return &EventGridNamespaceClientGroupModel{}
+}
+
+func (r EventGridNamespaceClientGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return clientgroups.ValidateClientGroupID
+}
+
+func (r EventGridNamespaceClientGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: eventGridNamespaceChildName("Client Group"),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: clientgroups.ValidateNamespaceID,
+		},
+
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+	}
+}
+
+func (r EventGridNamespaceClientGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceClientGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientGroupsClient
+
+			var model EventGridNamespaceClientGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := clientgroups.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := clientgroups.NewClientGroupID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := clientgroups.ClientGroup{
+				Properties: &clientgroups.ClientGroupProperties{
+					Query: pointer.To(model.Query),
+				},
+			}
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientGroupsClient
+
+			id, err := clientgroups.ParseClientGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceClientGroupModel{
+				Name:        id.ClientGroupName,
+				NamespaceId: clientgroups.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Query = pointer.From(props.Query)
+					state.Description = pointer.From(props.Description)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceClientGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientGroupsClient
+
+			id, err := clientgroups.ParseClientGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceClientGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+			payload := existing.Model
+
+			if metadata.ResourceData.HasChange("query") {
+				payload.Properties.Query = pointer.To(model.Query)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientGroupsClient
+
+			id, err := clientgroups.ParseClientGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
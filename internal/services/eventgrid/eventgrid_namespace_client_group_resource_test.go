@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/clientgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventGridNamespaceClientGroupResource struct{}
+
+func TestAccEventGridNamespaceClientGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_client_group", "test")
+	r := EventGridNamespaceClientGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespaceClientGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_client_group", "test")
+	r := EventGridNamespaceClientGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventGridNamespaceClientGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_client_group", "test")
+	r := EventGridNamespaceClientGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventGridNamespaceClientGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := clientgroups.ParseClientGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.NamespaceClientGroupsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r EventGridNamespaceClientGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_client_group" "test" {
+  name         = "acctest-egcg-%d"
+  namespace_id = azurerm_eventgrid_namespace.test.id
+  query        = "attributes.room IN ['kitchen']"
+}
+`, EventGridNamespaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r EventGridNamespaceClientGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_client_group" "import" {
+  name         = azurerm_eventgrid_namespace_client_group.test.name
+  namespace_id = azurerm_eventgrid_namespace_client_group.test.namespace_id
+  query        = azurerm_eventgrid_namespace_client_group.test.query
+}
+`, r.basic(data))
+}
+
+func (r EventGridNamespaceClientGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_client_group" "test" {
+  name         = "acctest-egcg-%d"
+  namespace_id = azurerm_eventgrid_namespace.test.id
+  query        = "attributes.room IN ['kitchen', 'lounge']"
+  description  = "devices in the kitchen and lounge"
+}
+`, EventGridNamespaceResource{}.basic(data), data.RandomInteger)
+}
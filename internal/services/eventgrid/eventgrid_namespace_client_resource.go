@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceClientModel struct {
+	Name                            string                                         `tfschema:"name"`
+	NamespaceId                     string                                         `tfschema:"namespace_id"`
+	AuthenticationName              string                                         `tfschema:"authentication_name"`
+	Attributes                      map[string]string                              `tfschema:"attributes"`
+	ClientCertificateAuthentication []EventGridNamespaceClientCertificateAuthModel `tfschema:"client_certificate_authentication"`
+	Description                     string                                         `tfschema:"description"`
+	Enabled                         bool                                           `tfschema:"enabled"`
+}
+
+type EventGridNamespaceClientCertificateAuthModel struct {
+	ValidationScheme   string   `tfschema:"validation_scheme"`
+	AllowedThumbprints []string `tfschema:"allowed_thumbprints"`
+}
+
+type EventGridNamespaceClientResource struct{}
+
+var (
+	_ sdk.Resource           = EventGridNamespaceClientResource{}
+	_ sdk.ResourceWithUpdate = EventGridNamespaceClientResource{}
+)
+
+func (r EventGridNamespaceClientResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_client"
+}
+
+func (r EventGridNamespaceClientResource) ModelObject() interface{} {
+	return &EventGridNamespaceClientModel{}
+}
+
+func (r EventGridNamespaceClientResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return clients.ValidateClientID
+}
+
+func (r EventGridNamespaceClientResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: eventGridNamespaceChildName("Client"),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: clients.ValidateNamespaceID,
+		},
+
+		"authentication_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"client_certificate_authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validation_scheme": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(clients.PossibleValuesForClientCertificateValidationScheme(), false),
+					},
+
+					"allowed_thumbprints": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 2,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceClientResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			var model EventGridNamespaceClientModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := clients.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := clients.NewClientID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := clients.Client{
+				Properties: expandEventGridNamespaceClientProperties(model),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceClientModel{
+				Name:        id.ClientName,
+				NamespaceId: clients.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AuthenticationName = pointer.From(props.AuthenticationName)
+					state.Attributes = flattenEventGridNamespaceClientAttributes(props.Attributes)
+					state.Description = pointer.From(props.Description)
+					state.Enabled = pointer.From(props.State) == clients.ClientStateEnabled
+
+					if auth := props.ClientCertificateAuthentication; auth != nil {
+						state.ClientCertificateAuthentication = []EventGridNamespaceClientCertificateAuthModel{
+							{
+								ValidationScheme:   string(pointer.From(auth.ValidationScheme)),
+								AllowedThumbprints: pointer.From(auth.AllowedThumbprints),
+							},
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceClientModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// all of the properties of a Client are sent on every update, so the payload is built from the config
+			payload := clients.Client{
+				Properties: expandEventGridNamespaceClientProperties(model),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceClientProperties(input EventGridNamespaceClientModel) *clients.ClientProperties {
+	state := clients.ClientStateDisabled
+	if input.Enabled {
+		state = clients.ClientStateEnabled
+	}
+
+	props := &clients.ClientProperties{
+		State: pointer.To(state),
+	}
+
+	if input.AuthenticationName != "" {
+		props.AuthenticationName = pointer.To(input.AuthenticationName)
+	}
+
+	if input.Description != "" {
+		props.Description = pointer.To(input.Description)
+	}
+
+	if len(input.Attributes) > 0 {
+		attributes := make(map[string]interface{})
+		for k, v := range input.Attributes {
+			attributes[k] = v
+		}
+		props.Attributes = pointer.To(interface{}(attributes))
+	}
+
+	if len(input.ClientCertificateAuthentication) > 0 {
+		auth := input.ClientCertificateAuthentication[0]
+		props.ClientCertificateAuthentication = &clients.ClientCertificateAuthentication{
+			ValidationScheme: pointer.To(clients.ClientCertificateValidationScheme(auth.ValidationScheme)),
+		}
+		if len(auth.AllowedThumbprints) > 0 {
+			props.ClientCertificateAuthentication.AllowedThumbprints = pointer.To(auth.AllowedThumbprints)
+		}
+	}
+
+	return props
+}
+
+func flattenEventGridNamespaceClientAttributes(input *interface{}) map[string]string {
+	result := make(map[string]string)
+	if input == nil {
+		return result
+	}
+
+	if attributes, ok := (*input).(map[string]interface{}); ok {
+		for k, v := range attributes {
+			result[k] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return result
+}
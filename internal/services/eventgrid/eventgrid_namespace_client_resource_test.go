@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	azureClients "github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventGridNamespaceClientResource struct{}
+
+func TestAccEventGridNamespaceClient_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_client", "test")
+	r := EventGridNamespaceClientResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authentication_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespaceClient_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_client", "test")
+	r := EventGridNamespaceClientResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventGridNamespaceClient_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_client", "test")
+	r := EventGridNamespaceClientResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventGridNamespaceClientResource) Exists(ctx context.Context, client *azureClients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := clients.ParseClientID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.EventGrid.NamespaceClientsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r EventGridNamespaceClientResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_client" "test" {
+  name         = "acctest-egc-%d"
+  namespace_id = azurerm_eventgrid_namespace.test.id
+
+  client_certificate_authentication {
+    validation_scheme = "SubjectMatchesAuthenticationName"
+  }
+}
+`, EventGridNamespaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r EventGridNamespaceClientResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_client" "import" {
+  name         = azurerm_eventgrid_namespace_client.test.name
+  namespace_id = azurerm_eventgrid_namespace_client.test.namespace_id
+
+  client_certificate_authentication {
+    validation_scheme = "SubjectMatchesAuthenticationName"
+  }
+}
+`, r.basic(data))
+}
+
+func (r EventGridNamespaceClientResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_client" "test" {
+  name                = "acctest-egc-%d"
+  namespace_id        = azurerm_eventgrid_namespace.test.id
+  authentication_name = "device-%d"
+  description         = "a test device"
+  enabled             = false
+
+  attributes = {
+    room = "kitchen"
+  }
+
+  client_certificate_authentication {
+    validation_scheme   = "ThumbprintMatch"
+    allowed_thumbprints = ["3A1F6D8C2B7E4F9A0C5D1E6B8F2A4C7D9E0B3F5A"]
+  }
+}
+`, EventGridNamespaceResource{}.basic(data), data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/permissionbindings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespacePermissionBindingModel struct {
+	Name            string `tfschema:"name"`
+	NamespaceId     string `tfschema:"namespace_id"`
+	ClientGroupName string `tfschema:"client_group_name"`
+	TopicSpaceName  string `tfschema:"topic_space_name"`
+	Permission      string `tfschema:"permission"`
+	Description     string `tfschema:"description"`
+}
+
+type EventGridNamespacePermissionBindingResource struct{}
+
+var (
+	_ sdk.Resource           = EventGridNamespacePermissionBindingResource{}
+	_ sdk.ResourceWithUpdate = EventGridNamespacePermissionBindingResource{}
+)
+
+func (r EventGridNamespacePermissionBindingResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_permission_binding"
+}
+
+func (r EventGridNamespacePermissionBindingResource) ModelObject() interface{} {
+	return &EventGridNamespacePermissionBindingModel{}
+}
+
+func (r EventGridNamespacePermissionBindingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return permissionbindings.ValidatePermissionBindingID
+}
+
+func (r EventGridNamespacePermissionBindingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: eventGridNamespaceChildName("Permission Binding"),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: permissionbindings.ValidateNamespaceID,
+		},
+
+		"client_group_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: eventGridNamespaceChildName("Client Group"),
+		},
+
+		"topic_space_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: eventGridNamespaceChildName("Topic Space"),
+		},
+
+		"permission": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(permissionbindings.PossibleValuesForPermissionType(), false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+	}
+}
+
+func (r EventGridNamespacePermissionBindingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespacePermissionBindingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacePermissionBindingsClient
+
+			var model EventGridNamespacePermissionBindingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := permissionbindings.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := permissionbindings.NewPermissionBindingID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := permissionbindings.PermissionBinding{
+				Properties: &permissionbindings.PermissionBindingProperties{
+					ClientGroupName: pointer.To(model.ClientGroupName),
+					TopicSpaceName:  pointer.To(model.TopicSpaceName),
+					Permission:      pointer.To(permissionbindings.PermissionType(model.Permission)),
+				},
+			}
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespacePermissionBindingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacePermissionBindingsClient
+
+			id, err := permissionbindings.ParsePermissionBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespacePermissionBindingModel{
+				Name:        id.PermissionBindingName,
+				NamespaceId: permissionbindings.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ClientGroupName = pointer.From(props.ClientGroupName)
+					state.TopicSpaceName = pointer.From(props.TopicSpaceName)
+					state.Permission = string(pointer.From(props.Permission))
+					state.Description = pointer.From(props.Description)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespacePermissionBindingResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacePermissionBindingsClient
+
+			id, err := permissionbindings.ParsePermissionBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespacePermissionBindingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+			payload := existing.Model
+
+			if metadata.ResourceData.HasChange("permission") {
+				payload.Properties.Permission = pointer.To(permissionbindings.PermissionType(model.Permission))
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespacePermissionBindingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacePermissionBindingsClient
+
+			id, err := permissionbindings.ParsePermissionBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
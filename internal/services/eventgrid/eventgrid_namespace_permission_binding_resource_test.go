@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/permissionbindings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventGridNamespacePermissionBindingResource struct{}
+
+func TestAccEventGridNamespacePermissionBinding_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Publisher"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespacePermissionBinding_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Publisher"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventGridNamespacePermissionBinding_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Publisher"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "Subscriber"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permission").HasValue("Subscriber"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventGridNamespacePermissionBindingResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := permissionbindings.ParsePermissionBindingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.NamespacePermissionBindingsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r EventGridNamespacePermissionBindingResource) basic(data acceptance.TestData, permission string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_eventgrid_namespace_client_group" "test" {
+  name         = "acctest-egcg-%[2]d"
+  namespace_id = azurerm_eventgrid_namespace.test.id
+  query        = "attributes.room IN ['kitchen']"
+}
+
+resource "azurerm_eventgrid_namespace_topic_space" "test" {
+  name            = "acctest-egts-%[2]d"
+  namespace_id    = azurerm_eventgrid_namespace.test.id
+  topic_templates = ["devices/telemetry/#"]
+}
+
+resource "azurerm_eventgrid_namespace_permission_binding" "test" {
+  name              = "acctest-egpb-%[2]d"
+  namespace_id      = azurerm_eventgrid_namespace.test.id
+  client_group_name = azurerm_eventgrid_namespace_client_group.test.name
+  topic_space_name  = azurerm_eventgrid_namespace_topic_space.test.name
+  permission        = "%[3]s"
+}
+`, EventGridNamespaceResource{}.basic(data), data.RandomInteger, permission)
+}
+
+func (r EventGridNamespacePermissionBindingResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_permission_binding" "import" {
+  name              = azurerm_eventgrid_namespace_permission_binding.test.name
+  namespace_id      = azurerm_eventgrid_namespace_permission_binding.test.namespace_id
+  client_group_name = azurerm_eventgrid_namespace_permission_binding.test.client_group_name
+  topic_space_name  = azurerm_eventgrid_namespace_permission_binding.test.topic_space_name
+  permission        = azurerm_eventgrid_namespace_permission_binding.test.permission
+}
+`, r.basic(data, "Publisher"))
+}
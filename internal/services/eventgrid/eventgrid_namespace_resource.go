@@ -0,0 +1,478 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespacetopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceModel struct {
+	Name                     string                                     `tfschema:"name"`
+	ResourceGroupName        string                                     `tfschema:"resource_group_name"`
+	Location                 string                                     `tfschema:"location"`
+	Sku                      string                                     `tfschema:"sku"`
+	Capacity                 int64                                      `tfschema:"capacity"`
+	Identity                 []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	InboundIPRules           []EventGridNamespaceInboundIPRule          `tfschema:"inbound_ip_rule"`
+	PublicNetworkAccess      string                                     `tfschema:"public_network_access"`
+	TopicSpacesConfiguration []EventGridNamespaceTopicSpacesConfig      `tfschema:"topic_spaces_configuration"`
+	Tags                     map[string]interface{}                     `tfschema:"tags"`
+
+	TopicsEndpoint string `tfschema:"topics_endpoint"`
+	MqttHostname   string `tfschema:"mqtt_hostname"`
+}
+
+type EventGridNamespaceInboundIPRule struct {
+	IPMask string `tfschema:"ip_mask"`
+	Action string `tfschema:"action"`
+}
+
+type EventGridNamespaceTopicSpacesConfig struct {
+	AlternativeAuthenticationNameSources       []string `tfschema:"alternative_authentication_name_sources"`
+	MaximumClientSessionsPerAuthenticationName int64    `tfschema:"maximum_client_sessions_per_authentication_name"`
+	MaximumSessionExpiryInHours                int64    `tfschema:"maximum_session_expiry_in_hours"`
+	RouteTopicId                               string   `tfschema:"route_topic_id"`
+}
+
+type EventGridNamespaceResource struct{}
+
+var (
+	_ sdk.Resource           = EventGridNamespaceResource{}
+	_ sdk.ResourceWithUpdate = EventGridNamespaceResource{}
+)
+
+func (r EventGridNamespaceResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace"
+}
+
+func (r EventGridNamespaceResource) ModelObject() interface{} {
+	return &EventGridNamespaceModel{}
+}
+
+func (r EventGridNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return namespaces.ValidateNamespaceID
+}
+
+func (r EventGridNamespaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid Namespace name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(namespaces.SkuNameStandard),
+			ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForSkuName(), false),
+		},
+
+		"capacity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 40),
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"inbound_ip_rule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 128,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_mask": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPv4Address),
+					},
+
+					"action": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(namespaces.IPActionTypeAllow),
+						ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForIPActionType(), false),
+					},
+				},
+			},
+		},
+
+		"public_network_access": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(namespaces.PublicNetworkAccessEnabled),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(namespaces.PublicNetworkAccessEnabled),
+				string(namespaces.PublicNetworkAccessDisabled),
+			}, false),
+		},
+
+		"topic_spaces_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"alternative_authentication_name_sources": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForAlternativeAuthenticationNameSource(), false),
+						},
+					},
+
+					"maximum_client_sessions_per_authentication_name": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+
+					"maximum_session_expiry_in_hours": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 8),
+					},
+
+					"route_topic_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: namespacetopics.ValidateNamespaceTopicID,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r EventGridNamespaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"topics_endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"mqtt_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model EventGridNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := namespaces.NewNamespaceID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := namespaces.Namespace{
+				Identity: identityValue,
+				Location: location.Normalize(model.Location),
+				Properties: &namespaces.NamespaceProperties{
+					InboundIPRules:           expandEventGridNamespaceInboundIPRules(model.InboundIPRules),
+					PublicNetworkAccess:      pointer.To(namespaces.PublicNetworkAccess(model.PublicNetworkAccess)),
+					TopicSpacesConfiguration: expandEventGridNamespaceTopicSpacesConfiguration(model.TopicSpacesConfiguration),
+				},
+				Sku: &namespaces.NamespaceSku{
+					Name:     pointer.To(namespaces.SkuName(model.Sku)),
+					Capacity: pointer.To(model.Capacity),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceModel{
+				Name:              id.NamespaceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				identityValue, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(identityValue)
+
+				if sku := model.Sku; sku != nil {
+					state.Sku = string(pointer.From(sku.Name))
+					state.Capacity = pointer.From(sku.Capacity)
+				}
+
+				if props := model.Properties; props != nil {
+					state.InboundIPRules = flattenEventGridNamespaceInboundIPRules(props.InboundIPRules)
+					state.PublicNetworkAccess = string(pointer.From(props.PublicNetworkAccess))
+					state.TopicSpacesConfiguration = flattenEventGridNamespaceTopicSpacesConfiguration(props.TopicSpacesConfiguration)
+
+					if topics := props.TopicsConfiguration; topics != nil {
+						state.TopicsEndpoint = pointer.From(topics.Hostname)
+					}
+					if topicSpaces := props.TopicSpacesConfiguration; topicSpaces != nil {
+						state.MqttHostname = pointer.From(topicSpaces.Hostname)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+			payload := existing.Model
+
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = identityValue
+			}
+
+			if metadata.ResourceData.HasChanges("sku", "capacity") {
+				payload.Sku = &namespaces.NamespaceSku{
+					Name:     pointer.To(namespaces.SkuName(model.Sku)),
+					Capacity: pointer.To(model.Capacity),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("inbound_ip_rule") {
+				payload.Properties.InboundIPRules = expandEventGridNamespaceInboundIPRules(model.InboundIPRules)
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access") {
+				payload.Properties.PublicNetworkAccess = pointer.To(namespaces.PublicNetworkAccess(model.PublicNetworkAccess))
+			}
+
+			if metadata.ResourceData.HasChange("topic_spaces_configuration") {
+				payload.Properties.TopicSpacesConfiguration = expandEventGridNamespaceTopicSpacesConfiguration(model.TopicSpacesConfiguration)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(model.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceInboundIPRules(input []EventGridNamespaceInboundIPRule) *[]namespaces.InboundIPRule {
+	result := make([]namespaces.InboundIPRule, 0)
+	for _, v := range input {
+		result = append(result, namespaces.InboundIPRule{
+			IPMask: pointer.To(v.IPMask),
+			Action: pointer.To(namespaces.IPActionType(v.Action)),
+		})
+	}
+
+	return &result
+}
+
+func flattenEventGridNamespaceInboundIPRules(input *[]namespaces.InboundIPRule) []EventGridNamespaceInboundIPRule {
+	result := make([]EventGridNamespaceInboundIPRule, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, EventGridNamespaceInboundIPRule{
+			IPMask: pointer.From(v.IPMask),
+			Action: string(pointer.From(v.Action)),
+		})
+	}
+
+	return result
+}
+
+func expandEventGridNamespaceTopicSpacesConfiguration(input []EventGridNamespaceTopicSpacesConfig) *namespaces.TopicSpacesConfiguration {
+	if len(input) == 0 {
+		return &namespaces.TopicSpacesConfiguration{
+			State: pointer.To(namespaces.TopicSpacesConfigurationStateDisabled),
+		}
+	}
+
+	config := input[0]
+	nameSources := make([]namespaces.AlternativeAuthenticationNameSource, 0)
+	for _, v := range config.AlternativeAuthenticationNameSources {
+		nameSources = append(nameSources, namespaces.AlternativeAuthenticationNameSource(v))
+	}
+
+	result := &namespaces.TopicSpacesConfiguration{
+		State: pointer.To(namespaces.TopicSpacesConfigurationStateEnabled),
+		ClientAuthentication: &namespaces.ClientAuthenticationSettings{
+			AlternativeAuthenticationNameSources: &nameSources,
+		},
+		MaximumClientSessionsPerAuthenticationName: pointer.To(config.MaximumClientSessionsPerAuthenticationName),
+		MaximumSessionExpiryInHours:                pointer.To(config.MaximumSessionExpiryInHours),
+	}
+	if config.RouteTopicId != "" {
+		result.RouteTopicResourceId = pointer.To(config.RouteTopicId)
+	}
+
+	return result
+}
+
+func flattenEventGridNamespaceTopicSpacesConfiguration(input *namespaces.TopicSpacesConfiguration) []EventGridNamespaceTopicSpacesConfig {
+	if input == nil || pointer.From(input.State) != namespaces.TopicSpacesConfigurationStateEnabled {
+		return []EventGridNamespaceTopicSpacesConfig{}
+	}
+
+	nameSources := make([]string, 0)
+	if auth := input.ClientAuthentication; auth != nil && auth.AlternativeAuthenticationNameSources != nil {
+		for _, v := range *auth.AlternativeAuthenticationNameSources {
+			nameSources = append(nameSources, string(v))
+		}
+	}
+
+	routeTopicId := ""
+	if v := pointer.From(input.RouteTopicResourceId); v != "" {
+		if parsed, err := namespacetopics.ParseNamespaceTopicIDInsensitively(v); err == nil {
+			routeTopicId = parsed.ID()
+		}
+	}
+
+	return []EventGridNamespaceTopicSpacesConfig{
+		{
+			AlternativeAuthenticationNameSources:       nameSources,
+			MaximumClientSessionsPerAuthenticationName: pointer.From(input.MaximumClientSessionsPerAuthenticationName),
+			MaximumSessionExpiryInHours:                pointer.From(input.MaximumSessionExpiryInHours),
+			RouteTopicId:                               routeTopicId,
+		},
+	}
+}
+
+// eventGridNamespaceChildName validates the name of the MQTT resources nested within an Event Grid Namespace
+func eventGridNamespaceChildName(resourceName string) pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+		fmt.Sprintf("EventGrid Namespace %s name must be 3 - 50 characters long, contain only letters, numbers and hyphens.", resourceName),
+	)
+}
@@ -0,0 +1,473 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/eventsubscriptions"
+	eventsubscriptions_v2023_12_15_preview "github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/eventsubscriptions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceTopicEventSubscriptionModel struct {
+	Name                string                                   `tfschema:"name"`
+	TopicId             string                                   `tfschema:"topic_id"`
+	EventDeliverySchema string                                   `tfschema:"event_delivery_schema"`
+	IncludedEventTypes  []string                                 `tfschema:"included_event_types"`
+	QueueDelivery       []EventGridNamespaceTopicQueueDelivery   `tfschema:"queue_delivery"`
+	PushDelivery        []EventGridNamespaceTopicPushDelivery    `tfschema:"push_delivery"`
+	AdvancedFilter      []EventGridNamespaceTopicAdvancedFilters `tfschema:"advanced_filter"`
+}
+
+type EventGridNamespaceTopicQueueDelivery struct {
+	ReceiveLockDurationInSeconds int64  `tfschema:"receive_lock_duration_in_seconds"`
+	MaxDeliveryCount             int64  `tfschema:"max_delivery_count"`
+	EventTimeToLive              string `tfschema:"event_time_to_live"`
+}
+
+type EventGridNamespaceTopicPushDelivery struct {
+	EventHubId             string `tfschema:"eventhub_id"`
+	IdentityType           string `tfschema:"identity_type"`
+	UserAssignedIdentityId string `tfschema:"user_assigned_identity_id"`
+	MaxDeliveryCount       int64  `tfschema:"max_delivery_count"`
+	EventTimeToLive        string `tfschema:"event_time_to_live"`
+}
+
+// EventGridNamespaceTopicAdvancedFilters is only present so the `advanced_filter` block can be decoded, the block is
+// expanded and flattened through the `pluginsdk.ResourceData` using the helpers shared with the other Event Subscriptions
+type EventGridNamespaceTopicAdvancedFilters struct{}
+
+type EventGridNamespaceTopicEventSubscriptionResource struct{}
+
+var (
+	_ sdk.Resource           = EventGridNamespaceTopicEventSubscriptionResource{}
+	_ sdk.ResourceWithUpdate = EventGridNamespaceTopicEventSubscriptionResource{}
+)
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_topic_event_subscription"
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ModelObject() interface{} {
+	return &EventGridNamespaceTopicEventSubscriptionModel{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return eventsubscriptions_v2023_12_15_preview.ValidateNamespaceTopicEventSubscriptionID
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": eventSubscriptionSchemaEventSubscriptionName(),
+
+		"topic_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: eventsubscriptions_v2023_12_15_preview.ValidateNamespaceTopicID,
+		},
+
+		"event_delivery_schema": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(eventsubscriptions_v2023_12_15_preview.DeliverySchemaCloudEventSchemaVOneZero),
+			ValidateFunc: validation.StringInSlice(eventsubscriptions_v2023_12_15_preview.PossibleValuesForDeliverySchema(), false),
+		},
+
+		"queue_delivery": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"queue_delivery", "push_delivery"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"receive_lock_duration_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      60,
+						ValidateFunc: validation.IntBetween(60, 300),
+					},
+
+					"max_delivery_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+
+					"event_time_to_live": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "P7D",
+						ValidateFunc: validate.ISO8601Duration,
+					},
+				},
+			},
+		},
+
+		"push_delivery": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"queue_delivery", "push_delivery"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"eventhub_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: eventhubs.ValidateEventhubID,
+					},
+
+					"identity_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(eventsubscriptions_v2023_12_15_preview.EventSubscriptionIdentityTypeSystemAssigned),
+						ValidateFunc: validation.StringInSlice(eventsubscriptions_v2023_12_15_preview.PossibleValuesForEventSubscriptionIdentityType(), false),
+					},
+
+					"user_assigned_identity_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+					},
+
+					"max_delivery_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+
+					"event_time_to_live": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "P7D",
+						ValidateFunc: validate.ISO8601Duration,
+					},
+				},
+			},
+		},
+
+		"included_event_types": eventSubscriptionSchemaIncludedEventTypes(),
+
+		"advanced_filter": eventSubscriptionSchemaAdvancedFilter(),
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicEventSubscriptionsClient
+
+			var model EventGridNamespaceTopicEventSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			topicId, err := eventsubscriptions_v2023_12_15_preview.ParseNamespaceTopicID(model.TopicId)
+			if err != nil {
+				return err
+			}
+
+			id := eventsubscriptions_v2023_12_15_preview.NewNamespaceTopicEventSubscriptionID(topicId.SubscriptionId, topicId.ResourceGroupName, topicId.NamespaceName, topicId.TopicName, model.Name)
+
+			existing, err := client.NamespaceTopicEventSubscriptionsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			filters, err := expandEventGridNamespaceTopicEventSubscriptionFilters(metadata.ResourceData, model.IncludedEventTypes)
+			if err != nil {
+				return fmt.Errorf("expanding `advanced_filter`: %+v", err)
+			}
+
+			payload := eventsubscriptions_v2023_12_15_preview.Subscription{
+				Properties: &eventsubscriptions_v2023_12_15_preview.SubscriptionProperties{
+					DeliveryConfiguration: expandEventGridNamespaceTopicEventSubscriptionDelivery(model),
+					EventDeliverySchema:   pointer.To(eventsubscriptions_v2023_12_15_preview.DeliverySchema(model.EventDeliverySchema)),
+					FiltersConfiguration:  filters,
+				},
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicEventSubscriptionsClient
+
+			id, err := eventsubscriptions_v2023_12_15_preview.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.NamespaceTopicEventSubscriptionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceTopicEventSubscriptionModel{
+				Name:    id.EventSubscriptionName,
+				TopicId: eventsubscriptions_v2023_12_15_preview.NewNamespaceTopicID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName).ID(),
+			}
+
+			advancedFilter := make([]interface{}, 0)
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EventDeliverySchema = string(pointer.From(props.EventDeliverySchema))
+
+					if delivery := props.DeliveryConfiguration; delivery != nil {
+						state.QueueDelivery = flattenEventGridNamespaceTopicQueueDelivery(delivery.Queue)
+						state.PushDelivery = flattenEventGridNamespaceTopicPushDelivery(delivery.Push)
+					}
+
+					if filters := props.FiltersConfiguration; filters != nil {
+						state.IncludedEventTypes = pointer.From(filters.IncludedEventTypes)
+
+						advancedFilter, err = flattenEventGridNamespaceTopicEventSubscriptionFilters(filters.Filters)
+						if err != nil {
+							return fmt.Errorf("flattening `advanced_filter`: %+v", err)
+						}
+					}
+				}
+			}
+
+			if err := metadata.Encode(&state); err != nil {
+				return err
+			}
+
+			return metadata.ResourceData.Set("advanced_filter", advancedFilter)
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicEventSubscriptionsClient
+
+			id, err := eventsubscriptions_v2023_12_15_preview.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceTopicEventSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := eventsubscriptions_v2023_12_15_preview.SubscriptionUpdateParameters{
+				Properties: &eventsubscriptions_v2023_12_15_preview.SubscriptionUpdateParametersProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("event_delivery_schema") {
+				payload.Properties.EventDeliverySchema = pointer.To(eventsubscriptions_v2023_12_15_preview.DeliverySchema(model.EventDeliverySchema))
+			}
+
+			if metadata.ResourceData.HasChanges("included_event_types", "advanced_filter") {
+				filters, err := expandEventGridNamespaceTopicEventSubscriptionFilters(metadata.ResourceData, model.IncludedEventTypes)
+				if err != nil {
+					return fmt.Errorf("expanding `advanced_filter`: %+v", err)
+				}
+				payload.Properties.FiltersConfiguration = filters
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicEventSubscriptionsClient
+
+			id, err := eventsubscriptions_v2023_12_15_preview.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceTopicEventSubscriptionDelivery(input EventGridNamespaceTopicEventSubscriptionModel) *eventsubscriptions_v2023_12_15_preview.DeliveryConfiguration {
+	if len(input.PushDelivery) > 0 {
+		push := input.PushDelivery[0]
+
+		identity := &eventsubscriptions_v2023_12_15_preview.EventSubscriptionIdentity{
+			Type: pointer.To(eventsubscriptions_v2023_12_15_preview.EventSubscriptionIdentityType(push.IdentityType)),
+		}
+		if push.UserAssignedIdentityId != "" {
+			identity.UserAssignedIdentity = pointer.To(push.UserAssignedIdentityId)
+		}
+
+		return &eventsubscriptions_v2023_12_15_preview.DeliveryConfiguration{
+			DeliveryMode: pointer.To(eventsubscriptions_v2023_12_15_preview.DeliveryModePush),
+			Push: &eventsubscriptions_v2023_12_15_preview.PushInfo{
+				DeliveryWithResourceIdentity: &eventsubscriptions_v2023_12_15_preview.DeliveryWithResourceIdentity{
+					Destination: eventsubscriptions_v2023_12_15_preview.EventHubEventSubscriptionDestination{
+						Properties: &eventsubscriptions_v2023_12_15_preview.EventHubEventSubscriptionDestinationProperties{
+							ResourceId: pointer.To(push.EventHubId),
+						},
+					},
+					Identity: identity,
+				},
+				EventTimeToLive:  pointer.To(push.EventTimeToLive),
+				MaxDeliveryCount: pointer.To(push.MaxDeliveryCount),
+			},
+		}
+	}
+
+	result := &eventsubscriptions_v2023_12_15_preview.DeliveryConfiguration{
+		DeliveryMode: pointer.To(eventsubscriptions_v2023_12_15_preview.DeliveryModeQueue),
+	}
+	if len(input.QueueDelivery) > 0 {
+		queue := input.QueueDelivery[0]
+		result.Queue = &eventsubscriptions_v2023_12_15_preview.QueueInfo{
+			EventTimeToLive:              pointer.To(queue.EventTimeToLive),
+			MaxDeliveryCount:             pointer.To(queue.MaxDeliveryCount),
+			ReceiveLockDurationInSeconds: pointer.To(queue.ReceiveLockDurationInSeconds),
+		}
+	}
+
+	return result
+}
+
+func flattenEventGridNamespaceTopicQueueDelivery(input *eventsubscriptions_v2023_12_15_preview.QueueInfo) []EventGridNamespaceTopicQueueDelivery {
+	if input == nil {
+		return []EventGridNamespaceTopicQueueDelivery{}
+	}
+
+	return []EventGridNamespaceTopicQueueDelivery{
+		{
+			ReceiveLockDurationInSeconds: pointer.From(input.ReceiveLockDurationInSeconds),
+			MaxDeliveryCount:             pointer.From(input.MaxDeliveryCount),
+			EventTimeToLive:              pointer.From(input.EventTimeToLive),
+		},
+	}
+}
+
+func flattenEventGridNamespaceTopicPushDelivery(input *eventsubscriptions_v2023_12_15_preview.PushInfo) []EventGridNamespaceTopicPushDelivery {
+	if input == nil {
+		return []EventGridNamespaceTopicPushDelivery{}
+	}
+
+	result := EventGridNamespaceTopicPushDelivery{
+		MaxDeliveryCount: pointer.From(input.MaxDeliveryCount),
+		EventTimeToLive:  pointer.From(input.EventTimeToLive),
+	}
+
+	if delivery := input.DeliveryWithResourceIdentity; delivery != nil {
+		if identity := delivery.Identity; identity != nil {
+			result.IdentityType = string(pointer.From(identity.Type))
+			result.UserAssignedIdentityId = pointer.From(identity.UserAssignedIdentity)
+		}
+
+		if destination, ok := delivery.Destination.(eventsubscriptions_v2023_12_15_preview.EventHubEventSubscriptionDestination); ok && destination.Properties != nil {
+			if eventHubId, err := eventhubs.ParseEventhubIDInsensitively(pointer.From(destination.Properties.ResourceId)); err == nil {
+				result.EventHubId = eventHubId.ID()
+			}
+		}
+	}
+
+	return []EventGridNamespaceTopicPushDelivery{result}
+}
+
+// expandEventGridNamespaceTopicEventSubscriptionFilters expands the `advanced_filter` block using the same helper as
+// the other Event Subscriptions. The filters of a Namespace Topic Event Subscription share the wire format of the
+// Advanced Filters of the other Event Subscriptions but are modelled as different types, so they're converted via JSON.
+func expandEventGridNamespaceTopicEventSubscriptionFilters(d *pluginsdk.ResourceData, includedEventTypes []string) (*eventsubscriptions_v2023_12_15_preview.FiltersConfiguration, error) {
+	advancedFilters := make([]eventsubscriptions.AdvancedFilter, 0)
+	if v, ok := d.GetOk("advanced_filter"); ok {
+		for filterKey, filterSchema := range v.([]interface{})[0].(map[string]interface{}) {
+			for _, options := range filterSchema.([]interface{}) {
+				filter, err := expandEventSubscriptionAdvancedFilter(filterKey, options.(map[string]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				advancedFilters = append(advancedFilters, filter)
+			}
+		}
+	}
+
+	raw, err := json.Marshal(map[string]interface{}{
+		"filters":            advancedFilters,
+		"includedEventTypes": includedEventTypes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshaling filters: %+v", err)
+	}
+
+	var result eventsubscriptions_v2023_12_15_preview.FiltersConfiguration
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("unmarshaling filters: %+v", err)
+	}
+
+	return &result, nil
+}
+
+func flattenEventGridNamespaceTopicEventSubscriptionFilters(input *[]eventsubscriptions_v2023_12_15_preview.Filter) ([]interface{}, error) {
+	if input == nil || len(*input) == 0 {
+		return []interface{}{}, nil
+	}
+
+	raw, err := json.Marshal(map[string]interface{}{
+		"advancedFilters": *input,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshaling filters: %+v", err)
+	}
+
+	var filter eventsubscriptions.EventSubscriptionFilter
+	if err := json.Unmarshal(raw, &filter); err != nil {
+		return nil, fmt.Errorf("unmarshaling filters: %+v", err)
+	}
+
+	return flattenEventSubscriptionAdvancedFilter(&filter), nil
+}
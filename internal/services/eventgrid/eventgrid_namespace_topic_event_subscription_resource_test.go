@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/eventsubscriptions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventGridNamespaceTopicEventSubscriptionResource struct{}
+
+func TestAccEventGridNamespaceTopicEventSubscription_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_topic_event_subscription", "test")
+	r := EventGridNamespaceTopicEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("queue_delivery.0.max_delivery_count").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespaceTopicEventSubscription_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_topic_event_subscription", "test")
+	r := EventGridNamespaceTopicEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventGridNamespaceTopicEventSubscription_filters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_topic_event_subscription", "test")
+	r := EventGridNamespaceTopicEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.filters(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("included_event_types.#").HasValue("2"),
+				check.That(data.ResourceName).Key("advanced_filter.0.string_begins_with.0.key").HasValue("subject"),
+				check.That(data.ResourceName).Key("advanced_filter.0.number_in.0.values.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespaceTopicEventSubscription_pushDelivery(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_topic_event_subscription", "test")
+	r := EventGridNamespaceTopicEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.pushDelivery(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventGridNamespaceTopicEventSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.NamespaceTopicEventSubscriptionsClient.NamespaceTopicEventSubscriptionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_topic_event_subscription" "test" {
+  name     = "acctest-egnes-%d"
+  topic_id = azurerm_eventgrid_namespace_topic.test.id
+
+  queue_delivery {}
+}
+`, EventGridNamespaceTopicResource{}.basic(data), data.RandomInteger)
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_topic_event_subscription" "import" {
+  name     = azurerm_eventgrid_namespace_topic_event_subscription.test.name
+  topic_id = azurerm_eventgrid_namespace_topic_event_subscription.test.topic_id
+
+  queue_delivery {}
+}
+`, r.basic(data))
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) filters(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_topic_event_subscription" "test" {
+  name                 = "acctest-egnes-%d"
+  topic_id             = azurerm_eventgrid_namespace_topic.test.id
+  included_event_types = ["Contoso.Items.ItemReceived", "Contoso.Items.ItemShipped"]
+
+  queue_delivery {
+    receive_lock_duration_in_seconds = 120
+    max_delivery_count               = 5
+    event_time_to_live               = "P1D"
+  }
+
+  advanced_filter {
+    string_begins_with {
+      key    = "subject"
+      values = ["orders/"]
+    }
+
+    number_in {
+      key    = "data.quantity"
+      values = [1, 2]
+    }
+  }
+}
+`, EventGridNamespaceTopicResource{}.basic(data), data.RandomInteger)
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) pushDelivery(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctest-ehns-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctest-eh-%[1]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 1
+  message_retention   = 1
+}
+
+resource "azurerm_eventgrid_namespace" "test" {
+  name                = "acctest-egns-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_eventhub.test.id
+  role_definition_name = "Azure Event Hubs Data Sender"
+  principal_id         = azurerm_eventgrid_namespace.test.identity.0.principal_id
+}
+
+resource "azurerm_eventgrid_namespace_topic" "test" {
+  name         = "acctest-egnt-%[1]d"
+  namespace_id = azurerm_eventgrid_namespace.test.id
+}
+
+resource "azurerm_eventgrid_namespace_topic_event_subscription" "test" {
+  name     = "acctest-egnes-%[1]d"
+  topic_id = azurerm_eventgrid_namespace_topic.test.id
+
+  push_delivery {
+    eventhub_id        = azurerm_eventhub.test.id
+    max_delivery_count = 3
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
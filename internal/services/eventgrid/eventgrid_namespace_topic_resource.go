@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespacetopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceTopicModel struct {
+	Name                 string `tfschema:"name"`
+	NamespaceId          string `tfschema:"namespace_id"`
+	EventRetentionInDays int64  `tfschema:"event_retention_in_days"`
+	InputSchema          string `tfschema:"input_schema"`
+	PublisherType        string `tfschema:"publisher_type"`
+}
+
+type EventGridNamespaceTopicResource struct{}
+
+var (
+	_ sdk.Resource           = EventGridNamespaceTopicResource{}
+	_ sdk.ResourceWithUpdate = EventGridNamespaceTopicResource{}
+)
+
+func (r EventGridNamespaceTopicResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_topic"
+}
+
+func (r EventGridNamespaceTopicResource) ModelObject() interface{} {
+	return &EventGridNamespaceTopicModel{}
+}
+
+func (r EventGridNamespaceTopicResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return namespacetopics.ValidateNamespaceTopicID
+}
+
+func (r EventGridNamespaceTopicResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid Namespace Topic name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespacetopics.ValidateNamespaceID,
+		},
+
+		"event_retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      7,
+			ValidateFunc: validation.IntBetween(1, 7),
+		},
+
+		"input_schema": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(namespacetopics.EventInputSchemaCloudEventSchemaVOneZero),
+			ValidateFunc: validation.StringInSlice(namespacetopics.PossibleValuesForEventInputSchema(), false),
+		},
+
+		"publisher_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(namespacetopics.PublisherTypeCustom),
+			ValidateFunc: validation.StringInSlice(namespacetopics.PossibleValuesForPublisherType(), false),
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceTopicResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicsClient
+
+			var model EventGridNamespaceTopicModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := namespacetopics.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := namespacetopics.NewNamespaceTopicID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := namespacetopics.NamespaceTopic{
+				Properties: &namespacetopics.NamespaceTopicProperties{
+					EventRetentionInDays: pointer.To(model.EventRetentionInDays),
+					InputSchema:          pointer.To(namespacetopics.EventInputSchema(model.InputSchema)),
+					PublisherType:        pointer.To(namespacetopics.PublisherType(model.PublisherType)),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicsClient
+
+			id, err := namespacetopics.ParseNamespaceTopicID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceTopicModel{
+				Name:        id.TopicName,
+				NamespaceId: namespacetopics.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EventRetentionInDays = pointer.From(props.EventRetentionInDays)
+					state.InputSchema = string(pointer.From(props.InputSchema))
+					state.PublisherType = string(pointer.From(props.PublisherType))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicsClient
+
+			id, err := namespacetopics.ParseNamespaceTopicID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceTopicModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := namespacetopics.NamespaceTopicUpdateParameters{
+				Properties: &namespacetopics.NamespaceTopicUpdateParameterProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("event_retention_in_days") {
+				payload.Properties.EventRetentionInDays = pointer.To(model.EventRetentionInDays)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicsClient
+
+			id, err := namespacetopics.ParseNamespaceTopicID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
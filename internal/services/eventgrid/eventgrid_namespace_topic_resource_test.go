@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespacetopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventGridNamespaceTopicResource struct{}
+
+func TestAccEventGridNamespaceTopic_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_topic", "test")
+	r := EventGridNamespaceTopicResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespaceTopic_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_topic", "test")
+	r := EventGridNamespaceTopicResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventGridNamespaceTopic_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_topic", "test")
+	r := EventGridNamespaceTopicResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.retention(data, 3),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("event_retention_in_days").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventGridNamespaceTopicResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := namespacetopics.ParseNamespaceTopicID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.NamespaceTopicsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r EventGridNamespaceTopicResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_topic" "test" {
+  name         = "acctest-egnt-%d"
+  namespace_id = azurerm_eventgrid_namespace.test.id
+}
+`, EventGridNamespaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r EventGridNamespaceTopicResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_topic" "import" {
+  name         = azurerm_eventgrid_namespace_topic.test.name
+  namespace_id = azurerm_eventgrid_namespace_topic.test.namespace_id
+}
+`, r.basic(data))
+}
+
+func (r EventGridNamespaceTopicResource) retention(data acceptance.TestData, days int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_topic" "test" {
+  name                    = "acctest-egnt-%d"
+  namespace_id            = azurerm_eventgrid_namespace.test.id
+  event_retention_in_days = %d
+}
+`, EventGridNamespaceResource{}.basic(data), data.RandomInteger, days)
+}
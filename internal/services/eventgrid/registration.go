@@ -10,7 +10,10 @@ import (
 
 type Registration struct{}
 
-var _ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+var (
+	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+)
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/event-grid"
@@ -49,3 +52,22 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_eventgrid_system_topic_event_subscription": resourceEventGridSystemTopicEventSubscription(),
 	}
 }
+
+// DataSources returns the typed DataSources supported by this service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns the typed Resources supported by this service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		EventGridNamespaceResource{},
+		EventGridNamespaceCaCertificateResource{},
+		EventGridNamespaceClientResource{},
+		EventGridNamespaceClientGroupResource{},
+		EventGridNamespacePermissionBindingResource{},
+		EventGridNamespaceTopicResource{},
+		EventGridNamespaceTopicEventSubscriptionResource{},
+		EventGridNamespaceTopicSpaceResource{},
+	}
+}
@@ -166,24 +166,49 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("retrieving certificate %q from keyvault: %+v", id.Name, err)
 	}
 
+	if pfx.ContentType == nil || pfx.Value == nil {
+		return fmt.Errorf("retrieving certificate %q from keyvault: `contentType` or `value` was nil", id.Name)
+	}
+	certificatePem, err := convertKeyVaultCertificateToPEM(id.Name, *pfx.ContentType, *pfx.Value)
+	if err != nil {
+		return err
+	}
+
+	d.Set("pem", certificatePem.Certificates)
+	d.Set("key", certificatePem.PrivateKey)
+	d.Set("certificates_count", certificatePem.CertificatesCount)
+
+	return tags.FlattenAndSet(d, cert.Tags)
+}
+
+// keyVaultCertificatePEM is the Certificate (including any Certificate Chain) and Private Key of a Key Vault Certificate, encoded as PEM
+type keyVaultCertificatePEM struct {
+	Certificates      string
+	CertificatesCount int
+	PrivateKey        string
+}
+
+// convertKeyVaultCertificateToPEM converts the Secret backing a Key Vault Certificate (which is either PKCS12 or PEM encoded) to PEM
+func convertKeyVaultCertificateToPEM(name string, contentType string, value string) (*keyVaultCertificatePEM, error) {
+	var err error
 	var PEMBlocks []*pem.Block
 
-	if *pfx.ContentType == "application/x-pkcs12" {
-		bytes, err := base64.StdEncoding.DecodeString(*pfx.Value)
+	if contentType == "application/x-pkcs12" {
+		bytes, err := base64.StdEncoding.DecodeString(value)
 		if err != nil {
-			return fmt.Errorf("decoding base64 certificate (%q): %+v", id.Name, err)
+			return nil, fmt.Errorf("decoding base64 certificate (%q): %+v", name, err)
 		}
 
 		// note PFX passwords are set to an empty string in Key Vault, this include password protected PFX uploads.
 		blocks, err := pkcs12.ToPEM(bytes, "")
 		if err != nil {
-			return fmt.Errorf("decoding certificate (%q): %+v", id.Name, err)
+			return nil, fmt.Errorf("decoding certificate (%q): %+v", name, err)
 		}
 		PEMBlocks = blocks
 	} else {
-		block, rest := pem.Decode([]byte(*pfx.Value))
+		block, rest := pem.Decode([]byte(value))
 		if block == nil {
-			return fmt.Errorf("decoding certificate (%q): %+v", id.Name, err)
+			return nil, fmt.Errorf("decoding certificate (%q): %+v", name, err)
 		}
 		PEMBlocks = append(PEMBlocks, block)
 		for len(rest) > 0 {
@@ -208,13 +233,13 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 
 	var privateKey interface{}
 
-	if *pfx.ContentType == "application/x-pkcs12" {
+	if contentType == "application/x-pkcs12" {
 		rsakey, err := x509.ParsePKCS1PrivateKey(pemKey)
 		if err != nil {
 			// try to parse as a EC key
 			eckey, err := x509.ParseECPrivateKey(pemKey)
 			if err != nil {
-				return fmt.Errorf("decoding private key: not RSA or ECDSA type (%q): %+v", id.Name, err)
+				return nil, fmt.Errorf("decoding private key: not RSA or ECDSA type (%q): %+v", name, err)
 			}
 			privateKey = eckey
 		} else {
@@ -223,7 +248,7 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 	} else {
 		pkey, err := x509.ParsePKCS8PrivateKey(pemKey)
 		if err != nil {
-			return fmt.Errorf("decoding PKCS8 RSA private key (%q): %+v", id.Name, err)
+			return nil, fmt.Errorf("decoding PKCS8 RSA private key (%q): %+v", name, err)
 		}
 		privateKey = pkey
 	}
@@ -235,14 +260,14 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 		case *ecdsa.PrivateKey:
 			keyX509, err = x509.MarshalECPrivateKey(privateKey.(*ecdsa.PrivateKey))
 			if err != nil {
-				return fmt.Errorf("marshalling private key type %+v (%q): %+v", v, id.Name, err)
+				return nil, fmt.Errorf("marshalling private key type %+v (%q): %+v", v, name, err)
 			}
 			pemKeyHeader = "EC PRIVATE KEY"
 		case *rsa.PrivateKey:
 			keyX509 = x509.MarshalPKCS1PrivateKey(privateKey.(*rsa.PrivateKey))
 			pemKeyHeader = "RSA PRIVATE KEY"
 		default:
-			return fmt.Errorf("marshalling private key type %+v (%q): key type is not supported", v, id.Name)
+			return nil, fmt.Errorf("marshalling private key type %+v (%q): key type is not supported", v, name)
 		}
 	}
 
@@ -255,7 +280,7 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 	var keyPEM bytes.Buffer
 	err = pem.Encode(&keyPEM, keyBlock)
 	if err != nil {
-		return fmt.Errorf("encoding Key Vault Certificate Key: %+v", err)
+		return nil, fmt.Errorf("encoding Key Vault Certificate Key: %+v", err)
 	}
 
 	certs := ""
@@ -269,14 +294,14 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 		var certPEM bytes.Buffer
 		err = pem.Encode(&certPEM, certBlock)
 		if err != nil {
-			return fmt.Errorf("encoding Key Vault Certificate PEM: %+v", err)
+			return nil, fmt.Errorf("encoding Key Vault Certificate PEM: %+v", err)
 		}
 		certs += certPEM.String()
 	}
 
-	d.Set("pem", certs)
-	d.Set("key", keyPEM.String())
-	d.Set("certificates_count", len(pemCerts))
-
-	return tags.FlattenAndSet(d, cert.Tags)
+	return &keyVaultCertificatePEM{
+		Certificates:      certs,
+		CertificatesCount: len(pemCerts),
+		PrivateKey:        keyPEM.String(),
+	}, nil
 }
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultCertificateEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &KeyVaultCertificateEphemeralResource{}
+
+func NewKeyVaultCertificateEphemeralResource() ephemeral.EphemeralResource {
+	return &KeyVaultCertificateEphemeralResource{}
+}
+
+type KeyVaultCertificateEphemeralResourceModel struct {
+	Name              types.String `tfsdk:"name"`
+	KeyVaultId        types.String `tfsdk:"key_vault_id"`
+	Version           types.String `tfsdk:"version"`
+	Hex               types.String `tfsdk:"hex"`
+	Pem               types.String `tfsdk:"pem"`
+	Key               types.String `tfsdk:"key"`
+	Expires           types.String `tfsdk:"expires"`
+	NotBefore         types.String `tfsdk:"not_before"`
+	CertificatesCount types.Int64  `tfsdk:"certificates_count"`
+}
+
+func (r *KeyVaultCertificateEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_vault_certificate"
+}
+
+func (r *KeyVaultCertificateEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sdk.StringValidator(validate.NestedItemName),
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sdk.StringValidator(commonids.ValidateKeyVaultID),
+				},
+			},
+
+			// when omitted the latest version of the Certificate is retrieved
+			"version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+
+			"hex": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"pem": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expires": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"not_before": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"certificates_count": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *KeyVaultCertificateEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.Defaults(req, resp)
+}
+
+func (r *KeyVaultCertificateEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	keyVaultsClient := r.Client.KeyVault
+	client := r.Client.KeyVault.ManagementClient
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	var data KeyVaultCertificateEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyVaultId, err := commonids.ParseKeyVaultID(data.KeyVaultId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid `key_vault_id`", err.Error())
+		return
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Certificate", fmt.Sprintf("looking up the Data Plane URI for %s: %+v", *keyVaultId, err))
+		return
+	}
+
+	name := data.Name.ValueString()
+	cert, err := client.GetCertificate(ctx, *keyVaultBaseUri, name, data.Version.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(cert.Response) {
+			resp.Diagnostics.AddError("Retrieving Key Vault Certificate", fmt.Sprintf("the Certificate %q was not found in Key Vault at URI %q", name, *keyVaultBaseUri))
+			return
+		}
+		resp.Diagnostics.AddError("Retrieving Key Vault Certificate", fmt.Sprintf("retrieving Certificate %q from Key Vault at URI %q: %+v", name, *keyVaultBaseUri, err))
+		return
+	}
+
+	if cert.ID == nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Certificate", fmt.Sprintf("retrieving Certificate %q from Key Vault at URI %q: `id` was nil", name, *keyVaultBaseUri))
+		return
+	}
+
+	// the latest version is retrieved when a version isn't specified, so parse the version from the response
+	id, err := parse.ParseNestedItemID(*cert.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Certificate", err.Error())
+		return
+	}
+
+	// the Private Key is only available from the Secret backing the Certificate
+	secret, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Certificate", fmt.Sprintf("retrieving the Secret for Certificate %q from Key Vault at URI %q: %+v", name, *keyVaultBaseUri, err))
+		return
+	}
+	if secret.ContentType == nil || secret.Value == nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Certificate", fmt.Sprintf("retrieving the Secret for Certificate %q from Key Vault at URI %q: `contentType` or `value` was nil", name, *keyVaultBaseUri))
+		return
+	}
+
+	certificatePem, err := convertKeyVaultCertificateToPEM(id.Name, *secret.ContentType, *secret.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Certificate", err.Error())
+		return
+	}
+
+	data.Version = types.StringValue(id.Version)
+	data.Hex = types.StringValue("")
+	if contents := cert.Cer; contents != nil {
+		data.Hex = types.StringValue(strings.ToUpper(hex.EncodeToString(*contents)))
+	}
+	data.Pem = types.StringValue(certificatePem.Certificates)
+	data.Key = types.StringValue(certificatePem.PrivateKey)
+	data.CertificatesCount = types.Int64Value(int64(certificatePem.CertificatesCount))
+	data.Expires = types.StringNull()
+	data.NotBefore = types.StringNull()
+	if attributes := cert.Attributes; attributes != nil {
+		if v := attributes.Expires; v != nil {
+			data.Expires = types.StringValue(time.Time(*v).Format(time.RFC3339))
+		}
+		if v := attributes.NotBefore; v != nil {
+			data.NotBefore = types.StringValue(time.Time(*v).Format(time.RFC3339))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KeyVaultCertificateEphemeralResource struct{}
+
+func TestAccEphemeralKeyVaultCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_key_vault_certificate", "test")
+	r := KeyVaultCertificateEphemeralResource{}
+
+	data.EphemeralResourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("echo.test").Key("data.hex").Exists(),
+				check.That("echo.test").Key("data.pem").Exists(),
+				check.That("echo.test").Key("data.key").Exists(),
+				check.That("echo.test").Key("data.expires").Exists(),
+				check.That("echo.test").Key("data.not_before").Exists(),
+				check.That("echo.test").Key("data.certificates_count").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (KeyVaultCertificateEphemeralResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_key_vault_certificate" "test" {
+  name         = azurerm_key_vault_certificate.test.name
+  key_vault_id = azurerm_key_vault.test.id
+  version      = azurerm_key_vault_certificate.test.version
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_key_vault_certificate.test
+}
+
+resource "echo" "test" {}
+`, KeyVaultCertificateResource{}.basicGenerate(data))
+}
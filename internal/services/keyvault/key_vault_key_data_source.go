@@ -4,12 +4,7 @@
 package keyvault
 
 import (
-	"crypto/ecdsa"
-	"crypto/elliptic"
-	"crypto/rsa"
-	"encoding/base64"
 	"fmt"
-	"math/big"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -21,7 +16,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	"github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
 )
 
 func dataSourceKeyVaultKey() *pluginsdk.Resource {
@@ -170,52 +164,13 @@ func dataSourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		d.Set("y", key.Y)
 		d.Set("curve", key.Crv)
 
-		if key := resp.Key; key != nil {
-			if key.Kty == keyvault.JSONWebKeyTypeRSA || key.Kty == keyvault.JSONWebKeyTypeRSAHSM {
-				nBytes, err := base64.RawURLEncoding.DecodeString(*key.N)
-				if err != nil {
-					return fmt.Errorf("failed to decode N: %+v", err)
-				}
-				eBytes, err := base64.RawURLEncoding.DecodeString(*key.E)
-				if err != nil {
-					return fmt.Errorf("failed to decode E: %+v", err)
-				}
-				publicKey := &rsa.PublicKey{
-					N: big.NewInt(0).SetBytes(nBytes),
-					E: int(big.NewInt(0).SetBytes(eBytes).Uint64()),
-				}
-				err = readPublicKey(d, publicKey)
-				if err != nil {
-					return fmt.Errorf("failed to read public key: %+v", err)
-				}
-			} else if key.Kty == keyvault.JSONWebKeyTypeEC || key.Kty == keyvault.JSONWebKeyTypeECHSM {
-				// do ec keys
-				xBytes, err := base64.RawURLEncoding.DecodeString(*key.X)
-				if err != nil {
-					return fmt.Errorf("failed to decode X: %+v", err)
-				}
-				yBytes, err := base64.RawURLEncoding.DecodeString(*key.Y)
-				if err != nil {
-					return fmt.Errorf("failed to decode Y: %+v", err)
-				}
-				publicKey := &ecdsa.PublicKey{
-					X: big.NewInt(0).SetBytes(xBytes),
-					Y: big.NewInt(0).SetBytes(yBytes),
-				}
-				switch key.Crv {
-				case keyvault.JSONWebKeyCurveNameP256:
-					publicKey.Curve = elliptic.P256()
-				case keyvault.JSONWebKeyCurveNameP384:
-					publicKey.Curve = elliptic.P384()
-				case keyvault.JSONWebKeyCurveNameP521:
-					publicKey.Curve = elliptic.P521()
-				}
-				if publicKey.Curve != nil {
-					err = readPublicKey(d, publicKey)
-					if err != nil {
-						return fmt.Errorf("failed to read public key: %+v", err)
-					}
-				}
+		publicKey, err := publicKeyFromJSONWebKey(*key)
+		if err != nil {
+			return err
+		}
+		if publicKey != nil {
+			if err := readPublicKey(d, publicKey); err != nil {
+				return fmt.Errorf("failed to read public key: %+v", err)
 			}
 		}
 	}
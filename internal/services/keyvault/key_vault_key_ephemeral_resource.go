@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultKeyEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &KeyVaultKeyEphemeralResource{}
+
+func NewKeyVaultKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &KeyVaultKeyEphemeralResource{}
+}
+
+type KeyVaultKeyEphemeralResourceModel struct {
+	Name             types.String `tfsdk:"name"`
+	KeyVaultId       types.String `tfsdk:"key_vault_id"`
+	Version          types.String `tfsdk:"version"`
+	KeyType          types.String `tfsdk:"key_type"`
+	KeyOpts          types.List   `tfsdk:"key_opts"`
+	N                types.String `tfsdk:"n"`
+	E                types.String `tfsdk:"e"`
+	X                types.String `tfsdk:"x"`
+	Y                types.String `tfsdk:"y"`
+	Curve            types.String `tfsdk:"curve"`
+	PublicKeyPem     types.String `tfsdk:"public_key_pem"`
+	PublicKeyOpenSSH types.String `tfsdk:"public_key_openssh"`
+}
+
+func (r *KeyVaultKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_vault_key"
+}
+
+func (r *KeyVaultKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sdk.StringValidator(validate.NestedItemName),
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sdk.StringValidator(commonids.ValidateKeyVaultID),
+				},
+			},
+
+			// when omitted the latest version of the Key is retrieved
+			"version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+
+			"key_type": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"key_opts": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+
+			"n": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"e": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"x": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"y": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"curve": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"public_key_pem": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"public_key_openssh": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *KeyVaultKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.Defaults(req, resp)
+}
+
+func (r *KeyVaultKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	keyVaultsClient := r.Client.KeyVault
+	client := r.Client.KeyVault.ManagementClient
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	var data KeyVaultKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyVaultId, err := commonids.ParseKeyVaultID(data.KeyVaultId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid `key_vault_id`", err.Error())
+		return
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Key", fmt.Sprintf("looking up the Data Plane URI for %s: %+v", *keyVaultId, err))
+		return
+	}
+
+	name := data.Name.ValueString()
+	key, err := client.GetKey(ctx, *keyVaultBaseUri, name, data.Version.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(key.Response) {
+			resp.Diagnostics.AddError("Retrieving Key Vault Key", fmt.Sprintf("the Key %q was not found in Key Vault at URI %q", name, *keyVaultBaseUri))
+			return
+		}
+		resp.Diagnostics.AddError("Retrieving Key Vault Key", fmt.Sprintf("retrieving Key %q from Key Vault at URI %q: %+v", name, *keyVaultBaseUri, err))
+		return
+	}
+
+	if key.Key == nil || key.Key.Kid == nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Key", fmt.Sprintf("retrieving Key %q from Key Vault at URI %q: `key.kid` was nil", name, *keyVaultBaseUri))
+		return
+	}
+
+	// the latest version is retrieved when a version isn't specified, so parse the version from the response
+	id, err := parse.ParseNestedItemID(*key.Key.Kid)
+	if err != nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Key", err.Error())
+		return
+	}
+
+	keyOpts := make([]string, 0)
+	if key.Key.KeyOps != nil {
+		keyOpts = *key.Key.KeyOps
+	}
+	keyOptsValue, diags := types.ListValueFrom(ctx, types.StringType, keyOpts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Version = types.StringValue(id.Version)
+	data.KeyType = types.StringValue(string(key.Key.Kty))
+	data.KeyOpts = keyOptsValue
+	data.N = types.StringPointerValue(key.Key.N)
+	data.E = types.StringPointerValue(key.Key.E)
+	data.X = types.StringPointerValue(key.Key.X)
+	data.Y = types.StringPointerValue(key.Key.Y)
+	data.Curve = types.StringValue(string(key.Key.Crv))
+	data.PublicKeyPem = types.StringNull()
+	data.PublicKeyOpenSSH = types.StringNull()
+
+	publicKey, err := publicKeyFromJSONWebKey(*key.Key)
+	if err != nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Key", err.Error())
+		return
+	}
+	if publicKey != nil {
+		publicKeyPem, publicKeyOpenSSH, err := encodePublicKey(publicKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Retrieving Key Vault Key", fmt.Sprintf("failed to read public key: %+v", err))
+			return
+		}
+		data.PublicKeyPem = types.StringValue(publicKeyPem)
+		data.PublicKeyOpenSSH = types.StringValue(publicKeyOpenSSH)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KeyVaultKeyEphemeralResource struct{}
+
+func TestAccEphemeralKeyVaultKey_basicRSA(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_key_vault_key", "test")
+	r := KeyVaultKeyEphemeralResource{}
+
+	data.EphemeralResourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basicRSA(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("echo.test").Key("data.key_type").HasValue("RSA"),
+				check.That("echo.test").Key("data.key_opts.#").HasValue("6"),
+				check.That("echo.test").Key("data.n").Exists(),
+				check.That("echo.test").Key("data.e").Exists(),
+				check.That("echo.test").Key("data.public_key_pem").Exists(),
+				check.That("echo.test").Key("data.public_key_openssh").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccEphemeralKeyVaultKey_basicEC(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_key_vault_key", "test")
+	r := KeyVaultKeyEphemeralResource{}
+
+	data.EphemeralResourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basicEC(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("echo.test").Key("data.key_type").HasValue("EC"),
+				check.That("echo.test").Key("data.x").Exists(),
+				check.That("echo.test").Key("data.y").Exists(),
+				check.That("echo.test").Key("data.public_key_pem").Exists(),
+			),
+		},
+	})
+}
+
+func (KeyVaultKeyEphemeralResource) basicRSA(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_key_vault_key" "test" {
+  name         = azurerm_key_vault_key.test.name
+  key_vault_id = azurerm_key_vault.test.id
+  version      = azurerm_key_vault_key.test.version
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_key_vault_key.test
+}
+
+resource "echo" "test" {}
+`, KeyVaultKeyResource{}.basicRSA(data))
+}
+
+func (KeyVaultKeyEphemeralResource) basicEC(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_key_vault_key" "test" {
+  name         = azurerm_key_vault_key.test.name
+  key_vault_id = azurerm_key_vault.test.id
+  version      = azurerm_key_vault_key.test.version
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_key_vault_key.test
+}
+
+resource "echo" "test" {}
+`, KeyVaultKeyResource{}.basicEC(data))
+}
@@ -564,51 +564,14 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("version", id.Version)
 	d.Set("versionless_id", id.VersionlessID())
 	if key := resp.Key; key != nil {
-		if key.Kty == keyvault.JSONWebKeyTypeRSA || key.Kty == keyvault.JSONWebKeyTypeRSAHSM {
-			nBytes, err := base64.RawURLEncoding.DecodeString(*key.N)
-			if err != nil {
-				return fmt.Errorf("failed to decode N: %+v", err)
-			}
-			eBytes, err := base64.RawURLEncoding.DecodeString(*key.E)
-			if err != nil {
-				return fmt.Errorf("failed to decode E: %+v", err)
-			}
-			publicKey := &rsa.PublicKey{
-				N: big.NewInt(0).SetBytes(nBytes),
-				E: int(big.NewInt(0).SetBytes(eBytes).Uint64()),
-			}
-			err = readPublicKey(d, publicKey)
-			if err != nil {
+		publicKey, err := publicKeyFromJSONWebKey(*key)
+		if err != nil {
+			return err
+		}
+		if publicKey != nil {
+			if err := readPublicKey(d, publicKey); err != nil {
 				return fmt.Errorf("failed to read public key: %+v", err)
 			}
-		} else if key.Kty == keyvault.JSONWebKeyTypeEC || key.Kty == keyvault.JSONWebKeyTypeECHSM {
-			// do ec keys
-			xBytes, err := base64.RawURLEncoding.DecodeString(*key.X)
-			if err != nil {
-				return fmt.Errorf("failed to decode X: %+v", err)
-			}
-			yBytes, err := base64.RawURLEncoding.DecodeString(*key.Y)
-			if err != nil {
-				return fmt.Errorf("failed to decode Y: %+v", err)
-			}
-			publicKey := &ecdsa.PublicKey{
-				X: big.NewInt(0).SetBytes(xBytes),
-				Y: big.NewInt(0).SetBytes(yBytes),
-			}
-			switch key.Crv {
-			case keyvault.JSONWebKeyCurveNameP256:
-				publicKey.Curve = elliptic.P256()
-			case keyvault.JSONWebKeyCurveNameP384:
-				publicKey.Curve = elliptic.P384()
-			case keyvault.JSONWebKeyCurveNameP521:
-				publicKey.Curve = elliptic.P521()
-			}
-			if publicKey.Curve != nil {
-				err = readPublicKey(d, publicKey)
-				if err != nil {
-					return fmt.Errorf("failed to read public key: %+v", err)
-				}
-			}
 		}
 	}
 
@@ -832,27 +795,81 @@ func flattenKeyVaultKeyRotationPolicy(input keyvault.KeyRotationPolicy) []interf
 	return []interface{}{policy}
 }
 
-// Credit to Hashicorp modified from https://github.com/hashicorp/terraform-provider-tls/blob/v3.1.0/internal/provider/util.go#L79-L105
 func readPublicKey(d *pluginsdk.ResourceData, pubKey interface{}) error {
+	publicKeyPem, publicKeyOpenSSH, err := encodePublicKey(pubKey)
+	if err != nil {
+		return err
+	}
+
+	d.Set("public_key_pem", publicKeyPem)
+	d.Set("public_key_openssh", publicKeyOpenSSH)
+	return nil
+}
+
+// publicKeyFromJSONWebKey returns the Public Key for an RSA or EC Key - or nil when the Key Type or Curve isn't supported
+func publicKeyFromJSONWebKey(key keyvault.JSONWebKey) (interface{}, error) {
+	if key.Kty == keyvault.JSONWebKeyTypeRSA || key.Kty == keyvault.JSONWebKeyTypeRSAHSM {
+		nBytes, err := base64.RawURLEncoding.DecodeString(*key.N)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode N: %+v", err)
+		}
+		eBytes, err := base64.RawURLEncoding.DecodeString(*key.E)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode E: %+v", err)
+		}
+		return &rsa.PublicKey{
+			N: big.NewInt(0).SetBytes(nBytes),
+			E: int(big.NewInt(0).SetBytes(eBytes).Uint64()),
+		}, nil
+	}
+
+	if key.Kty == keyvault.JSONWebKeyTypeEC || key.Kty == keyvault.JSONWebKeyTypeECHSM {
+		xBytes, err := base64.RawURLEncoding.DecodeString(*key.X)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode X: %+v", err)
+		}
+		yBytes, err := base64.RawURLEncoding.DecodeString(*key.Y)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode Y: %+v", err)
+		}
+		publicKey := &ecdsa.PublicKey{
+			X: big.NewInt(0).SetBytes(xBytes),
+			Y: big.NewInt(0).SetBytes(yBytes),
+		}
+		switch key.Crv {
+		case keyvault.JSONWebKeyCurveNameP256:
+			publicKey.Curve = elliptic.P256()
+		case keyvault.JSONWebKeyCurveNameP384:
+			publicKey.Curve = elliptic.P384()
+		case keyvault.JSONWebKeyCurveNameP521:
+			publicKey.Curve = elliptic.P521()
+		default:
+			return nil, nil
+		}
+		return publicKey, nil
+	}
+
+	return nil, nil
+}
+
+// Credit to Hashicorp modified from https://github.com/hashicorp/terraform-provider-tls/blob/v3.1.0/internal/provider/util.go#L79-L105
+func encodePublicKey(pubKey interface{}) (string, string, error) {
 	pubKeyBytes, err := x509.MarshalPKIXPublicKey(pubKey)
 	if err != nil {
-		return fmt.Errorf("failed to marshal public key error: %s", err)
+		return "", "", fmt.Errorf("failed to marshal public key error: %s", err)
 	}
 	pubKeyPemBlock := &pem.Block{
 		Type:  "PUBLIC KEY",
 		Bytes: pubKeyBytes,
 	}
 
-	d.Set("public_key_pem", string(pem.EncodeToMemory(pubKeyPemBlock)))
-
+	publicKeyOpenSSH := ""
 	sshPubKey, err := ssh.NewPublicKey(pubKey)
 	if err == nil {
 		// Not all EC types can be SSH keys, so we'll produce this only
 		// if an appropriate type was selected.
-		sshPubKeyBytes := ssh.MarshalAuthorizedKey(sshPubKey)
-		d.Set("public_key_openssh", string(sshPubKeyBytes))
-	} else {
-		d.Set("public_key_openssh", "")
+		publicKeyOpenSSH = string(ssh.MarshalAuthorizedKey(sshPubKey))
 	}
-	return nil
+
+	return string(pem.EncodeToMemory(pubKeyPemBlock)), publicKeyOpenSSH, nil
 }
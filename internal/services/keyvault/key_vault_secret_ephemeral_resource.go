@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultSecretEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &KeyVaultSecretEphemeralResource{}
+
+func NewKeyVaultSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &KeyVaultSecretEphemeralResource{}
+}
+
+type KeyVaultSecretEphemeralResourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	KeyVaultId     types.String `tfsdk:"key_vault_id"`
+	Version        types.String `tfsdk:"version"`
+	Value          types.String `tfsdk:"value"`
+	ContentType    types.String `tfsdk:"content_type"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	NotBeforeDate  types.String `tfsdk:"not_before_date"`
+}
+
+func (r *KeyVaultSecretEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_vault_secret"
+}
+
+func (r *KeyVaultSecretEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sdk.StringValidator(validate.NestedItemName),
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sdk.StringValidator(commonids.ValidateKeyVaultID),
+				},
+			},
+
+			// when omitted the latest version of the Secret is retrieved
+			"version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+
+			"value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"content_type": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"expiration_date": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"not_before_date": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *KeyVaultSecretEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.Defaults(req, resp)
+}
+
+func (r *KeyVaultSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	keyVaultsClient := r.Client.KeyVault
+	client := r.Client.KeyVault.ManagementClient
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	var data KeyVaultSecretEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyVaultId, err := commonids.ParseKeyVaultID(data.KeyVaultId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid `key_vault_id`", err.Error())
+		return
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Secret", fmt.Sprintf("looking up the Data Plane URI for %s: %+v", *keyVaultId, err))
+		return
+	}
+
+	name := data.Name.ValueString()
+	secret, err := client.GetSecret(ctx, *keyVaultBaseUri, name, data.Version.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(secret.Response) {
+			resp.Diagnostics.AddError("Retrieving Key Vault Secret", fmt.Sprintf("the Secret %q was not found in Key Vault at URI %q", name, *keyVaultBaseUri))
+			return
+		}
+		resp.Diagnostics.AddError("Retrieving Key Vault Secret", fmt.Sprintf("retrieving Secret %q from Key Vault at URI %q: %+v", name, *keyVaultBaseUri, err))
+		return
+	}
+
+	if secret.ID == nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Secret", fmt.Sprintf("retrieving Secret %q from Key Vault at URI %q: `id` was nil", name, *keyVaultBaseUri))
+		return
+	}
+
+	// the latest version is retrieved when a version isn't specified, so parse the version from the response
+	id, err := parse.ParseNestedItemID(*secret.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Retrieving Key Vault Secret", err.Error())
+		return
+	}
+
+	data.Version = types.StringValue(id.Version)
+	data.Value = types.StringPointerValue(secret.Value)
+	data.ContentType = types.StringPointerValue(secret.ContentType)
+	data.ExpirationDate = types.StringNull()
+	data.NotBeforeDate = types.StringNull()
+	if attributes := secret.Attributes; attributes != nil {
+		if v := attributes.Expires; v != nil {
+			data.ExpirationDate = types.StringValue(time.Time(*v).Format(time.RFC3339))
+		}
+		if v := attributes.NotBefore; v != nil {
+			data.NotBeforeDate = types.StringValue(time.Time(*v).Format(time.RFC3339))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
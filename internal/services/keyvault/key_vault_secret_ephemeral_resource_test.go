@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KeyVaultSecretEphemeralResource struct{}
+
+func TestAccEphemeralKeyVaultSecret_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_key_vault_secret", "test")
+	r := KeyVaultSecretEphemeralResource{}
+
+	data.EphemeralResourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("echo.test").Key("data.value").HasValue("rick-and-morty"),
+				check.That("echo.test").Key("data.version").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccEphemeralKeyVaultSecret_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_key_vault_secret", "test")
+	r := KeyVaultSecretEphemeralResource{}
+
+	data.EphemeralResourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("echo.test").Key("data.value").HasValue("<rick><morty /></rick>"),
+				check.That("echo.test").Key("data.content_type").HasValue("application/xml"),
+				check.That("echo.test").Key("data.not_before_date").HasValue("2019-01-01T01:02:03Z"),
+				check.That("echo.test").Key("data.expiration_date").HasValue("2020-01-01T01:02:03Z"),
+			),
+		},
+	})
+}
+
+func (KeyVaultSecretEphemeralResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_key_vault_secret" "test" {
+  name         = azurerm_key_vault_secret.test.name
+  key_vault_id = azurerm_key_vault.test.id
+  version      = azurerm_key_vault_secret.test.version
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_key_vault_secret.test
+}
+
+resource "echo" "test" {}
+`, KeyVaultSecretResource{}.basic(data))
+}
+
+func (KeyVaultSecretEphemeralResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_key_vault_secret" "test" {
+  name         = azurerm_key_vault_secret.test.name
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_key_vault_secret.test
+}
+
+resource "echo" "test" {}
+`, KeyVaultSecretResource{}.complete(data))
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func sweepSoftDeletedKeyVaults(ctx context.Context, client *clients.Client, options sdk.SweeperOptions) error {
+	if !options.PurgeSoftDeletedItems {
+		log.Printf("[DEBUG] Purging Soft-Deleted Items is disabled - skipping sweeping Soft-Deleted Key Vaults")
+		return nil
+	}
+
+	vaultsClient := client.KeyVault.VaultsClient
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+
+	resp, err := vaultsClient.ListDeletedComplete(ctx, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("listing Soft-Deleted Key Vaults within %s: %+v", subscriptionId, err)
+	}
+
+	errs := make([]error, 0)
+	for _, item := range resp.Items {
+		if item.Name == nil || item.Properties == nil {
+			continue
+		}
+		props := *item.Properties
+
+		deletedAt, err := props.GetDeletionDateAsTime()
+		if err != nil {
+			return fmt.Errorf("parsing `deletionDate` for Soft-Deleted Key Vault %q: %+v", *item.Name, err)
+		}
+		if !options.ShouldSweep(*item.Name, deletedAt) {
+			continue
+		}
+
+		id := vaults.NewDeletedVaultID(subscriptionId.SubscriptionId, location.NormalizeNilable(props.Location), *item.Name)
+		if pointer.From(props.PurgeProtectionEnabled) {
+			// these can't be purged and will be removed once the Scheduled Purge Date has passed
+			log.Printf("[DEBUG] Purge Protection is enabled for %s - skipping", id)
+			continue
+		}
+
+		if options.DryRun {
+			log.Printf("[DEBUG] Dry Run - would purge %s", id)
+			continue
+		}
+
+		log.Printf("[DEBUG] Purging %s..", id)
+		if err := vaultsClient.PurgeDeletedThenPoll(ctx, id); err != nil {
+			errs = append(errs, fmt.Errorf("purging %s: %+v", id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -4,6 +4,7 @@
 package keyvault
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -11,14 +12,37 @@ import (
 type Registration struct{}
 
 var (
-	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
-	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.TypedServiceRegistrationWithAGitHubLabel         = Registration{}
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel       = Registration{}
+	_ sdk.UntypedServiceRegistrationWithSweepers           = Registration{}
+	_ sdk.UntypedServiceRegistrationWithEphemeralResources = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/key-vault"
 }
 
+// Sweepers returns a list of Sweepers which remove any resources leaked by the Acceptance Tests
+func (r Registration) Sweepers() []sdk.Sweeper {
+	return []sdk.Sweeper{
+		{
+			// Key Vaults are soft-deleted when the Resource Group containing them is deleted
+			Name:         "azurerm_key_vault",
+			Dependencies: []string{"azurerm_resource_group"},
+			Func:         sweepSoftDeletedKeyVaults,
+		},
+	}
+}
+
+// EphemeralResources returns a list of Ephemeral Resources supported by this Service
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewKeyVaultCertificateEphemeralResource,
+		NewKeyVaultKeyEphemeralResource,
+		NewKeyVaultSecretEphemeralResource,
+	}
+}
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "KeyVault"
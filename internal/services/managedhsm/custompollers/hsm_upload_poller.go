@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	dataplane "github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+var _ pollers.PollerType = &hsmUploadPoller{}
+
+func NewHSMUploadPoller(client *dataplane.HSMSecurityDomainClient, baseUrl string) pollers.PollerType {
+	return &hsmUploadPoller{
+		client:  client,
+		baseUrl: baseUrl,
+	}
+}
+
+type hsmUploadPoller struct {
+	client  *dataplane.HSMSecurityDomainClient
+	baseUrl string
+}
+
+func (p *hsmUploadPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	res, err := p.client.UploadPending(ctx, p.baseUrl)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for Security Domain to upload failed within %s: %+v", p.baseUrl, err)
+	}
+
+	if res.Status == dataplane.OperationStatusSuccess {
+		return &pollers.PollResult{
+			Status:       pollers.PollingStatusSucceeded,
+			PollInterval: 10 * time.Second,
+		}, nil
+	}
+
+	if res.Status == dataplane.OperationStatusFailed {
+		message := fmt.Sprintf("uploading Security Domain to %s failed", p.baseUrl)
+		if res.StatusDetails != nil {
+			message = fmt.Sprintf("%s: %s", message, *res.StatusDetails)
+		}
+		return nil, pollers.PollingFailedError{
+			Message: message,
+		}
+	}
+
+	// Processing
+	return &pollers.PollResult{
+		Status:       pollers.PollingStatusInProgress,
+		PollInterval: 10 * time.Second,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/parse"
@@ -44,9 +46,20 @@ type KeyVaultMHSMKeyResourceSchema struct {
 	NotBeforeDate  string                 `tfschema:"not_before_date"`
 	ExpirationDate string                 `tfschema:"expiration_date"`
 	Tags           map[string]interface{} `tfschema:"tags"`
+	RotationPolicy []KeyRotationPolicy    `tfschema:"rotation_policy"`
 	VersionedId    string                 `tfschema:"versioned_id"`
 }
 
+type KeyRotationPolicy struct {
+	ExpireAfter string                  `tfschema:"expire_after"`
+	Automatic   []KeyRotationPolicyAuto `tfschema:"automatic"`
+}
+
+type KeyRotationPolicyAuto struct {
+	TimeAfterCreation string `tfschema:"time_after_creation"`
+	TimeBeforeExpiry  string `tfschema:"time_before_expiry"`
+}
+
 func (r KeyVaultMHSMKeyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.ManagedHSMDataPlaneVersionlessKeyID
 }
@@ -137,6 +150,59 @@ func (r KeyVaultMHSMKeyResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: validation.IsRFC3339Time,
 		},
 
+		// NOTE: unlike Key Vault, Managed HSM only supports the `Rotate` lifetime action, so there's no `notify_before_expiry`
+		"rotation_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"expire_after": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: helpersValidate.ISO8601DurationBetween("P28D", "P100Y"),
+						AtLeastOneOf: []string{
+							"rotation_policy.0.expire_after",
+							"rotation_policy.0.automatic",
+						},
+					},
+
+					"automatic": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"time_after_creation": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: helpersValidate.ISO8601Duration,
+									ExactlyOneOf: []string{
+										"rotation_policy.0.automatic.0.time_after_creation",
+										"rotation_policy.0.automatic.0.time_before_expiry",
+									},
+								},
+
+								"time_before_expiry": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: helpersValidate.ISO8601Duration,
+									ExactlyOneOf: []string{
+										"rotation_policy.0.automatic.0.time_after_creation",
+										"rotation_policy.0.automatic.0.time_before_expiry",
+									},
+								},
+							},
+						},
+						AtLeastOneOf: []string{
+							"rotation_policy.0.expire_after",
+							"rotation_policy.0.automatic",
+						},
+					},
+				},
+			},
+		},
+
 		"tags": tags.Schema(),
 	}
 }
@@ -276,6 +342,12 @@ func (r KeyVaultMHSMKeyResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if len(config.RotationPolicy) > 0 {
+				if _, err := client.UpdateKeyRotationPolicy(ctx, endpoint.BaseURI(), config.Name, expandMHSMKeyRotationPolicy(config.RotationPolicy)); err != nil {
+					return fmt.Errorf("creating Rotation Policy for %s: %+v", id, err)
+				}
+			}
+
 			metadata.SetID(id)
 			return nil
 		},
@@ -343,6 +415,12 @@ func (r KeyVaultMHSMKeyResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			policy, err := client.GetKeyRotationPolicy(ctx, id.BaseUri(), id.KeyName)
+			if err != nil {
+				return fmt.Errorf("retrieving Rotation Policy for %s: %+v", *id, err)
+			}
+			schema.RotationPolicy = flattenMHSMKeyRotationPolicy(policy)
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -402,6 +480,12 @@ func (r KeyVaultMHSMKeyResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
+			if metadata.ResourceData.HasChange("rotation_policy") {
+				if _, err := client.UpdateKeyRotationPolicy(ctx, id.BaseUri(), config.Name, expandMHSMKeyRotationPolicy(config.RotationPolicy)); err != nil {
+					return fmt.Errorf("updating Rotation Policy for %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -493,6 +577,71 @@ func expandKeyVaultKeyOptions(input []string) *[]keyvault.JSONWebKeyOperation {
 	return &results
 }
 
+func expandMHSMKeyRotationPolicy(input []KeyRotationPolicy) keyvault.KeyRotationPolicy {
+	if len(input) == 0 {
+		// removing the policy is done by sending an empty set of lifetime actions
+		return keyvault.KeyRotationPolicy{LifetimeActions: &[]keyvault.LifetimeActions{}}
+	}
+	policy := input[0]
+
+	lifetimeActions := make([]keyvault.LifetimeActions, 0)
+	if len(policy.Automatic) > 0 {
+		trigger := &keyvault.LifetimeActionsTrigger{}
+		if v := policy.Automatic[0].TimeAfterCreation; v != "" {
+			trigger.TimeAfterCreate = pointer.To(v)
+		}
+		if v := policy.Automatic[0].TimeBeforeExpiry; v != "" {
+			trigger.TimeBeforeExpiry = pointer.To(v)
+		}
+
+		lifetimeActions = append(lifetimeActions, keyvault.LifetimeActions{
+			Action: &keyvault.LifetimeActionsType{
+				Type: keyvault.ActionTypeRotate,
+			},
+			Trigger: trigger,
+		})
+	}
+
+	output := keyvault.KeyRotationPolicy{
+		LifetimeActions: &lifetimeActions,
+		Attributes:      &keyvault.KeyRotationPolicyAttributes{},
+	}
+	if policy.ExpireAfter != "" {
+		output.Attributes.ExpiryTime = pointer.To(policy.ExpireAfter)
+	}
+
+	return output
+}
+
+func flattenMHSMKeyRotationPolicy(input keyvault.KeyRotationPolicy) []KeyRotationPolicy {
+	policy := KeyRotationPolicy{
+		Automatic: []KeyRotationPolicyAuto{},
+	}
+
+	if input.Attributes != nil {
+		policy.ExpireAfter = pointer.From(input.Attributes.ExpiryTime)
+	}
+
+	if input.LifetimeActions != nil {
+		for _, action := range *input.LifetimeActions {
+			if action.Action == nil || action.Trigger == nil || !strings.EqualFold(string(action.Action.Type), string(keyvault.ActionTypeRotate)) {
+				continue
+			}
+
+			policy.Automatic = append(policy.Automatic, KeyRotationPolicyAuto{
+				TimeAfterCreation: pointer.From(action.Trigger.TimeAfterCreate),
+				TimeBeforeExpiry:  pointer.From(action.Trigger.TimeBeforeExpiry),
+			})
+		}
+	}
+
+	if policy.ExpireAfter == "" && len(policy.Automatic) == 0 {
+		return []KeyRotationPolicy{}
+	}
+
+	return []KeyRotationPolicy{policy}
+}
+
 func flattenKeyVaultKeyOptions(input *[]string) []string {
 	results := make([]string, 0)
 	if input == nil {
@@ -63,6 +63,39 @@ func testAccKeyVaultMHSMKey_complete(t *testing.T) {
 	})
 }
 
+func testAccKeyVaultMHSMKey_rotationPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultMHSMKeyTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.rotationPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.0.expire_after").HasValue("P90D"),
+				check.That(data.ResourceName).Key("rotation_policy.0.automatic.0.time_before_expiry").HasValue("P30D"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.rotationPolicyUpdate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.0.expire_after").HasValue("P60D"),
+				check.That(data.ResourceName).Key("rotation_policy.0.automatic.0.time_after_creation").HasValue("P30D"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func testAccKeyVaultHSMKey_purge(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
 	r := KeyVaultMHSMKeyTestResource{}
@@ -194,6 +227,68 @@ resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
 `, r.template(data), data.RandomString)
 }
 
+func (r KeyVaultMHSMKeyTestResource) rotationPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name           = "acctestHSMK-%[2]s"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+  key_type       = "EC-HSM"
+  curve          = "P-521"
+  key_opts       = ["sign"]
+
+  rotation_policy {
+    expire_after = "P90D"
+
+    automatic {
+      time_before_expiry = "P30D"
+    }
+  }
+
+  depends_on = [
+    azurerm_key_vault_managed_hardware_security_module_role_assignment.test,
+    azurerm_key_vault_managed_hardware_security_module_role_assignment.test1
+  ]
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r KeyVaultMHSMKeyTestResource) rotationPolicyUpdate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name           = "acctestHSMK-%[2]s"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+  key_type       = "EC-HSM"
+  curve          = "P-521"
+  key_opts       = ["sign"]
+
+  rotation_policy {
+    expire_after = "P60D"
+
+    automatic {
+      time_after_creation = "P30D"
+    }
+  }
+
+  depends_on = [
+    azurerm_key_vault_managed_hardware_security_module_role_assignment.test,
+    azurerm_key_vault_managed_hardware_security_module_role_assignment.test1
+  ]
+}
+`, r.template(data), data.RandomString)
+}
+
 func (r KeyVaultMHSMKeyTestResource) softDeleteRecovery(data acceptance.TestData, purge bool) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -32,6 +32,12 @@ func TestAccKeyVaultManagedHardwareSecurityModule(t *testing.T) {
 			"complete": testAccKeyVaultManagedHardwareSecurityModule_complete,
 			"download": testAccKeyVaultManagedHardwareSecurityModule_download,
 		},
+		"securityDomain": {
+			"download":                 testAccKeyVaultMHSMSecurityDomainDownload_basic,
+			"exchangeKeyDataSource":    testAccDataSourceKeyVaultMHSMSecurityDomainExchangeKey_basic,
+			"upload":                   testAccKeyVaultMHSMSecurityDomainUpload_basic,
+			"uploadInvalidRestoreData": testAccKeyVaultMHSMSecurityDomainUpload_invalidRestoreData,
+		},
 		"roleAssignments": {
 			"builtInRole": testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_builtInRole,
 			"customRole":  testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_customRole,
@@ -53,6 +59,7 @@ func TestAccKeyVaultManagedHardwareSecurityModule(t *testing.T) {
 		"keys": {
 			"basic":              testAccKeyVaultMHSMKey_basic,
 			"complete":           testAccKeyVaultMHSMKey_complete,
+			"rotationPolicy":     testAccKeyVaultMHSMKey_rotationPolicy,
 			"purge":              testAccKeyVaultHSMKey_purge,
 			"softDeleteRecovery": testAccKeyVaultHSMKey_softDeleteRecovery,
 		},
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultValidation "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KeyVaultMHSMSecurityDomainDownloadResourceModel struct {
+	ManagedHSMID           string   `tfschema:"managed_hsm_id"`
+	KeyVaultCertificateIds []string `tfschema:"security_domain_key_vault_certificate_ids"`
+	Quorum                 int64    `tfschema:"security_domain_quorum"`
+	EncryptedData          string   `tfschema:"security_domain_encrypted_data"`
+}
+
+type KeyVaultMHSMSecurityDomainDownloadResource struct{}
+
+var _ sdk.Resource = KeyVaultMHSMSecurityDomainDownloadResource{}
+
+func (r KeyVaultMHSMSecurityDomainDownloadResource) ModelObject() interface{} {
+	return &KeyVaultMHSMSecurityDomainDownloadResourceModel{}
+}
+
+func (r KeyVaultMHSMSecurityDomainDownloadResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedHSMSecurityDomainDownloadID
+}
+
+func (r KeyVaultMHSMSecurityDomainDownloadResource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_security_domain_download"
+}
+
+func (r KeyVaultMHSMSecurityDomainDownloadResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_hsm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedhsms.ValidateManagedHSMID,
+		},
+
+		"security_domain_key_vault_certificate_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 3,
+			MaxItems: 10,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: keyVaultValidation.NestedItemId,
+			},
+		},
+
+		"security_domain_quorum": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(2, 10),
+		},
+	}
+}
+
+func (r KeyVaultMHSMSecurityDomainDownloadResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"security_domain_encrypted_data": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r KeyVaultMHSMSecurityDomainDownloadResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedHSMs
+
+			var config KeyVaultMHSMSecurityDomainDownloadResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedHsmId, err := managedhsms.ParseManagedHSMID(config.ManagedHSMID)
+			if err != nil {
+				return err
+			}
+			id := parse.NewManagedHSMSecurityDomainDownloadID(managedHsmId.SubscriptionId, managedHsmId.ResourceGroupName, managedHsmId.ManagedHSMName)
+
+			baseUri, err := client.BaseUriForManagedHSM(ctx, *managedHsmId)
+			if err != nil {
+				return fmt.Errorf("determining the Data Plane Endpoint for %s: %+v", *managedHsmId, err)
+			}
+			if baseUri == nil {
+				return fmt.Errorf("unable to determine the Data Plane Endpoint for %q", *managedHsmId)
+			}
+
+			certIds := make([]interface{}, 0, len(config.KeyVaultCertificateIds))
+			for _, v := range config.KeyVaultCertificateIds {
+				certIds = append(certIds, v)
+			}
+
+			encData, err := securityDomainDownload(ctx, client.DataPlaneSecurityDomainsClient, *metadata.Client.KeyVault.ManagementClient, *baseUri, certIds, int(config.Quorum))
+			if err != nil {
+				return fmt.Errorf("downloading the Security Domain for %s: %+v", *managedHsmId, err)
+			}
+
+			config.EncryptedData = encData
+			metadata.SetID(id)
+			return metadata.Encode(&config)
+		},
+	}
+}
+
+func (r KeyVaultMHSMSecurityDomainDownloadResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMSecurityDomainDownloadID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			baseUri, err := metadata.Client.ManagedHSMs.BaseUriForManagedHSM(ctx, id.ManagedHSMId())
+			if err != nil {
+				return fmt.Errorf("determining the Data Plane Endpoint for %s: %+v", id.ManagedHSMId(), err)
+			}
+			if baseUri == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			// the Security Domain can't be retrieved once downloaded, so the encrypted data is retained from the state
+			var state KeyVaultMHSMSecurityDomainDownloadResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.ManagedHSMID = id.ManagedHSMId().ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KeyVaultMHSMSecurityDomainDownloadResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMSecurityDomainDownloadID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] a downloaded Security Domain can't be revoked - removing %s from the state only", id)
+			return nil
+		},
+	}
+}
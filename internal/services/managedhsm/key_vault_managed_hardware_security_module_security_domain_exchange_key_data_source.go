@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KeyVaultMHSMSecurityDomainExchangeKeyDataSourceModel struct {
+	ManagedHSMID   string `tfschema:"managed_hsm_id"`
+	ExchangeKeyPem string `tfschema:"exchange_key_pem"`
+}
+
+type KeyVaultMHSMSecurityDomainExchangeKeyDataSource struct{}
+
+var _ sdk.DataSource = KeyVaultMHSMSecurityDomainExchangeKeyDataSource{}
+
+func (k KeyVaultMHSMSecurityDomainExchangeKeyDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_hsm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: managedhsms.ValidateManagedHSMID,
+		},
+	}
+}
+
+func (k KeyVaultMHSMSecurityDomainExchangeKeyDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"exchange_key_pem": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (k KeyVaultMHSMSecurityDomainExchangeKeyDataSource) ModelObject() interface{} {
+	return &KeyVaultMHSMSecurityDomainExchangeKeyDataSourceModel{}
+}
+
+func (k KeyVaultMHSMSecurityDomainExchangeKeyDataSource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_security_domain_exchange_key"
+}
+
+func (k KeyVaultMHSMSecurityDomainExchangeKeyDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedHSMs
+
+			var config KeyVaultMHSMSecurityDomainExchangeKeyDataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedHsmId, err := managedhsms.ParseManagedHSMID(config.ManagedHSMID)
+			if err != nil {
+				return err
+			}
+
+			baseUri, err := client.BaseUriForManagedHSM(ctx, *managedHsmId)
+			if err != nil {
+				return fmt.Errorf("determining the Data Plane Endpoint for %s: %+v", *managedHsmId, err)
+			}
+			if baseUri == nil {
+				return fmt.Errorf("unable to determine the Data Plane Endpoint for %q", *managedHsmId)
+			}
+
+			resp, err := client.DataPlaneSecurityDomainsClient.TransferKeyMethod(ctx, *baseUri)
+			if err != nil {
+				return fmt.Errorf("retrieving the Security Domain Exchange Key for %s: %+v", *managedHsmId, err)
+			}
+
+			if resp.TransferKey == nil || resp.TransferKey.X5c == nil || len(*resp.TransferKey.X5c) == 0 {
+				return fmt.Errorf("retrieving the Security Domain Exchange Key for %s: `transfer_key.x5c` was nil", *managedHsmId)
+			}
+
+			// the first entry in the certificate chain is the Exchange Key, which the Security Domain is re-wrapped with
+			der, err := base64.StdEncoding.DecodeString((*resp.TransferKey.X5c)[0])
+			if err != nil {
+				return fmt.Errorf("decoding the Security Domain Exchange Key for %s: %+v", *managedHsmId, err)
+			}
+
+			config.ExchangeKeyPem = string(pem.EncodeToMemory(&pem.Block{
+				Type:  "CERTIFICATE",
+				Bytes: der,
+			}))
+
+			metadata.SetID(managedHsmId)
+			return metadata.Encode(&config)
+		},
+	}
+}
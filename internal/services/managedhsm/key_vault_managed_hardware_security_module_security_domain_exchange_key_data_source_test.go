@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KeyVaultMHSMSecurityDomainExchangeKeyDataSource struct{}
+
+func testAccDataSourceKeyVaultMHSMSecurityDomainExchangeKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_key_vault_managed_hardware_security_module_security_domain_exchange_key", "test")
+	r := KeyVaultMHSMSecurityDomainExchangeKeyDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("exchange_key_pem").IsSet(),
+			),
+		},
+	})
+}
+
+func (KeyVaultMHSMSecurityDomainExchangeKeyDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_managed_hardware_security_module_security_domain_exchange_key" "test" {
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.download(data, 3))
+}
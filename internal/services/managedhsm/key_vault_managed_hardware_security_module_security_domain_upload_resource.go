@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	kv74 "github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+type KeyVaultMHSMSecurityDomainUploadResourceModel struct {
+	ManagedHSMID string `tfschema:"managed_hsm_id"`
+	RestoreData  string `tfschema:"security_domain_restore_data"`
+}
+
+type KeyVaultMHSMSecurityDomainUploadResource struct{}
+
+var _ sdk.Resource = KeyVaultMHSMSecurityDomainUploadResource{}
+
+func (r KeyVaultMHSMSecurityDomainUploadResource) ModelObject() interface{} {
+	return &KeyVaultMHSMSecurityDomainUploadResourceModel{}
+}
+
+func (r KeyVaultMHSMSecurityDomainUploadResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedHSMSecurityDomainUploadID
+}
+
+func (r KeyVaultMHSMSecurityDomainUploadResource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_security_domain_upload"
+}
+
+func (r KeyVaultMHSMSecurityDomainUploadResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_hsm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedhsms.ValidateManagedHSMID,
+		},
+
+		// the Security Domain re-wrapped with the Exchange Key of the target Managed HSM, which has to be done
+		// outside of Terraform since it requires the private keys of a quorum of the Security Domain certificates
+		"security_domain_restore_data": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+	}
+}
+
+func (r KeyVaultMHSMSecurityDomainUploadResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KeyVaultMHSMSecurityDomainUploadResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedHSMs
+
+			var config KeyVaultMHSMSecurityDomainUploadResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedHsmId, err := managedhsms.ParseManagedHSMID(config.ManagedHSMID)
+			if err != nil {
+				return err
+			}
+			id := parse.NewManagedHSMSecurityDomainUploadID(managedHsmId.SubscriptionId, managedHsmId.ResourceGroupName, managedHsmId.ManagedHSMName)
+
+			baseUri, err := client.BaseUriForManagedHSM(ctx, *managedHsmId)
+			if err != nil {
+				return fmt.Errorf("determining the Data Plane Endpoint for %s: %+v", *managedHsmId, err)
+			}
+			if baseUri == nil {
+				return fmt.Errorf("unable to determine the Data Plane Endpoint for %q", *managedHsmId)
+			}
+
+			securityDomain := kv74.SecurityDomainObject{
+				Value: pointer.To(config.RestoreData),
+			}
+			if _, err := client.DataPlaneSecurityDomainsClient.Upload(ctx, *baseUri, securityDomain); err != nil {
+				return fmt.Errorf("uploading the Security Domain for %s: %+v", *managedHsmId, err)
+			}
+
+			pollerType := custompollers.NewHSMUploadPoller(client.DataPlaneSecurityDomainsClient, *baseUri)
+			poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+			if err := poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the Security Domain to be uploaded for %s: %+v", *managedHsmId, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KeyVaultMHSMSecurityDomainUploadResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMSecurityDomainUploadID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			baseUri, err := metadata.Client.ManagedHSMs.BaseUriForManagedHSM(ctx, id.ManagedHSMId())
+			if err != nil {
+				return fmt.Errorf("determining the Data Plane Endpoint for %s: %+v", id.ManagedHSMId(), err)
+			}
+			if baseUri == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			// the uploaded Security Domain can't be retrieved, so the restore data is retained from the state
+			var state KeyVaultMHSMSecurityDomainUploadResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.ManagedHSMID = id.ManagedHSMId().ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KeyVaultMHSMSecurityDomainUploadResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMSecurityDomainUploadID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] an uploaded Security Domain can't be removed from a Managed HSM - removing %s from the state only", id)
+			return nil
+		},
+	}
+}
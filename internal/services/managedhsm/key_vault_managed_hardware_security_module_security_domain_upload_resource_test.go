@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultMHSMSecurityDomainUploadTestResource struct{}
+
+func testAccKeyVaultMHSMSecurityDomainUpload_basic(t *testing.T) {
+	// the restore data has to be re-wrapped with the Exchange Key of the target Managed HSM using the private keys of a
+	// quorum of the Security Domain certificates, which can't be done within the test - as such this uses an existing
+	// Managed HSM which is awaiting the upload of a Security Domain, and the restore data prepared for it
+	if os.Getenv("ARM_TEST_MANAGED_HSM_ID") == "" || os.Getenv("ARM_TEST_MANAGED_HSM_SECURITY_DOMAIN_RESTORE_DATA_FILE") == "" {
+		t.Skipf("Skipping as either ARM_TEST_MANAGED_HSM_ID or ARM_TEST_MANAGED_HSM_SECURITY_DOMAIN_RESTORE_DATA_FILE is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_security_domain_upload", "test")
+	r := KeyVaultMHSMSecurityDomainUploadTestResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_hsm_id").HasValue(os.Getenv("ARM_TEST_MANAGED_HSM_ID")),
+			),
+		},
+	})
+}
+
+func testAccKeyVaultMHSMSecurityDomainUpload_invalidRestoreData(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_security_domain_upload", "test")
+	r := KeyVaultMHSMSecurityDomainUploadTestResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidRestoreData(data),
+			ExpectError: regexp.MustCompile("uploading the Security Domain"),
+		},
+	})
+}
+
+func (KeyVaultMHSMSecurityDomainUploadTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedHSMSecurityDomainUploadID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	baseUri, err := clients.ManagedHSMs.BaseUriForManagedHSM(ctx, id.ManagedHSMId())
+	if err != nil {
+		return nil, fmt.Errorf("determining the Data Plane Endpoint for %s: %+v", id.ManagedHSMId(), err)
+	}
+
+	return utils.Bool(baseUri != nil), nil
+}
+
+func (KeyVaultMHSMSecurityDomainUploadTestResource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_security_domain_upload" "test" {
+  managed_hsm_id               = %q
+  security_domain_restore_data = file(%q)
+}
+`, os.Getenv("ARM_TEST_MANAGED_HSM_ID"), os.Getenv("ARM_TEST_MANAGED_HSM_SECURITY_DOMAIN_RESTORE_DATA_FILE"))
+}
+
+func (KeyVaultMHSMSecurityDomainUploadTestResource) invalidRestoreData(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_security_domain_upload" "test" {
+  managed_hsm_id               = azurerm_key_vault_managed_hardware_security_module.test.id
+  security_domain_restore_data = jsonencode({
+    EncData = {
+      data = []
+    }
+  })
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.basic(data))
+}
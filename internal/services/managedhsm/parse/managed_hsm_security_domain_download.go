@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+)
+
+var _ resourceids.Id = ManagedHSMSecurityDomainDownloadId{}
+
+// ManagedHSMSecurityDomainDownloadId identifies the Security Domain download of a Managed HSM
+type ManagedHSMSecurityDomainDownloadId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ManagedHSMName    string
+}
+
+const managedHSMSecurityDomainDownloadSuffix = "/securityDomain/download"
+
+func NewManagedHSMSecurityDomainDownloadID(subscriptionId, resourceGroupName, managedHSMName string) ManagedHSMSecurityDomainDownloadId {
+	return ManagedHSMSecurityDomainDownloadId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ManagedHSMName:    managedHSMName,
+	}
+}
+
+func (id ManagedHSMSecurityDomainDownloadId) String() string {
+	segments := []string{
+		fmt.Sprintf("Managed HSM Name %q", id.ManagedHSMName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Managed HSM Security Domain Download", segmentsStr)
+}
+
+func (id ManagedHSMSecurityDomainDownloadId) ID() string {
+	return id.ManagedHSMId().ID() + managedHSMSecurityDomainDownloadSuffix
+}
+
+// ManagedHSMId returns the Resource Manager ID of the Managed HSM
+func (id ManagedHSMSecurityDomainDownloadId) ManagedHSMId() managedhsms.ManagedHSMId {
+	return managedhsms.NewManagedHSMID(id.SubscriptionId, id.ResourceGroupName, id.ManagedHSMName)
+}
+
+// ManagedHSMSecurityDomainDownloadID parses a Managed HSM Security Domain Download ID into a ManagedHSMSecurityDomainDownloadId struct
+func ManagedHSMSecurityDomainDownloadID(input string) (*ManagedHSMSecurityDomainDownloadId, error) {
+	if !strings.HasSuffix(input, managedHSMSecurityDomainDownloadSuffix) {
+		return nil, fmt.Errorf("expected the Managed HSM Security Domain Download ID %q to end with %q", input, managedHSMSecurityDomainDownloadSuffix)
+	}
+
+	hsmId, err := managedhsms.ParseManagedHSMID(strings.TrimSuffix(input, managedHSMSecurityDomainDownloadSuffix))
+	if err != nil {
+		return nil, fmt.Errorf("parsing Managed HSM Security Domain Download ID %q: %+v", input, err)
+	}
+
+	resourceId := NewManagedHSMSecurityDomainDownloadID(hsmId.SubscriptionId, hsmId.ResourceGroupName, hsmId.ManagedHSMName)
+	return &resourceId, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestManagedHSMSecurityDomainDownloadID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *ManagedHSMSecurityDomainDownloadId
+	}{
+		{
+			// empty
+			Input: "",
+		},
+		{
+			// Managed HSM ID without the suffix
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/managedHSMs/hsm1",
+		},
+		{
+			// wrong suffix
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/managedHSMs/hsm1/securityDomain/other",
+		},
+		{
+			// missing Managed HSM Name
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/managedHSMs/securityDomain/download",
+		},
+		{
+			// valid
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/managedHSMs/hsm1/securityDomain/download",
+			Expected: &ManagedHSMSecurityDomainDownloadId{
+				SubscriptionId:    "00000000-0000-0000-0000-000000000000",
+				ResourceGroupName: "group1",
+				ManagedHSMName:    "hsm1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagedHSMSecurityDomainDownloadID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroupName != v.Expected.ResourceGroupName {
+			t.Fatalf("Expected %q but got %q for ResourceGroupName", v.Expected.ResourceGroupName, actual.ResourceGroupName)
+		}
+		if actual.ManagedHSMName != v.Expected.ManagedHSMName {
+			t.Fatalf("Expected %q but got %q for ManagedHSMName", v.Expected.ManagedHSMName, actual.ManagedHSMName)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip as %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
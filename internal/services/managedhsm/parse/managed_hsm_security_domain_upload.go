@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+)
+
+var _ resourceids.Id = ManagedHSMSecurityDomainUploadId{}
+
+// ManagedHSMSecurityDomainUploadId identifies the Security Domain upload of a Managed HSM
+type ManagedHSMSecurityDomainUploadId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ManagedHSMName    string
+}
+
+const managedHSMSecurityDomainUploadSuffix = "/securityDomain/upload"
+
+func NewManagedHSMSecurityDomainUploadID(subscriptionId, resourceGroupName, managedHSMName string) ManagedHSMSecurityDomainUploadId {
+	return ManagedHSMSecurityDomainUploadId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ManagedHSMName:    managedHSMName,
+	}
+}
+
+func (id ManagedHSMSecurityDomainUploadId) String() string {
+	segments := []string{
+		fmt.Sprintf("Managed HSM Name %q", id.ManagedHSMName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Managed HSM Security Domain Upload", segmentsStr)
+}
+
+func (id ManagedHSMSecurityDomainUploadId) ID() string {
+	return id.ManagedHSMId().ID() + managedHSMSecurityDomainUploadSuffix
+}
+
+// ManagedHSMId returns the Resource Manager ID of the Managed HSM
+func (id ManagedHSMSecurityDomainUploadId) ManagedHSMId() managedhsms.ManagedHSMId {
+	return managedhsms.NewManagedHSMID(id.SubscriptionId, id.ResourceGroupName, id.ManagedHSMName)
+}
+
+// ManagedHSMSecurityDomainUploadID parses a Managed HSM Security Domain Upload ID into a ManagedHSMSecurityDomainUploadId struct
+func ManagedHSMSecurityDomainUploadID(input string) (*ManagedHSMSecurityDomainUploadId, error) {
+	if !strings.HasSuffix(input, managedHSMSecurityDomainUploadSuffix) {
+		return nil, fmt.Errorf("expected the Managed HSM Security Domain Upload ID %q to end with %q", input, managedHSMSecurityDomainUploadSuffix)
+	}
+
+	hsmId, err := managedhsms.ParseManagedHSMID(strings.TrimSuffix(input, managedHSMSecurityDomainUploadSuffix))
+	if err != nil {
+		return nil, fmt.Errorf("parsing Managed HSM Security Domain Upload ID %q: %+v", input, err)
+	}
+
+	resourceId := NewManagedHSMSecurityDomainUploadID(hsmId.SubscriptionId, hsmId.ResourceGroupName, hsmId.ManagedHSMName)
+	return &resourceId, nil
+}
@@ -49,6 +49,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		KeyvaultMHSMRoleDefinitionDataSource{},
+		KeyVaultMHSMSecurityDomainExchangeKeyDataSource{},
 	}
 }
 
@@ -57,5 +58,7 @@ func (r Registration) Resources() []sdk.Resource {
 		KeyVaultMHSMKeyResource{},
 		KeyVaultMHSMRoleDefinitionResource{},
 		KeyVaultManagedHSMRoleAssignmentResource{},
+		KeyVaultMHSMSecurityDomainDownloadResource{},
+		KeyVaultMHSMSecurityDomainUploadResource{},
 	}
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-01-01/actiongroupsapis"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-15-preview/scheduledqueryrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-04-03/azuremonitorworkspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resources"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -56,6 +57,7 @@ type Client struct {
 	MetricAlertsClient                   *metricalerts.MetricAlertsClient
 	PrivateLinkScopesClient              *privatelinkscopesapis.PrivateLinkScopesAPIsClient
 	PrivateLinkScopedResourcesClient     *privatelinkscopedresources.PrivateLinkScopedResourcesClient
+	ResourcesClient                      *resources.ResourcesClient
 	ScheduledQueryRulesClient            *scheduledqueryrules2018.ScheduledQueryRulesClient
 	ScheduledQueryRulesV2Client          *scheduledqueryrules.ScheduledQueryRulesClient
 	WorkspacesClient                     *azuremonitorworkspaces.AzureMonitorWorkspacesClient
@@ -170,6 +172,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(PrivateLinkScopedResourcesClient.Client, o.Authorizers.ResourceManager)
 
+	// used to list the resources targeted by a Diagnostic Setting Policy
+	ResourcesClient, err := resources.NewResourcesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Resources client: %+v", err)
+	}
+	o.Configure(ResourcesClient.Client, o.Authorizers.ResourceManager)
+
 	ScheduledQueryRulesClient, err := scheduledqueryrules2018.NewScheduledQueryRulesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Scheduled Query Rules client: %+v", err)
@@ -207,6 +216,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		MetricAlertsClient:                   MetricAlertsClient,
 		PrivateLinkScopesClient:              PrivateLinkScopesClient,
 		PrivateLinkScopedResourcesClient:     PrivateLinkScopedResourcesClient,
+		ResourcesClient:                      ResourcesClient,
 		ScheduledQueryRulesClient:            ScheduledQueryRulesClient,
 		ScheduledQueryRulesV2Client:          ScheduledQueryRulesV2Client,
 		WorkspacesClient:                     WorkspacesClient,
@@ -4,6 +4,7 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -74,68 +75,86 @@ func dataSourceMonitorDiagnosticCategoriesRead(d *pluginsdk.ResourceData, meta i
 	defer cancel()
 
 	actualResourceId := commonids.NewScopeID(d.Get("resource_id").(string))
-	// trim off the leading `/` since the CheckExistenceByID / List methods don't expect it
-	resourceId := strings.TrimPrefix(actualResourceId.Scope, "/")
-	resourceIdToList, err := commonids.ParseScopeID(resourceId)
+
+	// then retrieve the possible Diagnostics Categories for this Resource
+	categories, err := listMonitorDiagnosticCategories(ctx, categoriesClient, actualResourceId.Scope)
 	if err != nil {
-		return fmt.Errorf("parsing resource id error: %+v", err)
+		return err
 	}
 
-	// then retrieve the possible Diagnostics Categories for this Resource
-	categories, err := categoriesClient.DiagnosticSettingsCategoryList(ctx, *resourceIdToList)
+	d.SetId(actualResourceId.ID())
+
+	if err := d.Set("log_category_types", categories.Logs); err != nil {
+		return fmt.Errorf("setting `log_category_types`: %+v", err)
+	}
+
+	if !features.FourPointOhBeta() {
+		if err := d.Set("logs", categories.Logs); err != nil {
+			return fmt.Errorf("setting `log`: %+v", err)
+		}
+	}
+
+	if err := d.Set("metrics", categories.Metrics); err != nil {
+		return fmt.Errorf("setting `metrics`: %+v", err)
+	}
+
+	if err := d.Set("log_category_groups", categories.CategoryGroups); err != nil {
+		return fmt.Errorf("setting `log_category_groups`: %+v", err)
+	}
+	return nil
+}
+
+type monitorDiagnosticCategories struct {
+	Logs           []string
+	Metrics        []string
+	CategoryGroups []string
+}
+
+// listMonitorDiagnosticCategories retrieves the Diagnostics Categories which are supported by the target resource,
+// which is used by both this Data Source and the Diagnostic Setting resources
+func listMonitorDiagnosticCategories(ctx context.Context, client *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient, targetResourceId string) (*monitorDiagnosticCategories, error) {
+	// trim off the leading `/` since the List method doesn't expect it
+	scopeId, err := commonids.ParseScopeID(strings.TrimPrefix(targetResourceId, "/"))
 	if err != nil {
-		return fmt.Errorf("retrieving Diagnostics Categories for Resource %q: %+v", actualResourceId, err)
+		return nil, fmt.Errorf("parsing %q: %+v", targetResourceId, err)
 	}
 
-	if categories.Model == nil && categories.Model.Value == nil {
-		return fmt.Errorf("retrieving Diagnostics Categories for Resource %q: `categories.Value` was nil", actualResourceId)
+	resp, err := client.DiagnosticSettingsCategoryList(ctx, *scopeId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Diagnostics Categories for Resource %q: %+v", targetResourceId, err)
 	}
 
-	d.SetId(actualResourceId.ID())
-	val := *categories.Model.Value
+	if resp.Model == nil || resp.Model.Value == nil {
+		return nil, fmt.Errorf("retrieving Diagnostics Categories for Resource %q: `categories.Value` was nil", targetResourceId)
+	}
 
-	metrics := make([]string, 0)
-	logs := make([]string, 0)
-	categoryGroups := make([]string, 0)
+	result := monitorDiagnosticCategories{
+		Logs:           make([]string, 0),
+		Metrics:        make([]string, 0),
+		CategoryGroups: make([]string, 0),
+	}
 
-	for _, v := range val {
+	for _, v := range *resp.Model.Value {
 		if v.Name == nil {
 			continue
 		}
 
 		if category := v.Properties; category != nil {
 			if category.CategoryGroups != nil {
-				categoryGroups = append(categoryGroups, *category.CategoryGroups...)
+				result.CategoryGroups = append(result.CategoryGroups, *category.CategoryGroups...)
 			}
 			if category.CategoryType != nil {
 				switch *category.CategoryType {
 				case diagnosticsettingscategories.CategoryTypeLogs:
-					logs = append(logs, *v.Name)
+					result.Logs = append(result.Logs, *v.Name)
 				case diagnosticsettingscategories.CategoryTypeMetrics:
-					metrics = append(metrics, *v.Name)
+					result.Metrics = append(result.Metrics, *v.Name)
 				default:
-					return fmt.Errorf("Unsupported category type %q", string(*category.CategoryType))
+					return nil, fmt.Errorf("Unsupported category type %q", string(*category.CategoryType))
 				}
 			}
 		}
 	}
 
-	if err := d.Set("log_category_types", logs); err != nil {
-		return fmt.Errorf("setting `log_category_types`: %+v", err)
-	}
-
-	if !features.FourPointOhBeta() {
-		if err := d.Set("logs", logs); err != nil {
-			return fmt.Errorf("setting `log`: %+v", err)
-		}
-	}
-
-	if err := d.Set("metrics", metrics); err != nil {
-		return fmt.Errorf("setting `metrics`: %+v", err)
-	}
-
-	if err := d.Set("log_category_groups", categoryGroups); err != nil {
-		return fmt.Errorf("setting `log_category_groups`: %+v", err)
-	}
-	return nil
+	return &result, nil
 }
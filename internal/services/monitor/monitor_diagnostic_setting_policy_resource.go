@@ -0,0 +1,537 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	authRuleParse "github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/authorizationrulesnamespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DiagnosticSettingPolicyResourceModel struct {
+	Name                        string                              `tfschema:"name"`
+	Scope                       string                              `tfschema:"scope"`
+	ResourceType                string                              `tfschema:"resource_type"`
+	EventHubName                string                              `tfschema:"eventhub_name"`
+	EventHubAuthorizationRuleId string                              `tfschema:"eventhub_authorization_rule_id"`
+	LogAnalyticsWorkspaceId     string                              `tfschema:"log_analytics_workspace_id"`
+	LogAnalyticsDestinationType string                              `tfschema:"log_analytics_destination_type"`
+	StorageAccountId            string                              `tfschema:"storage_account_id"`
+	EnabledLogs                 []DiagnosticSettingPolicyEnabledLog `tfschema:"enabled_log"`
+	Metrics                     []DiagnosticSettingPolicyMetric     `tfschema:"metric"`
+	TargetResourceIds           []string                            `tfschema:"target_resource_ids"`
+}
+
+type DiagnosticSettingPolicyEnabledLog struct {
+	Category      string `tfschema:"category"`
+	CategoryGroup string `tfschema:"category_group"`
+	AllCategories bool   `tfschema:"all_categories"`
+}
+
+type DiagnosticSettingPolicyMetric struct {
+	Category string `tfschema:"category"`
+	Enabled  bool   `tfschema:"enabled"`
+}
+
+type DiagnosticSettingPolicyResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = DiagnosticSettingPolicyResource{}
+	_ sdk.ResourceWithCustomizeDiff = DiagnosticSettingPolicyResource{}
+)
+
+func (r DiagnosticSettingPolicyResource) ResourceType() string {
+	return "azurerm_monitor_diagnostic_setting_policy"
+}
+
+func (r DiagnosticSettingPolicyResource) ModelObject() interface{} {
+	return &DiagnosticSettingPolicyResourceModel{}
+}
+
+func (r DiagnosticSettingPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return func(input interface{}, key string) (warnings []string, errors []error) {
+		v, ok := input.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+			return
+		}
+
+		if _, err := parse.DiagnosticSettingPolicyID(v); err != nil {
+			errors = append(errors, err)
+		}
+
+		return
+	}
+}
+
+func (r DiagnosticSettingPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	destinations := []string{"eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id"}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MonitorDiagnosticSettingName,
+		},
+
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.Any(commonids.ValidateSubscriptionID, commonids.ValidateResourceGroupID),
+		},
+
+		"resource_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[A-Za-z0-9.]+/[A-Za-z0-9/]+$`),
+				"`resource_type` must be in the format `{resourceProvider}/{resourceType}`, e.g. `Microsoft.KeyVault/vaults`",
+			),
+		},
+
+		"eventhub_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: eventhubValidate.ValidateEventHubName(),
+		},
+
+		"eventhub_authorization_rule_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: authRuleParse.ValidateAuthorizationRuleID,
+			AtLeastOneOf: destinations,
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+			AtLeastOneOf: destinations,
+		},
+
+		"log_analytics_destination_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Dedicated",
+				"AzureDiagnostics",
+			}, false),
+		},
+
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+			AtLeastOneOf: destinations,
+		},
+
+		"enabled_log": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			AtLeastOneOf: []string{"enabled_log", "metric"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"category": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"category_group": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"all_categories": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"metric": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			AtLeastOneOf: []string{"enabled_log", "metric"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"category": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DiagnosticSettingPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_resource_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r DiagnosticSettingPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+			var model DiagnosticSettingPolicyResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewDiagnosticSettingPolicyID(model.Scope, model.ResourceType, model.Name)
+
+			targetResourceIds, err := listDiagnosticSettingPolicyTargets(ctx, metadata.Client, id)
+			if err != nil {
+				return err
+			}
+
+			for _, targetResourceId := range targetResourceIds {
+				settingId := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, id.Name)
+				existing, err := client.Get(ctx, settingId)
+				if err != nil && !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing Monitor Diagnostic Setting %q for Resource %q: %+v", settingId.DiagnosticSettingName, settingId.ResourceUri, err)
+				}
+				if !response.WasNotFound(existing.HttpResponse) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			if err := applyDiagnosticSettingPolicy(ctx, client, metadata.Client.Monitor.DiagnosticSettingsCategoryClient, model, targetResourceIds); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DiagnosticSettingPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+			id, err := parse.DiagnosticSettingPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			targetResourceIds, err := listDiagnosticSettingPolicyTargets(ctx, metadata.Client, *id)
+			if err != nil {
+				return err
+			}
+
+			// the destinations and categories are only tracked through the configuration, since each target could have
+			// been changed individually - however targets which no longer have the Diagnostic Setting are dropped so that
+			// it's re-applied to them
+			var state DiagnosticSettingPolicyResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Name = id.Name
+			state.Scope = id.Scope
+			state.ResourceType = id.ResourceType
+			state.TargetResourceIds = make([]string, 0)
+
+			for _, targetResourceId := range targetResourceIds {
+				settingId := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, id.Name)
+				resp, err := client.Get(ctx, settingId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						continue
+					}
+					return fmt.Errorf("retrieving Monitor Diagnostic Setting %q for Resource %q: %+v", settingId.DiagnosticSettingName, settingId.ResourceUri, err)
+				}
+
+				state.TargetResourceIds = append(state.TargetResourceIds, targetResourceId)
+			}
+
+			if len(state.TargetResourceIds) == 0 && len(targetResourceIds) > 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DiagnosticSettingPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+			id, err := parse.DiagnosticSettingPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DiagnosticSettingPolicyResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			targetResourceIds, err := listDiagnosticSettingPolicyTargets(ctx, metadata.Client, *id)
+			if err != nil {
+				return err
+			}
+
+			if err := applyDiagnosticSettingPolicy(ctx, client, metadata.Client.Monitor.DiagnosticSettingsCategoryClient, model, targetResourceIds); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DiagnosticSettingPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+			id, err := parse.DiagnosticSettingPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DiagnosticSettingPolicyResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, targetResourceId := range model.TargetResourceIds {
+				settingId := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, id.Name)
+				if resp, err := client.Delete(ctx, settingId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting Monitor Diagnostic Setting %q for Resource %q: %+v", settingId.DiagnosticSettingName, settingId.ResourceUri, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DiagnosticSettingPolicyResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DiagnosticSettingPolicyResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, v := range model.EnabledLogs {
+				if v.AllCategories {
+					if len(model.EnabledLogs) != 1 {
+						return fmt.Errorf("an `enabled_log` block with `all_categories` enabled must be the only `enabled_log` block")
+					}
+					if v.Category != "" || !strings.EqualFold(v.CategoryGroup, monitorDiagnosticAllLogsCategoryGroup) {
+						return fmt.Errorf("`all_categories` can only be enabled when `category_group` is set to %q", monitorDiagnosticAllLogsCategoryGroup)
+					}
+				}
+			}
+
+			if metadata.ResourceDiff.Id() == "" {
+				return nil
+			}
+
+			id, err := parse.DiagnosticSettingPolicyID(metadata.ResourceDiff.Id())
+			if err != nil {
+				return err
+			}
+
+			// resources of this type may have been created (or had the Diagnostic Setting removed) since the last apply,
+			// in which case the Diagnostic Setting needs to be applied to them
+			targetResourceIds, err := listDiagnosticSettingPolicyTargets(ctx, metadata.Client, *id)
+			if err != nil {
+				return err
+			}
+
+			existing := make(map[string]struct{})
+			for _, v := range model.TargetResourceIds {
+				existing[strings.ToLower(v)] = struct{}{}
+			}
+
+			changed := len(existing) != len(targetResourceIds)
+			for _, v := range targetResourceIds {
+				if _, ok := existing[strings.ToLower(v)]; !ok {
+					changed = true
+					break
+				}
+			}
+
+			if changed {
+				return metadata.ResourceDiff.SetNew("target_resource_ids", targetResourceIds)
+			}
+
+			return nil
+		},
+	}
+}
+
+// listDiagnosticSettingPolicyTargets returns the IDs of all resources of the policy's resource type within its scope
+func listDiagnosticSettingPolicyTargets(ctx context.Context, client *clients.Client, id parse.DiagnosticSettingPolicyId) ([]string, error) {
+	// the scope can be in a different Subscription to the one the Provider is configured for, which is fine since the
+	// Subscription is part of the ID which is listed
+	filter := fmt.Sprintf("resourceType eq '%s'", id.ResourceType)
+
+	results := make([]string, 0)
+	if resourceGroupId, err := commonids.ParseResourceGroupID(id.Scope); err == nil {
+		result, err := client.Resource.ResourceGroupsClient.ResourcesListByResourceGroupComplete(ctx, *resourceGroupId, resourcegroups.ResourcesListByResourceGroupOperationOptions{
+			Filter: pointer.To(filter),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing resources of type %q within %q: %+v", id.ResourceType, id.Scope, err)
+		}
+		for _, v := range result.Items {
+			if v.Id != nil {
+				results = append(results, *v.Id)
+			}
+		}
+	} else {
+		subscriptionId, err := commonids.ParseSubscriptionID(id.Scope)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := client.Monitor.ResourcesClient.ListComplete(ctx, *subscriptionId, resources.ListOperationOptions{
+			Filter: pointer.To(filter),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing resources of type %q within %q: %+v", id.ResourceType, id.Scope, err)
+		}
+		for _, v := range result.Items {
+			if v.Id != nil {
+				results = append(results, *v.Id)
+			}
+		}
+	}
+
+	sort.Strings(results)
+	return results, nil
+}
+
+func applyDiagnosticSettingPolicy(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, categoriesClient *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient, model DiagnosticSettingPolicyResourceModel, targetResourceIds []string) error {
+	metrics := make([]diagnosticsettings.MetricSettings, 0)
+	for _, v := range model.Metrics {
+		metrics = append(metrics, diagnosticsettings.MetricSettings{
+			Category: pointer.To(v.Category),
+			Enabled:  v.Enabled,
+		})
+	}
+
+	for _, targetResourceId := range targetResourceIds {
+		logs, err := expandDiagnosticSettingPolicyEnabledLogs(ctx, categoriesClient, targetResourceId, model.EnabledLogs)
+		if err != nil {
+			return err
+		}
+
+		properties := diagnosticsettings.DiagnosticSettings{
+			Logs:    &logs,
+			Metrics: &metrics,
+		}
+
+		if model.EventHubAuthorizationRuleId != "" {
+			properties.EventHubAuthorizationRuleId = pointer.To(model.EventHubAuthorizationRuleId)
+			properties.EventHubName = pointer.To(model.EventHubName)
+		}
+		if model.LogAnalyticsWorkspaceId != "" {
+			properties.WorkspaceId = pointer.To(model.LogAnalyticsWorkspaceId)
+		}
+		if model.LogAnalyticsDestinationType != "" {
+			properties.LogAnalyticsDestinationType = pointer.To(model.LogAnalyticsDestinationType)
+		}
+		if model.StorageAccountId != "" {
+			properties.StorageAccountId = pointer.To(model.StorageAccountId)
+		}
+
+		settingId := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, model.Name)
+		if _, err := client.CreateOrUpdate(ctx, settingId, diagnosticsettings.DiagnosticSettingsResource{Properties: &properties}); err != nil {
+			return fmt.Errorf("creating/updating Monitor Diagnostic Setting %q for Resource %q: %+v", settingId.DiagnosticSettingName, settingId.ResourceUri, err)
+		}
+	}
+
+	return nil
+}
+
+func expandDiagnosticSettingPolicyEnabledLogs(ctx context.Context, client *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient, targetResourceId string, input []DiagnosticSettingPolicyEnabledLog) ([]diagnosticsettings.LogSettings, error) {
+	results := make([]diagnosticsettings.LogSettings, 0)
+
+	for _, v := range input {
+		switch {
+		case v.AllCategories:
+			categories, err := listMonitorDiagnosticCategories(ctx, client, targetResourceId)
+			if err != nil {
+				return nil, err
+			}
+			for _, category := range categories.Logs {
+				results = append(results, diagnosticsettings.LogSettings{
+					Category: pointer.To(category),
+					Enabled:  true,
+				})
+			}
+		case v.Category != "":
+			results = append(results, diagnosticsettings.LogSettings{
+				Category: pointer.To(v.Category),
+				Enabled:  true,
+			})
+		case v.CategoryGroup != "":
+			results = append(results, diagnosticsettings.LogSettings{
+				CategoryGroup: pointer.To(v.CategoryGroup),
+				Enabled:       true,
+			})
+		default:
+			return nil, fmt.Errorf("exactly one of `category` or `category_group` must be specified")
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorDiagnosticSettingPolicyResource struct{}
+
+func TestAccMonitorDiagnosticSettingPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_policy", "test")
+	r := MonitorDiagnosticSettingPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorDiagnosticSettingPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_policy", "test")
+	r := MonitorDiagnosticSettingPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorDiagnosticSettingPolicy_newTargets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_policy", "test")
+	r := MonitorDiagnosticSettingPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the second Key Vault is created after the policy has been applied, so it's picked up on the next apply
+			Config: r.basic(data, 2),
+		},
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorDiagnosticSettingPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_policy", "test")
+	r := MonitorDiagnosticSettingPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t MonitorDiagnosticSettingPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DiagnosticSettingPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range state.Attributes {
+		if !strings.HasPrefix(k, "target_resource_ids.") || k == "target_resource_ids.#" {
+			continue
+		}
+
+		settingId := diagnosticsettings.NewScopedDiagnosticSettingID(v, id.Name)
+		resp, err := clients.Monitor.DiagnosticSettingsClient.Get(ctx, settingId)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving Monitor Diagnostic Setting %q for Resource %q: %+v", settingId.DiagnosticSettingName, settingId.ResourceUri, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (MonitorDiagnosticSettingPolicyResource) template(data acceptance.TestData, keyVaultCount int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_key_vault" "test" {
+  count               = %[4]d
+  name                = "acctest${count.index}%[3]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomIntOfLength(16), keyVaultCount)
+}
+
+func (r MonitorDiagnosticSettingPolicyResource) basic(data acceptance.TestData, keyVaultCount int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_setting_policy" "test" {
+  name                       = "acctest-DSP-%d"
+  scope                      = azurerm_resource_group.test.id
+  resource_type              = "Microsoft.KeyVault/vaults"
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+  enabled_log {
+    category_group = "allLogs"
+    all_categories = true
+  }
+
+  depends_on = [azurerm_key_vault.test]
+}
+`, r.template(data, keyVaultCount), data.RandomInteger)
+}
+
+func (r MonitorDiagnosticSettingPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_setting_policy" "import" {
+  name                       = azurerm_monitor_diagnostic_setting_policy.test.name
+  scope                      = azurerm_monitor_diagnostic_setting_policy.test.scope
+  resource_type              = azurerm_monitor_diagnostic_setting_policy.test.resource_type
+  log_analytics_workspace_id = azurerm_monitor_diagnostic_setting_policy.test.log_analytics_workspace_id
+
+  enabled_log {
+    category_group = "allLogs"
+    all_categories = true
+  }
+}
+`, r.basic(data, 1))
+}
+
+func (r MonitorDiagnosticSettingPolicyResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_setting_policy" "test" {
+  name                           = "acctest-DSP-%d"
+  scope                          = azurerm_resource_group.test.id
+  resource_type                  = "Microsoft.KeyVault/vaults"
+  log_analytics_workspace_id     = azurerm_log_analytics_workspace.test.id
+  log_analytics_destination_type = "Dedicated"
+
+  enabled_log {
+    category = "AuditEvent"
+  }
+
+  metric {
+    category = "AllMetrics"
+  }
+
+  depends_on = [azurerm_key_vault.test]
+}
+`, r.template(data, 1), data.RandomInteger)
+}
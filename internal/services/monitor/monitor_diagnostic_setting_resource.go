@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	authRuleParse "github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/authorizationrulesnamespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -28,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+const monitorDiagnosticAllLogsCategoryGroup = "allLogs"
+
 func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceMonitorDiagnosticSettingCreate,
@@ -126,6 +129,12 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
+						"all_categories": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
 						"retention_policy": {
 							Type:       pluginsdk.TypeList,
 							Optional:   true,
@@ -257,6 +266,7 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 
 func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	categoriesClient := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Diagnostic Settings.")
@@ -300,7 +310,7 @@ func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta inte
 	if enabledLogs, ok := d.GetOk("enabled_log"); ok {
 		enabledLogsList := enabledLogs.(*pluginsdk.Set).List()
 		if len(enabledLogsList) > 0 {
-			expandEnabledLogs, err := expandMonitorDiagnosticsSettingsEnabledLogs(enabledLogsList)
+			expandEnabledLogs, err := expandMonitorDiagnosticsSettingsEnabledLogsWithAllCategories(ctx, categoriesClient, id.ResourceUri, enabledLogsList)
 			if err != nil {
 				return fmt.Errorf("expanding enabled_log: %+v", err)
 			}
@@ -368,6 +378,7 @@ func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta inte
 
 func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	categoriesClient := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Diagnostic Settings.")
@@ -412,7 +423,7 @@ func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta inte
 	if d.HasChange("enabled_log") {
 		enabledLogs := d.Get("enabled_log").(*pluginsdk.Set).List()
 		if len(enabledLogs) > 0 {
-			expandEnabledLogs, err := expandMonitorDiagnosticsSettingsEnabledLogs(enabledLogs)
+			expandEnabledLogs, err := expandMonitorDiagnosticsSettingsEnabledLogsWithAllCategories(ctx, categoriesClient, id.ResourceUri, enabledLogs)
 			if err != nil {
 				return fmt.Errorf("expanding enabled_log: %+v", err)
 			}
@@ -559,6 +570,9 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("log_analytics_destination_type", logAnalyticsDestinationType)
 
 			enabledLogs := flattenMonitorDiagnosticEnabledLogs(resp.Model.Properties.Logs)
+			if monitorDiagnosticEnabledLogsUseAllCategories(d.Get("enabled_log").(*pluginsdk.Set).List()) {
+				enabledLogs = flattenMonitorDiagnosticEnabledLogsAllCategories(enabledLogs)
+			}
 			if err = d.Set("enabled_log", enabledLogs); err != nil {
 				return fmt.Errorf("setting `enabled_log`: %+v", err)
 			}
@@ -707,6 +721,86 @@ func expandMonitorDiagnosticsSettingsEnabledLogs(input []interface{}) (*[]diagno
 	return &results, nil
 }
 
+// expandMonitorDiagnosticsSettingsEnabledLogsWithAllCategories expands the `enabled_log` blocks, resolving an
+// `enabled_log` block with `all_categories` enabled into each of the log categories currently supported by the target
+// resource.
+func expandMonitorDiagnosticsSettingsEnabledLogsWithAllCategories(ctx context.Context, client *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient, targetResourceId string, input []interface{}) (*[]diagnosticsettings.LogSettings, error) {
+	if !monitorDiagnosticEnabledLogsUseAllCategories(input) {
+		return expandMonitorDiagnosticsSettingsEnabledLogs(input)
+	}
+
+	if len(input) != 1 {
+		return nil, fmt.Errorf("an `enabled_log` block with `all_categories` enabled must be the only `enabled_log` block")
+	}
+
+	v := input[0].(map[string]interface{})
+	if v["category"].(string) != "" || !strings.EqualFold(v["category_group"].(string), monitorDiagnosticAllLogsCategoryGroup) {
+		return nil, fmt.Errorf("`all_categories` can only be enabled when `category_group` is set to %q", monitorDiagnosticAllLogsCategoryGroup)
+	}
+
+	var retentionPolicy *diagnosticsettings.RetentionPolicy
+	if policiesRaw := v["retention_policy"].([]interface{}); len(policiesRaw) != 0 && policiesRaw[0] != nil {
+		policyRaw := policiesRaw[0].(map[string]interface{})
+		retentionPolicy = &diagnosticsettings.RetentionPolicy{
+			Days:    int64(policyRaw["days"].(int)),
+			Enabled: policyRaw["enabled"].(bool),
+		}
+	}
+
+	categories, err := listMonitorDiagnosticCategories(ctx, client, targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+	if len(categories.Logs) == 0 {
+		return nil, fmt.Errorf("resource %q does not support any log categories", targetResourceId)
+	}
+
+	results := make([]diagnosticsettings.LogSettings, 0)
+	for _, category := range categories.Logs {
+		results = append(results, diagnosticsettings.LogSettings{
+			Category:        utils.String(category),
+			Enabled:         true,
+			RetentionPolicy: retentionPolicy,
+		})
+	}
+
+	return &results, nil
+}
+
+func monitorDiagnosticEnabledLogsUseAllCategories(input []interface{}) bool {
+	for _, raw := range input {
+		if v, ok := raw.(map[string]interface{}); ok && v["all_categories"] != nil && v["all_categories"].(bool) {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenMonitorDiagnosticEnabledLogsAllCategories collapses the individually enabled log categories back into the single
+// `allLogs` block from the configuration, so that log categories which are added to the resource later, or which were
+// resolved differently at apply time, don't show up as a diff.
+func flattenMonitorDiagnosticEnabledLogsAllCategories(input []interface{}) []interface{} {
+	if len(input) == 0 {
+		return input
+	}
+
+	retentionPolicy := make([]interface{}, 0)
+	if v, ok := input[0].(map[string]interface{}); ok {
+		if policies, ok := v["retention_policy"].([]interface{}); ok {
+			retentionPolicy = policies
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"category":         "",
+			"category_group":   monitorDiagnosticAllLogsCategoryGroup,
+			"all_categories":   true,
+			"retention_policy": retentionPolicy,
+		},
+	}
+}
+
 func flattenMonitorDiagnosticLogs(input *[]diagnosticsettings.LogSettings) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
@@ -770,6 +864,7 @@ func flattenMonitorDiagnosticEnabledLogs(input *[]diagnosticsettings.LogSettings
 			categoryGroup = *v.CategoryGroup
 		}
 		output["category_group"] = categoryGroup
+		output["all_categories"] = false
 
 		policies := make([]interface{}, 0)
 
@@ -877,6 +972,10 @@ func resourceMonitorDiagnosticLogSettingHash(input interface{}) int {
 		if categoryGroup, ok := rawData["category_group"]; ok {
 			buf.WriteString(fmt.Sprintf("%s-", categoryGroup.(string)))
 		}
+		// only hashed when enabled so that the hash of existing `enabled_log` blocks is unchanged
+		if allCategories, ok := rawData["all_categories"]; ok && allCategories.(bool) {
+			buf.WriteString("allCategories-")
+		}
 		if enabled, ok := rawData["enabled"]; ok {
 			buf.WriteString(fmt.Sprintf("%t-", enabled.(bool)))
 		}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+// DiagnosticSettingPolicyId identifies a Diagnostic Setting which is applied to every resource of a given type within a
+// Subscription or Resource Group. There's no such resource in Azure, so this is a composite of the scope, the resource
+// type and the name of the Diagnostic Setting.
+type DiagnosticSettingPolicyId struct {
+	Scope        string
+	ResourceType string
+	Name         string
+}
+
+func NewDiagnosticSettingPolicyID(scope, resourceType, name string) DiagnosticSettingPolicyId {
+	return DiagnosticSettingPolicyId{
+		Scope:        scope,
+		ResourceType: resourceType,
+		Name:         name,
+	}
+}
+
+func (id DiagnosticSettingPolicyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Scope %q", id.Scope),
+		fmt.Sprintf("Resource Type %q", id.ResourceType),
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Diagnostic Setting Policy", segmentsStr)
+}
+
+func (id DiagnosticSettingPolicyId) ID() string {
+	return fmt.Sprintf("%s|%s|%s", id.Scope, id.ResourceType, id.Name)
+}
+
+// DiagnosticSettingPolicyID parses a Diagnostic Setting Policy ID in the format `{scope}|{resourceType}|{name}` into a
+// DiagnosticSettingPolicyId struct
+func DiagnosticSettingPolicyID(input string) (*DiagnosticSettingPolicyId, error) {
+	v := strings.Split(input, "|")
+	if len(v) != 3 {
+		return nil, fmt.Errorf("expected the Diagnostic Setting Policy ID to be in the format `{scope}|{resourceType}|{name}` but got %d segments", len(v))
+	}
+
+	if _, err := commonids.ParseResourceGroupID(v[0]); err != nil {
+		if _, err := commonids.ParseSubscriptionID(v[0]); err != nil {
+			return nil, fmt.Errorf("expected the scope %q to be a Subscription ID or a Resource Group ID", v[0])
+		}
+	}
+
+	if !strings.Contains(v[1], "/") {
+		return nil, fmt.Errorf("expected the resource type %q to be in the format `{resourceProvider}/{resourceType}`", v[1])
+	}
+
+	if v[2] == "" {
+		return nil, fmt.Errorf("expected the name to not be empty")
+	}
+
+	resourceId := NewDiagnosticSettingPolicyID(v[0], v[1], v[2])
+	return &resourceId, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = DiagnosticSettingPolicyId{}
+
+func TestDiagnosticSettingPolicyIDFormatter(t *testing.T) {
+	actual := NewDiagnosticSettingPolicyID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1", "Microsoft.KeyVault/vaults", "setting1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1|Microsoft.KeyVault/vaults|setting1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestDiagnosticSettingPolicyID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DiagnosticSettingPolicyId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|Microsoft.KeyVault/vaults",
+			Error: true,
+		},
+
+		{
+			// empty name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|Microsoft.KeyVault/vaults|",
+			Error: true,
+		},
+
+		{
+			// invalid scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1|Microsoft.KeyVault/vaults|setting1",
+			Error: true,
+		},
+
+		{
+			// invalid resource type
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|vaults|setting1",
+			Error: true,
+		},
+
+		{
+			// subscription scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|Microsoft.KeyVault/vaults|setting1",
+			Expected: &DiagnosticSettingPolicyId{
+				Scope:        "/subscriptions/12345678-1234-9876-4563-123456789012",
+				ResourceType: "Microsoft.KeyVault/vaults",
+				Name:         "setting1",
+			},
+		},
+
+		{
+			// resource group scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1|Microsoft.KeyVault/vaults|setting1",
+			Expected: &DiagnosticSettingPolicyId{
+				Scope:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				ResourceType: "Microsoft.KeyVault/vaults",
+				Name:         "setting1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := DiagnosticSettingPolicyID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.ResourceType != v.Expected.ResourceType {
+			t.Fatalf("Expected %q but got %q for ResourceType", v.Expected.ResourceType, actual.ResourceType)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -35,6 +35,7 @@ func (r Registration) Resources() []sdk.Resource {
 		DataCollectionEndpointResource{},
 		DataCollectionRuleAssociationResource{},
 		DataCollectionRuleResource{},
+		DiagnosticSettingPolicyResource{},
 		ScheduledQueryRulesAlertV2Resource{},
 		AlertPrometheusRuleGroupResource{},
 		WorkspaceResource{},
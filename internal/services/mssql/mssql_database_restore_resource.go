@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/databases"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/servers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlDatabaseRestoreModel struct {
+	Name                        string `tfschema:"name"`
+	ServerId                    string `tfschema:"server_id"`
+	SourceDatabaseId            string `tfschema:"source_database_id"`
+	RestorePointInTime          string `tfschema:"restore_point_in_time"`
+	RestorableDroppedDatabaseId string `tfschema:"restorable_dropped_database_id"`
+	LongTermRetentionBackupId   string `tfschema:"long_term_retention_backup_id"`
+	RecoverableDatabaseId       string `tfschema:"recoverable_database_id"`
+	ElasticPoolId               string `tfschema:"elastic_pool_id"`
+	SkuName                     string `tfschema:"sku_name"`
+	CreateMode                  string `tfschema:"create_mode"`
+}
+
+type MsSqlDatabaseRestoreResource struct{}
+
+var _ sdk.Resource = MsSqlDatabaseRestoreResource{}
+
+func (r MsSqlDatabaseRestoreResource) ResourceType() string {
+	return "azurerm_mssql_database_restore"
+}
+
+func (r MsSqlDatabaseRestoreResource) ModelObject() interface{} {
+	return &MsSqlDatabaseRestoreModel{}
+}
+
+func (r MsSqlDatabaseRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateSqlDatabaseID
+}
+
+func (r MsSqlDatabaseRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	sources := []string{
+		"source_database_id",
+		"restorable_dropped_database_id",
+		"long_term_retention_backup_id",
+		"recoverable_database_id",
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ValidateMsSqlDatabaseName,
+		},
+
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSqlServerID,
+		},
+
+		"source_database_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSqlDatabaseID,
+			ExactlyOneOf: sources,
+			RequiredWith: []string{"restore_point_in_time"},
+		},
+
+		"restore_point_in_time": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppress.RFC3339Time,
+			ValidateFunc:     validation.IsRFC3339Time,
+			ConflictsWith:    []string{"long_term_retention_backup_id", "recoverable_database_id"},
+		},
+
+		"restorable_dropped_database_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RestorableDatabaseID,
+			ExactlyOneOf: sources,
+		},
+
+		"long_term_retention_backup_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: sources,
+		},
+
+		"recoverable_database_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RecoverableDatabaseID,
+			ExactlyOneOf: sources,
+		},
+
+		"elastic_pool_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validate.ElasticPoolID,
+			ConflictsWith: []string{"sku_name"},
+		},
+
+		"sku_name": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ValidateFunc:  validate.DatabaseSkuName(),
+			ConflictsWith: []string{"elastic_pool_id"},
+		},
+	}
+}
+
+func (r MsSqlDatabaseRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"create_mode": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MsSqlDatabaseRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.DatabasesClient
+			serversClient := metadata.Client.MSSQL.ServersClient
+
+			var model MsSqlDatabaseRestoreModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			serverId, err := commonids.ParseSqlServerID(model.ServerId)
+			if err != nil {
+				return err
+			}
+
+			id := commonids.NewSqlDatabaseID(serverId.SubscriptionId, serverId.ResourceGroupName, serverId.ServerName, model.Name)
+
+			existing, err := client.Get(ctx, id, databases.DefaultGetOperationOptions())
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			server, err := serversClient.Get(ctx, *serverId, servers.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *serverId, err)
+			}
+			if server.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *serverId)
+			}
+
+			properties := databases.DatabaseProperties{}
+			createMode := databases.CreateModePointInTimeRestore
+
+			switch {
+			case model.SourceDatabaseId != "":
+				properties.SourceDatabaseId = pointer.To(model.SourceDatabaseId)
+			case model.RestorableDroppedDatabaseId != "":
+				createMode = databases.CreateModeRestore
+				properties.RestorableDroppedDatabaseId = pointer.To(model.RestorableDroppedDatabaseId)
+			case model.LongTermRetentionBackupId != "":
+				createMode = databases.CreateModeRestoreLongTermRetentionBackup
+				properties.LongTermRetentionBackupResourceId = pointer.To(model.LongTermRetentionBackupId)
+			case model.RecoverableDatabaseId != "":
+				createMode = databases.CreateModeRecovery
+				properties.RecoverableDatabaseId = pointer.To(model.RecoverableDatabaseId)
+			}
+			properties.CreateMode = pointer.To(createMode)
+
+			// a dropped database is restored to the point it was deleted unless a point in time is specified
+			if model.RestorePointInTime != "" {
+				properties.RestorePointInTime = pointer.To(model.RestorePointInTime)
+			}
+
+			if model.ElasticPoolId != "" {
+				properties.ElasticPoolId = pointer.To(model.ElasticPoolId)
+			}
+
+			input := databases.Database{
+				Location:   location.Normalize(server.Model.Location),
+				Properties: &properties,
+			}
+
+			if model.SkuName != "" {
+				input.Sku = &databases.Sku{
+					Name: model.SkuName,
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, input); err != nil {
+				return fmt.Errorf("restoring %s using create mode %q: %+v", id, string(createMode), err)
+			}
+
+			if err := waitForMsSqlDatabaseRestoreToComplete(ctx, client, id); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlDatabaseRestoreResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.DatabasesClient
+
+			id, err := commonids.ParseSqlDatabaseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, databases.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the restore source isn't returned by the API, so these are retained from the configuration
+			var state MsSqlDatabaseRestoreModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Name = id.DatabaseName
+			state.ServerId = commonids.NewSqlServerID(id.SubscriptionId, id.ResourceGroupName, id.ServerName).ID()
+
+			if model := resp.Model; model != nil {
+				if sku := model.Sku; sku != nil {
+					state.SkuName = sku.Name
+				}
+
+				if props := model.Properties; props != nil {
+					state.ElasticPoolId = pointer.From(props.ElasticPoolId)
+					if props.CreateMode != nil {
+						state.CreateMode = string(*props.CreateMode)
+					}
+				}
+			}
+
+			if state.CreateMode == "" {
+				state.CreateMode = string(msSqlDatabaseRestoreCreateMode(state))
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MsSqlDatabaseRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.DatabasesClient
+
+			id, err := commonids.ParseSqlDatabaseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func msSqlDatabaseRestoreCreateMode(input MsSqlDatabaseRestoreModel) databases.CreateMode {
+	switch {
+	case input.RestorableDroppedDatabaseId != "":
+		return databases.CreateModeRestore
+	case input.LongTermRetentionBackupId != "":
+		return databases.CreateModeRestoreLongTermRetentionBackup
+	case input.RecoverableDatabaseId != "":
+		return databases.CreateModeRecovery
+	default:
+		return databases.CreateModePointInTimeRestore
+	}
+}
+
+func waitForMsSqlDatabaseRestoreToComplete(ctx context.Context, client *databases.DatabasesClient, id commonids.SqlDatabaseId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	// NOTE: the restore operation can complete before the database is usable, so we wait for it to come online - any
+	// other status (e.g. `Offline`, `Suspect` or `Inaccessible`) won't become `Online` by itself, so fails the wait
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(databases.DatabaseStatusCopying),
+			string(databases.DatabaseStatusCreating),
+			string(databases.DatabaseStatusOnlineChangingDwPerformanceTiers),
+			string(databases.DatabaseStatusRecovering),
+			string(databases.DatabaseStatusRecoveryPending),
+			string(databases.DatabaseStatusRestoring),
+			string(databases.DatabaseStatusResuming),
+			string(databases.DatabaseStatusScaling),
+			string(databases.DatabaseStatusStarting),
+		},
+		Target: []string{string(databases.DatabaseStatusOnline)},
+		Refresh: func() (interface{}, string, error) {
+			log.Printf("[DEBUG] Checking to see if the restore of %s has completed...", id)
+
+			resp, err := client.Get(ctx, id, databases.DefaultGetOperationOptions())
+			if err != nil {
+				return nil, "", fmt.Errorf("polling for the status of %s: %+v", id, err)
+			}
+
+			if resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.Status != nil {
+				return resp, string(pointer.From(resp.Model.Properties.Status)), nil
+			}
+
+			// the database may not have a status yet immediately after the restore has been accepted
+			return resp, string(databases.DatabaseStatusCreating), nil
+		},
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                1 * time.Minute,
+		Timeout:                   time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the restore of %s to complete: %+v", id, err)
+	}
+
+	return nil
+}
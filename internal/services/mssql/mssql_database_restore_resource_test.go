@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlDatabaseRestoreResource struct{}
+
+func TestAccMsSqlDatabaseRestore_pointInTime(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_restore", "test")
+	r := MsSqlDatabaseRestoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			PreConfig: func() { time.Sleep(11 * time.Minute) },
+			Config:    r.pointInTime(data, time.Now().Add(time.Duration(13)*time.Minute).UTC().Format(time.RFC3339)),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("create_mode").HasValue("PointInTimeRestore"),
+			),
+		},
+		data.ImportStep("source_database_id", "restore_point_in_time", "create_mode"),
+	})
+}
+
+func TestAccMsSqlDatabaseRestore_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_restore", "test")
+	r := MsSqlDatabaseRestoreResource{}
+
+	restorePointInTime := time.Now().Add(time.Duration(13) * time.Minute).UTC().Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			PreConfig: func() { time.Sleep(11 * time.Minute) },
+			Config:    r.pointInTime(data, restorePointInTime),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, restorePointInTime)
+		}),
+	})
+}
+
+func (MsSqlDatabaseRestoreResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseSqlDatabaseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.DatabasesClient.Get(ctx, *id, databases.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (MsSqlDatabaseRestoreResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctest-sqlserver-%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctest-db-%[1]d"
+  server_id = azurerm_mssql_server.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MsSqlDatabaseRestoreResource) pointInTime(data acceptance.TestData, restorePointInTime string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database_restore" "test" {
+  name                  = "acctest-dbr-%[2]d"
+  server_id             = azurerm_mssql_server.test.id
+  source_database_id    = azurerm_mssql_database.test.id
+  restore_point_in_time = "%[3]s"
+}
+`, r.template(data), data.RandomInteger, restorePointInTime)
+}
+
+func (r MsSqlDatabaseRestoreResource) requiresImport(data acceptance.TestData, restorePointInTime string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_restore" "import" {
+  name                  = azurerm_mssql_database_restore.test.name
+  server_id             = azurerm_mssql_database_restore.test.server_id
+  source_database_id    = azurerm_mssql_database_restore.test.source_database_id
+  restore_point_in_time = azurerm_mssql_database_restore.test.restore_point_in_time
+}
+`, r.pointInTime(data, restorePointInTime))
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/longtermretentionbackups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/servers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlLongTermRetentionBackupsDataSource struct{}
+
+var _ sdk.DataSource = MsSqlLongTermRetentionBackupsDataSource{}
+
+type MsSqlLongTermRetentionBackupsDataSourceModel struct {
+	ServerId              string                              `tfschema:"server_id"`
+	DatabaseName          string                              `tfschema:"database_name"`
+	DatabaseState         string                              `tfschema:"database_state"`
+	OnlyLatestPerDatabase bool                                `tfschema:"only_latest_per_database"`
+	Backups               []MsSqlLongTermRetentionBackupModel `tfschema:"backups"`
+}
+
+type MsSqlLongTermRetentionBackupModel struct {
+	Id                      string `tfschema:"id"`
+	Name                    string `tfschema:"name"`
+	DatabaseName            string `tfschema:"database_name"`
+	BackupTime              string `tfschema:"backup_time"`
+	BackupExpirationTime    string `tfschema:"backup_expiration_time"`
+	BackupStorageRedundancy string `tfschema:"backup_storage_redundancy"`
+	DatabaseDeletionTime    string `tfschema:"database_deletion_time"`
+	ServerCreateTime        string `tfschema:"server_create_time"`
+}
+
+func (r MsSqlLongTermRetentionBackupsDataSource) ResourceType() string {
+	return "azurerm_mssql_long_term_retention_backups"
+}
+
+func (r MsSqlLongTermRetentionBackupsDataSource) ModelObject() interface{} {
+	return &MsSqlLongTermRetentionBackupsDataSourceModel{}
+}
+
+func (r MsSqlLongTermRetentionBackupsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateSqlServerID,
+		},
+
+		"database_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.ValidateMsSqlDatabaseName,
+		},
+
+		"database_state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(longtermretentionbackups.DatabaseStateAll),
+			ValidateFunc: validation.StringInSlice(longtermretentionbackups.PossibleValuesForDatabaseState(), false),
+		},
+
+		"only_latest_per_database": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r MsSqlLongTermRetentionBackupsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"backups": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"database_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"backup_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"backup_expiration_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"backup_storage_redundancy": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"database_deletion_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"server_create_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MsSqlLongTermRetentionBackupsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.LongTermRetentionBackupsClient
+			serversClient := metadata.Client.MSSQL.ServersClient
+
+			var state MsSqlLongTermRetentionBackupsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			serverId, err := commonids.ParseSqlServerID(state.ServerId)
+			if err != nil {
+				return err
+			}
+
+			// long term retention backups are scoped to the location of the server, since they outlive the server itself
+			server, err := serversClient.Get(ctx, *serverId, servers.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *serverId, err)
+			}
+			if server.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *serverId)
+			}
+			locationName := location.Normalize(server.Model.Location)
+
+			databaseState := longtermretentionbackups.DatabaseState(state.DatabaseState)
+
+			var backups []longtermretentionbackups.LongTermRetentionBackup
+			if state.DatabaseName != "" {
+				databaseId := longtermretentionbackups.NewLocationLongTermRetentionServerLongTermRetentionDatabaseID(serverId.SubscriptionId, serverId.ResourceGroupName, locationName, serverId.ServerName, state.DatabaseName)
+				options := longtermretentionbackups.ListByResourceGroupDatabaseOperationOptions{
+					DatabaseState:         pointer.To(databaseState),
+					OnlyLatestPerDatabase: pointer.To(state.OnlyLatestPerDatabase),
+				}
+
+				resp, err := client.ListByResourceGroupDatabaseComplete(ctx, databaseId, options)
+				if err != nil {
+					return fmt.Errorf("listing long term retention backups for %s: %+v", databaseId, err)
+				}
+				backups = resp.Items
+			} else {
+				ltrServerId := longtermretentionbackups.NewLocationLongTermRetentionServerID(serverId.SubscriptionId, serverId.ResourceGroupName, locationName, serverId.ServerName)
+				options := longtermretentionbackups.ListByResourceGroupServerOperationOptions{
+					DatabaseState:         pointer.To(databaseState),
+					OnlyLatestPerDatabase: pointer.To(state.OnlyLatestPerDatabase),
+				}
+
+				resp, err := client.ListByResourceGroupServerComplete(ctx, ltrServerId, options)
+				if err != nil {
+					return fmt.Errorf("listing long term retention backups for %s: %+v", ltrServerId, err)
+				}
+				backups = resp.Items
+			}
+
+			state.Backups = flattenMsSqlLongTermRetentionBackups(backups)
+
+			metadata.SetID(serverId)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenMsSqlLongTermRetentionBackups(input []longtermretentionbackups.LongTermRetentionBackup) []MsSqlLongTermRetentionBackupModel {
+	output := make([]MsSqlLongTermRetentionBackupModel, 0)
+
+	for _, item := range input {
+		backup := MsSqlLongTermRetentionBackupModel{
+			Id:   pointer.From(item.Id),
+			Name: pointer.From(item.Name),
+		}
+
+		if props := item.Properties; props != nil {
+			backup.DatabaseName = pointer.From(props.DatabaseName)
+			backup.BackupTime = pointer.From(props.BackupTime)
+			backup.BackupExpirationTime = pointer.From(props.BackupExpirationTime)
+			backup.BackupStorageRedundancy = string(pointer.From(props.BackupStorageRedundancy))
+			backup.DatabaseDeletionTime = pointer.From(props.DatabaseDeletionTime)
+			backup.ServerCreateTime = pointer.From(props.ServerCreateTime)
+		}
+
+		output = append(output, backup)
+	}
+
+	return output
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MsSqlLongTermRetentionBackupsDataSource struct{}
+
+func TestAccDataSourceMsSqlLongTermRetentionBackups_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_long_term_retention_backups", "test")
+	d := MsSqlLongTermRetentionBackupsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("backups.#").Exists(),
+				check.That(data.ResourceName).Key("database_state").HasValue("All"),
+			),
+		},
+	})
+}
+
+func (MsSqlLongTermRetentionBackupsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctest-sqlserver-%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctest-db-%[1]d"
+  server_id = azurerm_mssql_server.test.id
+
+  long_term_retention_policy {
+    weekly_retention = "P1W"
+  }
+}
+
+data "azurerm_mssql_long_term_retention_backups" "test" {
+  server_id     = azurerm_mssql_server.test.id
+  database_name = azurerm_mssql_database.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/restorabledroppeddatabases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlRestorableDroppedDatabasesDataSource struct{}
+
+var _ sdk.DataSource = MsSqlRestorableDroppedDatabasesDataSource{}
+
+type MsSqlRestorableDroppedDatabasesDataSourceModel struct {
+	ServerId  string                                `tfschema:"server_id"`
+	Databases []MsSqlRestorableDroppedDatabaseModel `tfschema:"databases"`
+}
+
+type MsSqlRestorableDroppedDatabaseModel struct {
+	Id                      string `tfschema:"id"`
+	DatabaseName            string `tfschema:"database_name"`
+	CreationDate            string `tfschema:"creation_date"`
+	DeletionDate            string `tfschema:"deletion_date"`
+	EarliestRestoreDate     string `tfschema:"earliest_restore_date"`
+	BackupStorageRedundancy string `tfschema:"backup_storage_redundancy"`
+	MaxSizeBytes            int64  `tfschema:"max_size_bytes"`
+}
+
+func (r MsSqlRestorableDroppedDatabasesDataSource) ResourceType() string {
+	return "azurerm_mssql_restorable_dropped_databases"
+}
+
+func (r MsSqlRestorableDroppedDatabasesDataSource) ModelObject() interface{} {
+	return &MsSqlRestorableDroppedDatabasesDataSourceModel{}
+}
+
+func (r MsSqlRestorableDroppedDatabasesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateSqlServerID,
+		},
+	}
+}
+
+func (r MsSqlRestorableDroppedDatabasesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"databases": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"database_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"creation_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"deletion_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"earliest_restore_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"backup_storage_redundancy": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"max_size_bytes": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MsSqlRestorableDroppedDatabasesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.RestorableDroppedDatabasesClient
+
+			var state MsSqlRestorableDroppedDatabasesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			serverId, err := commonids.ParseSqlServerID(state.ServerId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListByServerComplete(ctx, *serverId)
+			if err != nil {
+				return fmt.Errorf("listing restorable dropped databases for %s: %+v", *serverId, err)
+			}
+
+			state.Databases = flattenMsSqlRestorableDroppedDatabases(resp.Items)
+
+			metadata.SetID(serverId)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenMsSqlRestorableDroppedDatabases(input []restorabledroppeddatabases.RestorableDroppedDatabase) []MsSqlRestorableDroppedDatabaseModel {
+	output := make([]MsSqlRestorableDroppedDatabaseModel, 0)
+
+	for _, item := range input {
+		database := MsSqlRestorableDroppedDatabaseModel{
+			Id: pointer.From(item.Id),
+		}
+
+		if props := item.Properties; props != nil {
+			database.DatabaseName = pointer.From(props.DatabaseName)
+			database.CreationDate = pointer.From(props.CreationDate)
+			database.DeletionDate = pointer.From(props.DeletionDate)
+			database.EarliestRestoreDate = pointer.From(props.EarliestRestoreDate)
+			database.BackupStorageRedundancy = string(pointer.From(props.BackupStorageRedundancy))
+			database.MaxSizeBytes = pointer.From(props.MaxSizeBytes)
+		}
+
+		output = append(output, database)
+	}
+
+	return output
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MsSqlRestorableDroppedDatabasesDataSource struct{}
+
+func TestAccDataSourceMsSqlRestorableDroppedDatabases_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_restorable_dropped_databases", "test")
+	d := MsSqlRestorableDroppedDatabasesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.databaseDeleted(data),
+		},
+		{
+			PreConfig: func() { time.Sleep(8 * time.Minute) },
+			Config:    d.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("databases.#").HasValue("1"),
+				check.That(data.ResourceName).Key("databases.0.database_name").HasValue(fmt.Sprintf("acctest-db-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("databases.0.id").Exists(),
+				check.That(data.ResourceName).Key("databases.0.deletion_date").Exists(),
+			),
+		},
+	})
+}
+
+func (MsSqlRestorableDroppedDatabasesDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctest-sqlserver-%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (d MsSqlRestorableDroppedDatabasesDataSource) databaseDeleted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctest-db-%[2]d"
+  server_id = azurerm_mssql_server.test.id
+}
+`, d.template(data), data.RandomInteger)
+}
+
+func (d MsSqlRestorableDroppedDatabasesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_restorable_dropped_databases" "test" {
+  server_id = azurerm_mssql_server.test.id
+}
+`, d.template(data))
+}
@@ -83,15 +83,31 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 				Computed:     true,
 				ForceNew:     true,
 				AtLeastOneOf: []string{"administrator_login", "azuread_administrator.0.azuread_authentication_only"},
-				RequiredWith: []string{"administrator_login", "administrator_login_password"},
 			},
 
 			"administrator_login_password": {
-				Type:         pluginsdk.TypeString,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				AtLeastOneOf:  []string{"administrator_login_password", "administrator_login_password_wo", "azuread_administrator.0.azuread_authentication_only"},
+				RequiredWith:  []string{"administrator_login"},
+				ConflictsWith: []string{"administrator_login_password_wo"},
+			},
+
+			"administrator_login_password_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				AtLeastOneOf:  []string{"administrator_login_password", "administrator_login_password_wo", "azuread_administrator.0.azuread_authentication_only"},
+				RequiredWith:  []string{"administrator_login"},
+				ConflictsWith: []string{"administrator_login_password"},
+			},
+
+			"administrator_login_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				AtLeastOneOf: []string{"administrator_login_password", "azuread_administrator.0.azuread_authentication_only"},
-				RequiredWith: []string{"administrator_login", "administrator_login_password"},
+				RequiredWith: []string{"administrator_login_password_wo"},
 			},
 
 			"azuread_administrator": {
@@ -241,8 +257,17 @@ func resourceMsSqlServerCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		props.Properties.AdministratorLogin = utils.String(v.(string))
 	}
 
-	if v := d.Get("administrator_login_password"); v.(string) != "" {
-		props.Properties.AdministratorLoginPassword = utils.String(v.(string))
+	adminPassword := d.Get("administrator_login_password").(string)
+	if adminPassword == "" {
+		adminPassword, err = pluginsdk.GetWriteOnlyString(d, "administrator_login_password_wo")
+		if err != nil {
+			return err
+		}
+	}
+	if adminPassword != "" {
+		props.Properties.AdministratorLoginPassword = utils.String(adminPassword)
+	} else if props.Properties.AdministratorLogin != nil {
+		return fmt.Errorf("one of `administrator_login_password` or `administrator_login_password_wo` must be specified when `administrator_login` is set")
 	}
 
 	// NOTE: You must set the admin before setting the values of the admin...
@@ -377,6 +402,16 @@ func resourceMsSqlServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 			payload.Properties.AdministratorLoginPassword = pointer.To(adminPassword)
 		}
 
+		if pluginsdk.HasWriteOnlyChange(d, "administrator_login_password_wo") {
+			adminPassword, err := pluginsdk.GetWriteOnlyString(d, "administrator_login_password_wo")
+			if err != nil {
+				return err
+			}
+			if adminPassword != "" {
+				payload.Properties.AdministratorLoginPassword = pointer.To(adminPassword)
+			}
+		}
+
 		if d.HasChange("minimum_tls_version") {
 			payload.Properties.MinimalTlsVersion = pointer.To(d.Get("minimum_tls_version").(string))
 		}
@@ -707,5 +742,8 @@ func msSqlPasswordChangeWhenAADAuthOnly(ctx context.Context, d *pluginsdk.Resour
 	if old.(bool) && d.HasChange("administrator_login_password") {
 		err = fmt.Errorf("`administrator_login_password` cannot be changed once `azuread_administrator.0.azuread_authentication_only = true`")
 	}
+	if old.(bool) && d.HasChange("administrator_login_password_wo_version") {
+		err = fmt.Errorf("`administrator_login_password_wo_version` cannot be changed once `azuread_administrator.0.azuread_authentication_only = true`")
+	}
 	return
 }
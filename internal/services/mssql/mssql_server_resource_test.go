@@ -301,6 +301,51 @@ func TestAccMsSqlServer_CMKServerTagsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlServer_writeOnlyAdminPassword(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server", "test")
+	r := MsSqlServerResource{}
+
+	data.ResourceTestWriteOnly(t, r, []acceptance.TestStep{
+		{
+			Config: r.writeOnlyAdminPassword(data, "thisIsKat11", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("administrator_login_password").IsEmpty(),
+			),
+		},
+		data.ImportStep("administrator_login_password_wo_version"),
+		{
+			Config: r.writeOnlyAdminPassword(data, "thisIsKat12", 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password_wo_version"),
+	})
+}
+
+func TestAccMsSqlServer_updateToWriteOnlyAdminPassword(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server", "test")
+	r := MsSqlServerResource{}
+
+	data.ResourceTestWriteOnly(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+		{
+			Config: r.writeOnlyAdminPassword(data, "thisIsKat12", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password", "administrator_login_password_wo_version"),
+	})
+}
+
 func (MsSqlServerResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ServerID(state.ID)
 	if err != nil {
@@ -1054,3 +1099,28 @@ resource "azurerm_key_vault_key" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
+
+func (MsSqlServerResource) writeOnlyAdminPassword(data acceptance.TestData, password string, version int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                                    = "acctestsqlserver%[1]d"
+  resource_group_name                     = azurerm_resource_group.test.name
+  location                                = azurerm_resource_group.test.location
+  version                                 = "12.0"
+  administrator_login                     = "missadministrator"
+  administrator_login_password_wo         = "%[3]s"
+  administrator_login_password_wo_version = %[4]d
+
+  outbound_network_restriction_enabled = true
+}
+`, data.RandomInteger, data.Locations.Primary, password, version)
+}
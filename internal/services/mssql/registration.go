@@ -64,12 +64,16 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 // DataSources returns the typed DataSources supported by this service
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		MsSqlLongTermRetentionBackupsDataSource{},
+		MsSqlRestorableDroppedDatabasesDataSource{},
+	}
 }
 
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		MsSqlDatabaseRestoreResource{},
 		MsSqlFailoverGroupResource{},
 		MsSqlVirtualMachineAvailabilityGroupListenerResource{},
 		MsSqlVirtualMachineGroupResource{},
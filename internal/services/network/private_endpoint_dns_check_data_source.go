@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkinterfaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/privateendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/privatezones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	privateEndpointDnsCheckStatusHealthy        = "Healthy"
+	privateEndpointDnsCheckStatusMissingZone    = "MissingZone"
+	privateEndpointDnsCheckStatusMissingRecord  = "MissingRecord"
+	privateEndpointDnsCheckStatusWrongIPAddress = "WrongIPAddress"
+)
+
+type PrivateEndpointDnsCheckDataSource struct{}
+
+var _ sdk.DataSource = PrivateEndpointDnsCheckDataSource{}
+
+type PrivateEndpointDnsCheckDataSourceModel struct {
+	PrivateEndpointId string                          `tfschema:"private_endpoint_id"`
+	PrivateDnsZoneIds []string                        `tfschema:"private_dns_zone_ids"`
+	Healthy           bool                            `tfschema:"healthy"`
+	Records           []PrivateEndpointDnsCheckRecord `tfschema:"record"`
+}
+
+type PrivateEndpointDnsCheckRecord struct {
+	Fqdn                 string   `tfschema:"fqdn"`
+	IPAddresses          []string `tfschema:"ip_addresses"`
+	PrivateDnsZoneId     string   `tfschema:"private_dns_zone_id"`
+	RecordSetId          string   `tfschema:"record_set_id"`
+	RecordSetIPAddresses []string `tfschema:"record_set_ip_addresses"`
+	Status               string   `tfschema:"status"`
+}
+
+func (PrivateEndpointDnsCheckDataSource) ResourceType() string {
+	return "azurerm_private_endpoint_dns_check"
+}
+
+func (PrivateEndpointDnsCheckDataSource) ModelObject() interface{} {
+	return &PrivateEndpointDnsCheckDataSourceModel{}
+}
+
+func (PrivateEndpointDnsCheckDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_endpoint_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: privateendpoints.ValidatePrivateEndpointID,
+		},
+
+		// additional Private DNS Zones to check, for when the records are managed outside of a Private DNS Zone Group
+		"private_dns_zone_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: privatezones.ValidatePrivateDnsZoneID,
+			},
+		},
+	}
+}
+
+func (PrivateEndpointDnsCheckDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"healthy": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"record": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"fqdn": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"ip_addresses": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"private_dns_zone_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"record_set_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"record_set_ip_addresses": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (PrivateEndpointDnsCheckDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateEndpoints
+			nicsClient := metadata.Client.Network.NetworkInterfaces
+			dnsZoneGroupsClient := metadata.Client.Network.PrivateDnsZoneGroups
+			recordSetsClient := metadata.Client.PrivateDns.RecordSetsClient
+
+			var state PrivateEndpointDnsCheckDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := privateendpoints.ParsePrivateEndpointID(state.PrivateEndpointId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, privateendpoints.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// the FQDNs and the expected IP Addresses come from the Private Endpoint itself (the Custom DNS Configs and
+			// the IP Configurations of its Network Interfaces), rather than the record sets of the Private DNS Zone Groups,
+			// since those reflect what's in DNS - which is what's being checked
+			var customDnsConfigs *[]privateendpoints.CustomDnsConfigPropertiesFormat
+			ipConfigurations := make([]networkinterfaces.NetworkInterfaceIPConfiguration, 0)
+			if model := resp.Model; model != nil && model.Properties != nil {
+				customDnsConfigs = model.Properties.CustomDnsConfigs
+
+				if model.Properties.NetworkInterfaces != nil {
+					for _, nic := range *model.Properties.NetworkInterfaces {
+						if nic.Id == nil {
+							continue
+						}
+
+						nicId, err := commonids.ParseNetworkInterfaceIDInsensitively(*nic.Id)
+						if err != nil {
+							return err
+						}
+
+						nicResp, err := nicsClient.Get(ctx, *nicId, networkinterfaces.DefaultGetOperationOptions())
+						if err != nil {
+							return fmt.Errorf("retrieving %s: %+v", nicId, err)
+						}
+						if nicModel := nicResp.Model; nicModel != nil && nicModel.Properties != nil && nicModel.Properties.IPConfigurations != nil {
+							ipConfigurations = append(ipConfigurations, *nicModel.Properties.IPConfigurations...)
+						}
+					}
+				}
+			}
+			fqdns, fqdnIPAddresses := privateEndpointIPAddressesByFqdn(customDnsConfigs, ipConfigurations)
+
+			// the Private DNS Zones linked through the Private DNS Zone Groups, followed by any specified explicitly
+			zoneIds := make([]privatezones.PrivateDnsZoneId, 0)
+			dnsZoneGroupIds, err := retrievePrivateDnsZoneGroupsForPrivateEndpoint(ctx, dnsZoneGroupsClient, *id)
+			if err != nil {
+				return err
+			}
+			if dnsZoneGroupIds != nil {
+				for _, dnsZoneGroupId := range *dnsZoneGroupIds {
+					flattened, err := retrieveAndFlattenPrivateDnsZone(ctx, dnsZoneGroupsClient, dnsZoneGroupId)
+					if err != nil {
+						return err
+					}
+					if flattened == nil {
+						continue
+					}
+
+					for _, v := range flattened.DnsZoneGroup["private_dns_zone_ids"].([]string) {
+						zoneId, err := privatezones.ParsePrivateDnsZoneIDInsensitively(v)
+						if err != nil {
+							return err
+						}
+						zoneIds = append(zoneIds, *zoneId)
+					}
+				}
+			}
+			for _, v := range state.PrivateDnsZoneIds {
+				zoneId, err := privatezones.ParsePrivateDnsZoneID(v)
+				if err != nil {
+					return err
+				}
+				zoneIds = append(zoneIds, *zoneId)
+			}
+
+			state.Healthy = true
+			state.Records = make([]PrivateEndpointDnsCheckRecord, 0)
+			for _, fqdn := range fqdns {
+				record, err := checkPrivateEndpointDnsRecord(ctx, recordSetsClient, zoneIds, fqdn, fqdnIPAddresses[fqdn])
+				if err != nil {
+					return err
+				}
+
+				if record.Status != privateEndpointDnsCheckStatusHealthy {
+					state.Healthy = false
+				}
+				state.Records = append(state.Records, *record)
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// privateEndpointIPAddressesByFqdn returns the FQDNs of the Private Endpoint along with the IP Addresses of the Private
+// Endpoint for each FQDN, taken from the Custom DNS Configs and the IP Configurations of its Network Interfaces - the IP
+// Addresses for the same FQDN are combined
+func privateEndpointIPAddressesByFqdn(customDnsConfigs *[]privateendpoints.CustomDnsConfigPropertiesFormat, ipConfigurations []networkinterfaces.NetworkInterfaceIPConfiguration) ([]string, map[string][]string) {
+	fqdns := make([]string, 0)
+	fqdnIPAddresses := make(map[string][]string)
+	addFqdn := func(fqdn string, ipAddresses []string) {
+		key := strings.ToLower(strings.TrimSuffix(fqdn, "."))
+		if key == "" {
+			return
+		}
+		if _, ok := fqdnIPAddresses[key]; !ok {
+			fqdns = append(fqdns, key)
+			fqdnIPAddresses[key] = make([]string, 0)
+		}
+		for _, ip := range ipAddresses {
+			if ip != "" && !containsIPAddress(fqdnIPAddresses[key], ip) {
+				fqdnIPAddresses[key] = append(fqdnIPAddresses[key], ip)
+			}
+		}
+	}
+
+	if customDnsConfigs != nil {
+		for _, config := range *customDnsConfigs {
+			addFqdn(pointer.From(config.Fqdn), pointer.From(config.IPAddresses))
+		}
+	}
+
+	for _, config := range ipConfigurations {
+		props := config.Properties
+		if props == nil || props.PrivateLinkConnectionProperties == nil || props.PrivateLinkConnectionProperties.Fqdns == nil {
+			continue
+		}
+		for _, fqdn := range *props.PrivateLinkConnectionProperties.Fqdns {
+			addFqdn(fqdn, []string{pointer.From(props.PrivateIPAddress)})
+		}
+	}
+
+	return fqdns, fqdnIPAddresses
+}
+
+// checkPrivateEndpointDnsRecord looks up the A record for the specified FQDN within the closest matching Private DNS Zone
+// and compares it against the IP Addresses of the Private Endpoint
+func checkPrivateEndpointDnsRecord(ctx context.Context, client *recordsets.RecordSetsClient, zoneIds []privatezones.PrivateDnsZoneId, fqdn string, ipAddresses []string) (*PrivateEndpointDnsCheckRecord, error) {
+	record := PrivateEndpointDnsCheckRecord{
+		Fqdn:                 fqdn,
+		IPAddresses:          ipAddresses,
+		RecordSetIPAddresses: make([]string, 0),
+		Status:               privateEndpointDnsCheckStatusMissingZone,
+	}
+
+	zoneId, relativeName := privateEndpointDnsZoneForFqdn(zoneIds, fqdn)
+	if zoneId == nil {
+		return &record, nil
+	}
+	record.PrivateDnsZoneId = zoneId.ID()
+
+	recordSetId := recordsets.NewRecordTypeID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.PrivateDnsZoneName, recordsets.RecordTypeA, relativeName)
+	resp, err := client.Get(ctx, recordSetId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			record.Status = privateEndpointDnsCheckStatusMissingRecord
+			return &record, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", recordSetId, err)
+	}
+	record.RecordSetId = recordSetId.ID()
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ARecords != nil {
+		for _, v := range *model.Properties.ARecords {
+			if v.IPv4Address != nil {
+				record.RecordSetIPAddresses = append(record.RecordSetIPAddresses, *v.IPv4Address)
+			}
+		}
+	}
+	sort.Strings(record.RecordSetIPAddresses)
+
+	record.Status = privateEndpointDnsRecordStatus(ipAddresses, record.RecordSetIPAddresses)
+
+	return &record, nil
+}
+
+// privateEndpointDnsRecordStatus compares the IP Addresses of the Private Endpoint against those within the record set,
+// which is only Healthy when both contain the same IP Addresses - since an additional IP Address within the record set
+// means that the FQDN can resolve to an address which isn't the Private Endpoint
+func privateEndpointDnsRecordStatus(ipAddresses []string, recordSetIPAddresses []string) string {
+	if len(ipAddresses) == 0 && len(recordSetIPAddresses) == 0 {
+		return privateEndpointDnsCheckStatusMissingRecord
+	}
+
+	for _, ip := range ipAddresses {
+		if !containsIPAddress(recordSetIPAddresses, ip) {
+			return privateEndpointDnsCheckStatusWrongIPAddress
+		}
+	}
+	for _, ip := range recordSetIPAddresses {
+		if !containsIPAddress(ipAddresses, ip) {
+			return privateEndpointDnsCheckStatusWrongIPAddress
+		}
+	}
+
+	return privateEndpointDnsCheckStatusHealthy
+}
+
+// privateEndpointDnsZoneForFqdn returns the Private DNS Zone with the longest name matching the FQDN, along with the
+// relative record set name for the FQDN within it
+func privateEndpointDnsZoneForFqdn(zoneIds []privatezones.PrivateDnsZoneId, fqdn string) (*privatezones.PrivateDnsZoneId, string) {
+	var zoneId *privatezones.PrivateDnsZoneId
+	relativeName := ""
+	matchLength := 0
+	for _, v := range zoneIds {
+		name, length, ok := privateEndpointDnsRecordName(fqdn, v.PrivateDnsZoneName)
+		if ok && length > matchLength {
+			zoneId = pointer.To(v)
+			relativeName = name
+			matchLength = length
+		}
+	}
+
+	return zoneId, relativeName
+}
+
+// privateEndpointDnsRecordName returns the relative record set name for the FQDN within the Private DNS Zone, along with
+// the length of the matched suffix. The FQDNs of a Private Endpoint refer to the public name of the service (for example
+// `example.blob.core.windows.net`) whilst the record lives in the `privatelink.` zone, so both forms are matched.
+func privateEndpointDnsRecordName(fqdn, zoneName string) (string, int, bool) {
+	fqdn = strings.ToLower(strings.TrimSuffix(fqdn, "."))
+	zoneName = strings.ToLower(strings.TrimSuffix(zoneName, "."))
+
+	suffixes := []string{zoneName}
+	if publicZoneName := strings.TrimPrefix(zoneName, "privatelink."); publicZoneName != zoneName {
+		suffixes = append(suffixes, publicZoneName)
+	}
+
+	for _, suffix := range suffixes {
+		if fqdn == suffix {
+			return "@", len(zoneName), true
+		}
+		if strings.HasSuffix(fqdn, "."+suffix) {
+			return strings.TrimSuffix(fqdn, "."+suffix), len(zoneName), true
+		}
+	}
+
+	return "", 0, false
+}
+
+func containsIPAddress(input []string, ip string) bool {
+	for _, v := range input {
+		if strings.EqualFold(v, ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PrivateEndpointDnsCheckDataSource struct{}
+
+func TestAccDataSourcePrivateEndpointDnsCheck_privateDnsZoneGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_dns_check", "test")
+	r := PrivateEndpointDnsCheckDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.privateDnsZoneGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("healthy").HasValue("true"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourcePrivateEndpointDnsCheck_missingRecord(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_dns_check", "test")
+	r := PrivateEndpointDnsCheckDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.missingRecord(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("healthy").HasValue("false"),
+				check.That(data.ResourceName).Key("record.0.status").HasValue("MissingRecord"),
+				check.That(data.ResourceName).Key("record.0.private_dns_zone_id").Exists(),
+			),
+		},
+	})
+}
+
+func (PrivateEndpointDnsCheckDataSource) privateDnsZoneGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_endpoint_dns_check" "test" {
+  private_endpoint_id = azurerm_private_endpoint.test.id
+}
+`, PrivateEndpointResource{}.privateDnsZoneGroup(data))
+}
+
+func (PrivateEndpointDnsCheckDataSource) missingRecord(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_endpoint_dns_check" "test" {
+  private_endpoint_id  = azurerm_private_endpoint.test.id
+  private_dns_zone_ids = [azurerm_private_dns_zone.finance.id]
+}
+`, PrivateEndpointResource{}.privateDnsZoneGroupRemove(data))
+}
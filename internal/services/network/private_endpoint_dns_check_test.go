@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkinterfaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/privateendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/privatezones"
+)
+
+func TestPrivateEndpointDnsRecordName(t *testing.T) {
+	testData := []struct {
+		Name         string
+		Fqdn         string
+		ZoneName     string
+		ExpectedName string
+		Matched      bool
+	}{
+		{
+			Name:         "public FQDN within a privatelink zone",
+			Fqdn:         "example.blob.core.windows.net",
+			ZoneName:     "privatelink.blob.core.windows.net",
+			ExpectedName: "example",
+			Matched:      true,
+		},
+		{
+			Name:         "privatelink FQDN within a privatelink zone",
+			Fqdn:         "example.privatelink.blob.core.windows.net.",
+			ZoneName:     "privatelink.blob.core.windows.net",
+			ExpectedName: "example",
+			Matched:      true,
+		},
+		{
+			Name:         "mixed case FQDN within a zone without the privatelink prefix",
+			Fqdn:         "Example.Contoso.Internal",
+			ZoneName:     "contoso.internal",
+			ExpectedName: "example",
+			Matched:      true,
+		},
+		{
+			Name:         "FQDN matching the zone apex",
+			Fqdn:         "blob.core.windows.net",
+			ZoneName:     "privatelink.blob.core.windows.net",
+			ExpectedName: "@",
+			Matched:      true,
+		},
+		{
+			Name:     "FQDN within a different zone",
+			Fqdn:     "example.vault.azure.net",
+			ZoneName: "privatelink.blob.core.windows.net",
+			Matched:  false,
+		},
+		{
+			Name:     "FQDN which only shares a suffix of the zone name",
+			Fqdn:     "example.myblob.core.windows.net",
+			ZoneName: "privatelink.blob.core.windows.net",
+			Matched:  false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, _, matched := privateEndpointDnsRecordName(v.Fqdn, v.ZoneName)
+		if matched != v.Matched {
+			t.Fatalf("expected matched to be %t but got %t", v.Matched, matched)
+		}
+		if actual != v.ExpectedName {
+			t.Fatalf("expected the record name %q but got %q", v.ExpectedName, actual)
+		}
+	}
+}
+
+func TestPrivateEndpointDnsZoneForFqdn(t *testing.T) {
+	zoneIds := []privatezones.PrivateDnsZoneId{
+		privatezones.NewPrivateDnsZoneID("12345678-1234-9876-4563-123456789012", "example", "core.windows.net"),
+		privatezones.NewPrivateDnsZoneID("12345678-1234-9876-4563-123456789012", "example", "privatelink.blob.core.windows.net"),
+		privatezones.NewPrivateDnsZoneID("12345678-1234-9876-4563-123456789012", "example", "windows.net"),
+	}
+
+	testData := []struct {
+		Name         string
+		Fqdn         string
+		ExpectedZone string
+		ExpectedName string
+	}{
+		{
+			Name:         "longest matching privatelink zone",
+			Fqdn:         "example.blob.core.windows.net",
+			ExpectedZone: "privatelink.blob.core.windows.net",
+			ExpectedName: "example",
+		},
+		{
+			Name:         "longest matching zone without the privatelink prefix",
+			Fqdn:         "example.vault.core.windows.net",
+			ExpectedZone: "core.windows.net",
+			ExpectedName: "example.vault",
+		},
+		{
+			Name: "no matching zone",
+			Fqdn: "example.vault.azure.net",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		zoneId, name := privateEndpointDnsZoneForFqdn(zoneIds, v.Fqdn)
+		if v.ExpectedZone == "" {
+			if zoneId != nil {
+				t.Fatalf("expected no zone to match but got %q", zoneId.PrivateDnsZoneName)
+			}
+			continue
+		}
+
+		if zoneId == nil {
+			t.Fatalf("expected the zone %q to match but no zone matched", v.ExpectedZone)
+		}
+		if zoneId.PrivateDnsZoneName != v.ExpectedZone {
+			t.Fatalf("expected the zone %q but got %q", v.ExpectedZone, zoneId.PrivateDnsZoneName)
+		}
+		if name != v.ExpectedName {
+			t.Fatalf("expected the record name %q but got %q", v.ExpectedName, name)
+		}
+	}
+}
+
+func TestPrivateEndpointDnsRecordStatus(t *testing.T) {
+	testData := []struct {
+		Name                 string
+		IPAddresses          []string
+		RecordSetIPAddresses []string
+		Expected             string
+	}{
+		{
+			Name:                 "matching IP Addresses",
+			IPAddresses:          []string{"10.0.0.4", "10.0.0.5"},
+			RecordSetIPAddresses: []string{"10.0.0.5", "10.0.0.4"},
+			Expected:             privateEndpointDnsCheckStatusHealthy,
+		},
+		{
+			Name:                 "missing IP Address",
+			IPAddresses:          []string{"10.0.0.4", "10.0.0.5"},
+			RecordSetIPAddresses: []string{"10.0.0.4"},
+			Expected:             privateEndpointDnsCheckStatusWrongIPAddress,
+		},
+		{
+			Name:                 "additional IP Address",
+			IPAddresses:          []string{"10.0.0.4"},
+			RecordSetIPAddresses: []string{"10.0.0.4", "10.1.0.4"},
+			Expected:             privateEndpointDnsCheckStatusWrongIPAddress,
+		},
+		{
+			Name:                 "no IP Addresses",
+			IPAddresses:          []string{},
+			RecordSetIPAddresses: []string{},
+			Expected:             privateEndpointDnsCheckStatusMissingRecord,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if actual := privateEndpointDnsRecordStatus(v.IPAddresses, v.RecordSetIPAddresses); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestPrivateEndpointIPAddressesByFqdn(t *testing.T) {
+	customDnsConfigs := &[]privateendpoints.CustomDnsConfigPropertiesFormat{
+		{
+			Fqdn:        pointer.To("example.blob.core.windows.net"),
+			IPAddresses: pointer.To([]string{"10.0.0.4"}),
+		},
+	}
+	ipConfigurations := []networkinterfaces.NetworkInterfaceIPConfiguration{
+		{
+			Properties: &networkinterfaces.NetworkInterfaceIPConfigurationPropertiesFormat{
+				PrivateIPAddress: pointer.To("10.0.0.4"),
+				PrivateLinkConnectionProperties: &networkinterfaces.NetworkInterfaceIPConfigurationPrivateLinkConnectionProperties{
+					Fqdns: pointer.To([]string{"Example.blob.core.windows.net."}),
+				},
+			},
+		},
+		{
+			Properties: &networkinterfaces.NetworkInterfaceIPConfigurationPropertiesFormat{
+				PrivateIPAddress: pointer.To("10.0.0.5"),
+				PrivateLinkConnectionProperties: &networkinterfaces.NetworkInterfaceIPConfigurationPrivateLinkConnectionProperties{
+					Fqdns: pointer.To([]string{"example-secondary.blob.core.windows.net"}),
+				},
+			},
+		},
+		{
+			// an IP Configuration which isn't used for Private Link
+			Properties: &networkinterfaces.NetworkInterfaceIPConfigurationPropertiesFormat{
+				PrivateIPAddress: pointer.To("10.0.0.6"),
+			},
+		},
+	}
+
+	fqdns, fqdnIPAddresses := privateEndpointIPAddressesByFqdn(customDnsConfigs, ipConfigurations)
+
+	expected := map[string][]string{
+		"example.blob.core.windows.net":           {"10.0.0.4"},
+		"example-secondary.blob.core.windows.net": {"10.0.0.5"},
+	}
+	if !reflect.DeepEqual(fqdns, []string{"example.blob.core.windows.net", "example-secondary.blob.core.windows.net"}) {
+		t.Fatalf("unexpected FQDNs: %+v", fqdns)
+	}
+	if !reflect.DeepEqual(fqdnIPAddresses, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, fqdnIPAddresses)
+	}
+
+	// the record within the Private DNS Zone Group points at the wrong IP Address, which must be reported rather than
+	// being used as the expected IP Address for the FQDN
+	zoneGroupRecordSetIPAddresses := []string{"10.0.0.9"}
+	if actual := privateEndpointDnsRecordStatus(fqdnIPAddresses["example-secondary.blob.core.windows.net"], zoneGroupRecordSetIPAddresses); actual != privateEndpointDnsCheckStatusWrongIPAddress {
+		t.Fatalf("expected %q but got %q", privateEndpointDnsCheckStatusWrongIPAddress, actual)
+	}
+}
@@ -11,8 +11,9 @@ import (
 type Registration struct{}
 
 var (
-	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
-	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.TypedServiceRegistrationWithAGitHubLabel            = Registration{}
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel          = Registration{}
+	_ sdk.UntypedServiceRegistrationWithSubscriptionTargeting = Registration{}
 )
 
 // Name is the name of this Service
@@ -31,11 +32,20 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// SubscriptionTargetedResources returns the Resources which use the clients for the Subscription within the Resource ID
+func (r Registration) SubscriptionTargetedResources() map[string]sdk.CreateSubscriptionIdFunc {
+	return map[string]sdk.CreateSubscriptionIdFunc{
+		// a hub Virtual Network Peering is commonly managed from another Landing Zone
+		"azurerm_virtual_network_peering": virtualNetworkPeeringCreateSubscriptionId,
+	}
+}
+
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		ManagerDataSource{},
 		ManagerNetworkGroupDataSource{},
 		ManagerConnectivityConfigurationDataSource{},
+		PrivateEndpointDnsCheckDataSource{},
 	}
 }
 
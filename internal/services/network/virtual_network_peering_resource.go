@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -56,6 +57,14 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
 			"remote_virtual_network_id": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
@@ -98,6 +107,12 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 	}
 }
 
+// virtualNetworkPeeringCreateSubscriptionId returns the Subscription which the Virtual Network Peering will be created
+// within, which defaults to the Subscription configured in the Provider block
+func virtualNetworkPeeringCreateSubscriptionId(d *pluginsdk.ResourceData) (string, error) {
+	return d.Get("subscription_id").(string), nil
+}
+
 func resourceVirtualNetworkPeeringCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.VirtualNetworkPeerings
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -240,6 +255,7 @@ func resourceVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta interface
 	d.Set("name", id.VirtualNetworkPeeringName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 	d.Set("virtual_network_name", id.VirtualNetworkName)
+	d.Set("subscription_id", id.SubscriptionId)
 
 	if model := resp.Model; model != nil {
 		if peer := model.Properties; peer != nil {
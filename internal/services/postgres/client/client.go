@@ -20,7 +20,10 @@ import (
 	flexibleserveradministrators "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/administrators"
 	flexibleserverdatabases "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/databases"
 	flexibleserverfirewallrules "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/firewallrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/flexibleservercapabilities"
+	flexibleserverreplicas "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/replicas"
 	flexibleservers "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/virtualendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -33,6 +36,9 @@ type Client struct {
 	FlexibleServerFirewallRuleClient    *flexibleserverfirewallrules.FirewallRulesClient
 	FlexibleServerDatabaseClient        *flexibleserverdatabases.DatabasesClient
 	FlexibleServerAdministratorsClient  *flexibleserveradministrators.AdministratorsClient
+	FlexibleServerCapabilitiesClient    *flexibleservercapabilities.FlexibleServerCapabilitiesClient
+	FlexibleServerReplicasClient        *flexibleserverreplicas.ReplicasClient
+	FlexibleServerVirtualEndpointClient *virtualendpoints.VirtualEndpointsClient
 	ServersClient                       *servers.ServersClient
 	ServerRestartClient                 *serverrestart.ServerRestartClient
 	ServerKeysClient                    *serverkeys.ServerKeysClient
@@ -133,6 +139,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(flexibleServerAdministratorsClient.Client, o.Authorizers.ResourceManager)
 
+	flexibleServerCapabilitiesClient, err := flexibleservercapabilities.NewFlexibleServerCapabilitiesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building FlexibleServerCapabilities client: %+v", err)
+	}
+	o.Configure(flexibleServerCapabilitiesClient.Client, o.Authorizers.ResourceManager)
+
+	flexibleServerReplicasClient, err := flexibleserverreplicas.NewReplicasClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building FlexibleServerReplicas client: %+v", err)
+	}
+	o.Configure(flexibleServerReplicasClient.Client, o.Authorizers.ResourceManager)
+
+	flexibleServerVirtualEndpointClient, err := virtualendpoints.NewVirtualEndpointsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building FlexibleServerVirtualEndpoint client: %+v", err)
+	}
+	o.Configure(flexibleServerVirtualEndpointClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		ConfigurationsClient:                configurationsClient,
 		DatabasesClient:                     databasesClient,
@@ -143,6 +167,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		FlexibleServerFirewallRuleClient:    flexibleServerFirewallRuleClient,
 		FlexibleServerDatabaseClient:        flexibleServerDatabaseClient,
 		FlexibleServerAdministratorsClient:  flexibleServerAdministratorsClient,
+		FlexibleServerCapabilitiesClient:    flexibleServerCapabilitiesClient,
+		FlexibleServerReplicasClient:        flexibleServerReplicasClient,
+		FlexibleServerVirtualEndpointClient: flexibleServerVirtualEndpointClient,
 		ServersClient:                       serversClient,
 		ServerKeysClient:                    serverKeysClient,
 		ServerSecurityAlertPoliciesClient:   serverSecurityAlertPoliciesClient,
@@ -19,8 +19,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/serverrestart"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/flexibleservercapabilities"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/replicas"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/servers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/privatezones"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -44,8 +47,9 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourcePostgresqlFlexibleServerCreate,
 		Read:   resourcePostgresqlFlexibleServerRead,
-		Update: resourcePostgresqlFlexibleServerUpdate,
-		Delete: resourcePostgresqlFlexibleServerDelete,
+		// the in-place major version upgrade pre-checks return warnings alongside any errors, which needs diagnostics
+		UpdateContext: resourcePostgresqlFlexibleServerUpdateWithDiagnostics,
+		Delete:        resourcePostgresqlFlexibleServerDelete,
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(1 * time.Hour),
@@ -79,10 +83,26 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 			},
 
 			"administrator_password": {
-				Type:         pluginsdk.TypeString,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"administrator_password_wo"},
+			},
+
+			"administrator_password_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"administrator_password"},
+			},
+
+			"administrator_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"administrator_password_wo"},
 			},
 
 			"authentication": {
@@ -290,6 +310,24 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"replica_promotion_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(servers.ReadReplicaPromoteModeStandalone),
+					string(servers.ReadReplicaPromoteModeSwitchover),
+				}, false),
+			},
+
+			"replica_promotion_option": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(servers.ReplicationPromoteOptionPlanned),
+					string(servers.ReplicationPromoteOptionForced),
+				}, false),
+			},
+
 			"identity": commonschema.UserAssignedIdentityOptional(),
 
 			"customer_managed_key": {
@@ -336,8 +374,9 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 
 		CustomizeDiff: pluginsdk.CustomDiffWithAll(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
 			createModeVal := d.Get("create_mode").(string)
+			inPlaceUpgradeEnabled := v.(*clients.Client).Features.PostgresqlFlexibleServer.InPlaceMajorVersionUpgrade
 
-			if createModeVal == string(servers.CreateModeUpdate) {
+			if createModeVal == string(servers.CreateModeUpdate) || (inPlaceUpgradeEnabled && !d.HasChange("create_mode")) {
 				oldVersionVal, newVersionVal := d.GetChange("version")
 
 				if oldVersionVal != "" && newVersionVal != "" {
@@ -352,7 +391,16 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 					}
 
 					if oldVersion < newVersion {
-						return nil
+						if d.Id() == "" || !inPlaceUpgradeEnabled {
+							return nil
+						}
+
+						id, err := servers.ParseFlexibleServerID(d.Id())
+						if err != nil {
+							return err
+						}
+
+						return postgresqlFlexibleServerDiagnosticsError(postgresqlFlexibleServerMajorVersionUpgradePreCheck(ctx, v, *id, servers.ServerVersion(newVersionVal.(string)), true))
 					}
 				}
 			}
@@ -456,6 +504,14 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 
 	createMode := d.Get("create_mode").(string)
 
+	adminPassword := d.Get("administrator_password").(string)
+	if adminPassword == "" {
+		adminPassword, err = pluginsdk.GetWriteOnlyString(d, "administrator_password_wo")
+		if err != nil {
+			return err
+		}
+	}
+
 	if _, ok := d.GetOk("replication_role"); ok {
 		return fmt.Errorf("`replication_role` cannot be set while creating")
 	}
@@ -477,7 +533,7 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 
 	if createMode == "" || servers.CreateMode(createMode) == servers.CreateModeDefault {
 		_, adminLoginSet := d.GetOk("administrator_login")
-		_, adminPwdSet := d.GetOk("administrator_password")
+		adminPwdSet := adminPassword != ""
 
 		pwdEnabled := true // it defaults to true
 		if authRaw, authExist := d.GetOk("authentication"); authExist {
@@ -493,10 +549,10 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 			}
 
 			if !adminPwdSet {
-				return fmt.Errorf("`administrator_password` is required when `create_mode` is `Default` and `authentication.password_auth_enabled` is set to `true`")
+				return fmt.Errorf("one of `administrator_password` or `administrator_password_wo` is required when `create_mode` is `Default` and `authentication.password_auth_enabled` is set to `true`")
 			}
 		} else if adminLoginSet || adminPwdSet {
-			return fmt.Errorf("`administrator_login` and `administrator_password` cannot be set during creation when `authentication.password_auth_enabled` is set to `false`")
+			return fmt.Errorf("`administrator_login` and `administrator_password` (or `administrator_password_wo`) cannot be set during creation when `authentication.password_auth_enabled` is set to `false`")
 		}
 
 		if _, ok := d.GetOk("sku_name"); !ok {
@@ -554,8 +610,8 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 		parameters.Properties.AdministratorLogin = utils.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("administrator_password"); ok && v.(string) != "" {
-		parameters.Properties.AdministratorLoginPassword = utils.String(v.(string))
+	if adminPassword != "" {
+		parameters.Properties.AdministratorLoginPassword = utils.String(adminPassword)
 	}
 
 	if createMode != "" {
@@ -733,6 +789,26 @@ func resourcePostgresqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interf
 	return nil
 }
 
+// resourcePostgresqlFlexibleServerUpdateWithDiagnostics runs the in-place major version upgrade pre-checks prior to
+// updating the server, so that both the warnings and the errors from the pre-checks are returned as diagnostics
+func resourcePostgresqlFlexibleServerUpdateWithDiagnostics(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := make(diag.Diagnostics, 0)
+
+	if d.HasChange("version") && meta.(*clients.Client).Features.PostgresqlFlexibleServer.InPlaceMajorVersionUpgrade {
+		id, err := servers.ParseFlexibleServerID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags = append(diags, postgresqlFlexibleServerMajorVersionUpgradePreCheck(ctx, meta, *id, servers.ServerVersion(d.Get("version").(string)), false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, diag.FromErr(resourcePostgresqlFlexibleServerUpdate(d, meta))...)
+}
+
 func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Postgres.FlexibleServersClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
@@ -749,11 +825,19 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 
 	requireUpdateOnLogin := false // it's required to call Create with `createMode` set to `Update` to update login name.
 
+	adminPassword := d.Get("administrator_password").(string)
+	if adminPassword == "" {
+		adminPassword, err = pluginsdk.GetWriteOnlyString(d, "administrator_password_wo")
+		if err != nil {
+			return err
+		}
+	}
+
 	createMode := d.Get("create_mode").(string)
 	if createMode == "" || servers.CreateMode(createMode) == servers.CreateModeDefault {
 
 		_, adminLoginSet := d.GetOk("administrator_login")
-		_, adminPwdSet := d.GetOk("administrator_password")
+		adminPwdSet := adminPassword != ""
 
 		pwdEnabled := true // it defaults to true
 		if authRaw, authExist := d.GetOk("authentication"); authExist {
@@ -768,7 +852,7 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 				return fmt.Errorf("`administrator_login` is required when `authentication.password_auth_enabled` is set to `true`")
 			}
 			if !adminPwdSet {
-				return fmt.Errorf("`administrator_password` is required when `authentication.password_auth_enabled` is set to `true`")
+				return fmt.Errorf("one of `administrator_password` or `administrator_password_wo` is required when `authentication.password_auth_enabled` is set to `true`")
 			}
 		}
 
@@ -828,23 +912,42 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		createMode := d.Get("create_mode").(string)
 		replicationRole := d.Get("replication_role").(string)
 		if createMode == string(servers.CreateModeReplica) && replicationRole == string(servers.ReplicationRoleNone) {
-			replicationRole := servers.ReplicationRoleNone
+			promoteMode := servers.ReadReplicaPromoteModeStandalone
+			if v := d.Get("replica_promotion_mode").(string); v != "" {
+				promoteMode = servers.ReadReplicaPromoteMode(v)
+			}
+
+			promoteOption := servers.ReplicationPromoteOptionPlanned
+			if v := d.Get("replica_promotion_option").(string); v != "" {
+				promoteOption = servers.ReplicationPromoteOption(v)
+			}
+
+			// a `switchover` swaps the roles of the replica and its source, whereas a `standalone` promotion detaches the replica
+			role := servers.ReplicationRoleNone
+			if promoteMode == servers.ReadReplicaPromoteModeSwitchover {
+				role = servers.ReplicationRolePrimary
+			}
+
 			parameters := servers.ServerForUpdate{
 				Properties: &servers.ServerPropertiesForUpdate{
-					ReplicationRole: &replicationRole,
+					Replica: &servers.Replica{
+						Role:          pointer.To(role),
+						PromoteMode:   pointer.To(promoteMode),
+						PromoteOption: pointer.To(promoteOption),
+					},
 				},
 			}
 
 			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
-				return fmt.Errorf("updating `replication_role` for %s: %+v", *id, err)
+				return fmt.Errorf("promoting replica %s: %+v", *id, err)
 			}
 		} else {
 			return fmt.Errorf("`replication_role` only can be updated to `None` for replica server")
 		}
 	}
 
-	if d.HasChange("administrator_password") {
-		parameters.Properties.AdministratorLoginPassword = utils.String(d.Get("administrator_password").(string))
+	if d.HasChange("administrator_password") || pluginsdk.HasWriteOnlyChange(d, "administrator_password_wo") {
+		parameters.Properties.AdministratorLoginPassword = utils.String(adminPassword)
 	}
 
 	if d.HasChange("authentication") {
@@ -912,7 +1015,22 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 
 	if d.HasChange("version") {
 		version := servers.ServerVersion(d.Get("version").(string))
-		parameters.Properties.Version = &version
+
+		if meta.(*clients.Client).Features.PostgresqlFlexibleServer.InPlaceMajorVersionUpgrade {
+			// NOTE: the pre-checks have been run by `resourcePostgresqlFlexibleServerUpdateWithDiagnostics`
+			upgradeParameters := servers.ServerForUpdate{
+				Properties: &servers.ServerPropertiesForUpdate{
+					CreateMode: pointer.To(servers.CreateModeForUpdateUpdate),
+					Version:    pointer.To(version),
+				},
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, upgradeParameters); err != nil {
+				return fmt.Errorf("performing in-place major version upgrade of %s to %q: %+v", *id, string(version), err)
+			}
+		} else {
+			parameters.Properties.Version = &version
+		}
 	}
 
 	if requireUpdateOnLogin {
@@ -923,7 +1041,7 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 				CreateMode:                 &updateMode,
 				AuthConfig:                 expandFlexibleServerAuthConfig(d.Get("authentication").([]interface{})),
 				AdministratorLogin:         utils.String(d.Get("administrator_login").(string)),
-				AdministratorLoginPassword: utils.String(d.Get("administrator_password").(string)),
+				AdministratorLoginPassword: utils.String(adminPassword),
 				Network:                    expandArmServerNetwork(d),
 			},
 		}
@@ -971,6 +1089,136 @@ func resourcePostgresqlFlexibleServerDelete(d *pluginsdk.ResourceData, meta inte
 	return nil
 }
 
+// postgresqlFlexibleServerMajorVersionUpgradePreCheck validates that the server is in a state which allows an
+// in-place major version upgrade, and that the service supports upgrading from the current version to the target
+// version, returning a diagnostic for each finding so that they can be resolved in a single pass.
+//
+// When planning, the findings which can be resolved by changes applied before the upgrade (for example removing the
+// read replicas) are returned as warnings rather than errors, since these are re-checked prior to the upgrade.
+func postgresqlFlexibleServerMajorVersionUpgradePreCheck(ctx context.Context, meta interface{}, id servers.FlexibleServerId, targetVersion servers.ServerVersion, planning bool) diag.Diagnostics {
+	client := meta.(*clients.Client).Postgres.FlexibleServersClient
+	capabilitiesClient := meta.(*clients.Client).Postgres.FlexibleServerCapabilitiesClient
+	replicasClient := meta.(*clients.Client).Postgres.FlexibleServerReplicasClient
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return diag.Errorf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return diag.Errorf("retrieving %s: `properties` was nil", id)
+	}
+	props := resp.Model.Properties
+
+	transientSeverity := diag.Error
+	if planning {
+		transientSeverity = diag.Warning
+	}
+
+	diags := make(diag.Diagnostics, 0)
+	finding := func(severity diag.Severity, summary string, detail string) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("in-place major version upgrade of %s to %q: %s", id, string(targetVersion), summary),
+			Detail:   detail,
+		})
+	}
+
+	if props.Version != nil {
+		currentVersion, err := strconv.ParseInt(string(*props.Version), 10, 32)
+		if err != nil {
+			return diag.Errorf("parsing the current version %q of %s: %+v", string(*props.Version), id, err)
+		}
+
+		newVersion, err := strconv.ParseInt(string(targetVersion), 10, 32)
+		if err != nil {
+			return diag.Errorf("parsing the target version %q: %+v", string(targetVersion), err)
+		}
+
+		if newVersion <= currentVersion {
+			finding(diag.Error, fmt.Sprintf("the target version must be greater than the current version %q", string(*props.Version)), "")
+		}
+	}
+
+	if props.State != nil && *props.State != servers.ServerStateReady {
+		finding(transientSeverity, fmt.Sprintf("the server must be in the %q state but was %q", string(servers.ServerStateReady), string(*props.State)), "")
+	}
+
+	if role := props.ReplicationRole; role != nil && (*role == servers.ReplicationRoleAsyncReplica || *role == servers.ReplicationRoleGeoAsyncReplica) {
+		finding(diag.Error, fmt.Sprintf("the server is a read replica (%q) and must be promoted before it can be upgraded", string(*role)), "")
+	}
+
+	existingReplicas, err := replicasClient.ListByServerComplete(ctx, replicas.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName))
+	if err != nil {
+		return append(diags, diag.Errorf("listing replicas for %s: %+v", id, err)...)
+	}
+	if len(existingReplicas.Items) > 0 {
+		finding(transientSeverity, fmt.Sprintf("the server has %d read replica(s) which must be removed before it can be upgraded", len(existingReplicas.Items)), "")
+	}
+
+	// the capabilities of the server determine which versions the service supports upgrading the current version to
+	capabilities, err := capabilitiesClient.ServerCapabilitiesListComplete(ctx, flexibleservercapabilities.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName))
+	if err != nil {
+		return append(diags, diag.Errorf("listing the capabilities of %s: %+v", id, err)...)
+	}
+
+	var currentVersionCapability, targetVersionCapability *flexibleservercapabilities.ServerVersionCapability
+	for _, capability := range capabilities.Items {
+		if capability.SupportedServerVersions == nil {
+			continue
+		}
+		for _, v := range *capability.SupportedServerVersions {
+			if props.Version != nil && strings.EqualFold(pointer.From(v.Name), string(*props.Version)) {
+				currentVersionCapability = pointer.To(v)
+			}
+			if strings.EqualFold(pointer.From(v.Name), string(targetVersion)) {
+				targetVersionCapability = pointer.To(v)
+			}
+		}
+	}
+
+	if currentVersionCapability == nil || currentVersionCapability.SupportedVersionsToUpgrade == nil {
+		finding(diag.Warning, "unable to determine the versions which the service supports upgrading to", "The capabilities of the server didn't include the versions which the current version can be upgraded to, the upgrade will be validated by the service when it's performed.")
+	} else if !utils.SliceContainsValue(*currentVersionCapability.SupportedVersionsToUpgrade, string(targetVersion)) {
+		finding(diag.Error, fmt.Sprintf("the service doesn't support upgrading from version %q to the target version", pointer.From(currentVersionCapability.Name)), fmt.Sprintf("The supported versions to upgrade to are: %s", strings.Join(*currentVersionCapability.SupportedVersionsToUpgrade, ", ")))
+	}
+
+	if targetVersionCapability != nil && targetVersionCapability.Status != nil {
+		switch *targetVersionCapability.Status {
+		case flexibleservercapabilities.CapabilityStatusDisabled:
+			finding(diag.Error, "the target version is disabled for this server", pointer.From(targetVersionCapability.Reason))
+		case flexibleservercapabilities.CapabilityStatusVisible:
+			finding(diag.Warning, "the target version isn't generally available for this server", pointer.From(targetVersionCapability.Reason))
+		}
+	}
+
+	return diags
+}
+
+// postgresqlFlexibleServerDiagnosticsError logs any warnings within the diagnostics, and returns the errors as a single
+// error listing each of them - this is only used within the CustomizeDiff, which can't return diagnostics, since the
+// warnings are returned by `resourcePostgresqlFlexibleServerUpdateWithDiagnostics` when the upgrade is applied
+func postgresqlFlexibleServerDiagnosticsError(diags diag.Diagnostics) error {
+	failures := make([]string, 0)
+	for _, d := range diags {
+		message := d.Summary
+		if d.Detail != "" {
+			message = fmt.Sprintf("%s: %s", d.Summary, d.Detail)
+		}
+
+		if d.Severity == diag.Warning {
+			log.Printf("[WARN] %s", message)
+			continue
+		}
+		failures = append(failures, message)
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("the in-place major version upgrade pre-checks failed:\n\n* %s", strings.Join(failures, "\n* "))
+	}
+
+	return nil
+}
+
 func expandArmServerNetwork(d *pluginsdk.ResourceData) *servers.Network {
 	network := servers.Network{}
 
@@ -396,6 +396,59 @@ func TestAccPostgresqlFlexibleServer_upgradeVersion(t *testing.T) {
 	})
 }
 
+func TestAccPostgresqlFlexibleServer_inPlaceMajorVersionUpgrade(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.inPlaceMajorVersionUpgrade(data, "12"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+		{
+			Config: r.inPlaceMajorVersionUpgrade(data, "14"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").HasValue("14"),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+	})
+}
+
+func TestAccPostgresqlFlexibleServer_replicaSwitchover(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+		{
+			PreConfig: func() { time.Sleep(15 * time.Minute) },
+			Config:    r.replica(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_postgresql_flexible_server.replica").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+		{
+			Config: r.promoteReplica(data, "switchover", "planned"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_postgresql_flexible_server.replica").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode", "replica_promotion_mode", "replica_promotion_option"),
+	})
+}
+
 func TestAccPostgresqlFlexibleServer_enableGeoRedundantBackup(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
 	r := PostgresqlFlexibleServerResource{}
@@ -626,6 +679,51 @@ func TestAccPostgresqlFlexibleServer_recreateWithLowerStorageMb(t *testing.T) {
 	})
 }
 
+func TestAccPostgresqlFlexibleServer_writeOnlyAdminPassword(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+
+	data.ResourceTestWriteOnly(t, r, []acceptance.TestStep{
+		{
+			Config: r.writeOnlyAdminPassword(data, "QAZwsx123", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("administrator_password").IsEmpty(),
+			),
+		},
+		data.ImportStep("administrator_password_wo_version", "create_mode"),
+		{
+			Config: r.writeOnlyAdminPassword(data, "123wsxQAZ", 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password_wo_version", "create_mode"),
+	})
+}
+
+func TestAccPostgresqlFlexibleServer_updateToWriteOnlyAdminPassword(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+
+	data.ResourceTestWriteOnly(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+		{
+			Config: r.writeOnlyAdminPassword(data, "123wsxQAZ", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "administrator_password_wo_version", "create_mode"),
+	})
+}
+
 func (PostgresqlFlexibleServerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := servers.ParseFlexibleServerID(state.ID)
 	if err != nil {
@@ -653,6 +751,24 @@ resource "azurerm_resource_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (r PostgresqlFlexibleServerResource) writeOnlyAdminPassword(data acceptance.TestData, password string, version int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server" "test" {
+  name                              = "acctest-fs-%d"
+  resource_group_name               = azurerm_resource_group.test.name
+  location                          = azurerm_resource_group.test.location
+  administrator_login               = "adminTerraform"
+  administrator_password_wo         = "%s"
+  administrator_password_wo_version = %d
+  version                           = "12"
+  sku_name                          = "GP_Standard_D2s_v3"
+  zone                              = "2"
+}
+`, r.template(data), data.RandomInteger, password, version)
+}
+
 func (r PostgresqlFlexibleServerResource) updateOnlyWithStorageTier(data acceptance.TestData, storageTier string) string {
 	return fmt.Sprintf(`
 %s
@@ -1249,6 +1365,53 @@ resource "azurerm_postgresql_flexible_server" "replica" {
 `, r.basic(data), data.RandomInteger)
 }
 
+func (r PostgresqlFlexibleServerResource) promoteReplica(data acceptance.TestData, mode, option string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server" "replica" {
+  name                     = "acctest-fs-replica-%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  zone                     = "2"
+  create_mode              = "Replica"
+  source_server_id         = azurerm_postgresql_flexible_server.test.id
+  replication_role         = "None"
+  replica_promotion_mode   = "%s"
+  replica_promotion_option = "%s"
+}
+`, r.basic(data), data.RandomInteger, mode, option)
+}
+
+func (r PostgresqlFlexibleServerResource) inPlaceMajorVersionUpgrade(data acceptance.TestData, version string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    postgresql_flexible_server {
+      in_place_major_version_upgrade = true
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-postgresql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_postgresql_flexible_server" "test" {
+  name                   = "acctest-fs-%[1]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  administrator_login    = "adminTerraform"
+  administrator_password = "QAZwsx123"
+  storage_mb             = 32768
+  version                = "%[3]s"
+  sku_name               = "GP_Standard_D2s_v3"
+  zone                   = "2"
+}
+`, data.RandomInteger, data.Locations.Primary, version)
+}
+
 func (r PostgresqlFlexibleServerResource) upgradeVersion(data acceptance.TestData, version string) string {
 	return fmt.Sprintf(`
 %s
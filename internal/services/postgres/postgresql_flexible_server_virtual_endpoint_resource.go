@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/virtualendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PostgresqlFlexibleServerVirtualEndpointModel struct {
+	Name            string `tfschema:"name"`
+	SourceServerId  string `tfschema:"source_server_id"`
+	ReplicaServerId string `tfschema:"replica_server_id"`
+	Type            string `tfschema:"type"`
+}
+
+type PostgresqlFlexibleServerVirtualEndpointResource struct{}
+
+var _ sdk.ResourceWithUpdate = PostgresqlFlexibleServerVirtualEndpointResource{}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) ResourceType() string {
+	return "azurerm_postgresql_flexible_server_virtual_endpoint"
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) ModelObject() interface{} {
+	return &PostgresqlFlexibleServerVirtualEndpointModel{}
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return virtualendpoints.ValidateVirtualEndpointID
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"source_server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: servers.ValidateFlexibleServerID,
+		},
+
+		"replica_server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: servers.ValidateFlexibleServerID,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(virtualendpoints.PossibleValuesForVirtualEndpointType(), false),
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Postgres.FlexibleServerVirtualEndpointClient
+
+			var model PostgresqlFlexibleServerVirtualEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			sourceServerId, err := servers.ParseFlexibleServerID(model.SourceServerId)
+			if err != nil {
+				return err
+			}
+
+			replicaServerId, err := servers.ParseFlexibleServerID(model.ReplicaServerId)
+			if err != nil {
+				return err
+			}
+
+			id := virtualendpoints.NewVirtualEndpointID(sourceServerId.SubscriptionId, sourceServerId.ResourceGroupName, sourceServerId.FlexibleServerName, model.Name)
+
+			locks.ByName(sourceServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+			defer locks.UnlockByName(sourceServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+			locks.ByName(replicaServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+			defer locks.UnlockByName(replicaServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := virtualendpoints.VirtualEndpointResource{
+				Properties: &virtualendpoints.VirtualEndpointResourceProperties{
+					EndpointType: pointer.To(virtualendpoints.VirtualEndpointType(model.Type)),
+					Members:      pointer.To([]string{replicaServerId.FlexibleServerName}),
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Postgres.FlexibleServerVirtualEndpointClient
+
+			id, err := virtualendpoints.ParseVirtualEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config PostgresqlFlexibleServerVirtualEndpointModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := PostgresqlFlexibleServerVirtualEndpointModel{
+				Name:           id.VirtualEndpointName,
+				SourceServerId: servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Type = string(pointer.From(props.EndpointType))
+
+					// the API returns the names of all members of the endpoint (including the source server) rather than
+					// their IDs, so the replica server ID from the config is retained where its name is still a member
+					state.ReplicaServerId = flattenPostgresqlFlexibleServerVirtualEndpointReplicaServerId(*id, config.ReplicaServerId, pointer.From(props.Members))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Postgres.FlexibleServerVirtualEndpointClient
+
+			id, err := virtualendpoints.ParseVirtualEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PostgresqlFlexibleServerVirtualEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("replica_server_id") {
+				replicaServerId, err := servers.ParseFlexibleServerID(model.ReplicaServerId)
+				if err != nil {
+					return err
+				}
+
+				locks.ByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+				defer locks.UnlockByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+				locks.ByName(replicaServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+				defer locks.UnlockByName(replicaServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+
+				parameters := virtualendpoints.VirtualEndpointResourceForPatch{
+					Properties: &virtualendpoints.VirtualEndpointResourceProperties{
+						EndpointType: pointer.To(virtualendpoints.VirtualEndpointType(model.Type)),
+						Members:      pointer.To([]string{replicaServerId.FlexibleServerName}),
+					},
+				}
+
+				if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Postgres.FlexibleServerVirtualEndpointClient
+
+			id, err := virtualendpoints.ParseVirtualEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+			defer locks.UnlockByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenPostgresqlFlexibleServerVirtualEndpointReplicaServerId(id virtualendpoints.VirtualEndpointId, configuredReplicaServerId string, members []string) string {
+	if configuredReplicaServerId != "" {
+		if replicaServerId, err := servers.ParseFlexibleServerIDInsensitively(configuredReplicaServerId); err == nil {
+			for _, member := range members {
+				if member == replicaServerId.FlexibleServerName {
+					return replicaServerId.ID()
+				}
+			}
+		}
+	}
+
+	// otherwise (e.g. on import) the replica is assumed to live alongside the source server
+	for _, member := range members {
+		if member != id.FlexibleServerName {
+			return servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, member).ID()
+		}
+	}
+
+	return ""
+}
@@ -10,8 +10,8 @@ import (
 )
 
 var (
-	_ sdk.TypedServiceRegistration   = Registration{}
-	_ sdk.UntypedServiceRegistration = Registration{}
+	_ sdk.TypedServiceRegistrationWithSweepers   = Registration{}
+	_ sdk.UntypedServiceRegistrationWithSweepers = Registration{}
 )
 
 type Registration struct{}
@@ -30,6 +30,16 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// Sweepers returns a list of Sweepers which remove any resources leaked by the Acceptance Tests
+func (r Registration) Sweepers() []sdk.Sweeper {
+	return []sdk.Sweeper{
+		{
+			Name: "azurerm_resource_group",
+			Func: sweepResourceGroups,
+		},
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func sweepResourceGroups(ctx context.Context, client *clients.Client, options sdk.SweeperOptions) error {
+	resourceGroupsClient := client.Resource.ResourceGroupsClient
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+
+	resp, err := resourceGroupsClient.ListComplete(ctx, subscriptionId, resourcegroups.DefaultListOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing Resource Groups within %s: %+v", subscriptionId, err)
+	}
+
+	errs := make([]error, 0)
+	for _, item := range resp.Items {
+		name := pointer.From(item.Name)
+		if !options.ShouldSweep(name, sdk.CreationTimeFromTestResourceName(name)) {
+			continue
+		}
+
+		id := commonids.NewResourceGroupID(subscriptionId.SubscriptionId, name)
+		if options.DryRun {
+			log.Printf("[DEBUG] Dry Run - would delete %s", id)
+			continue
+		}
+
+		// deleting a Resource Group can take a long time, so we intentionally don't wait for this to complete
+		log.Printf("[DEBUG] Deleting %s..", id)
+		if _, err := resourceGroupsClient.Delete(ctx, id, resourcegroups.DefaultDeleteOperationOptions()); err != nil {
+			errs = append(errs, fmt.Errorf("deleting %s: %+v", id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -18,6 +18,8 @@ type Client struct {
 	QueryKeysClient                       *querykeys.QueryKeysClient
 	ServicesClient                        *services.ServicesClient
 	SearchSharedPrivateLinkResourceClient *sharedprivatelinkresources.SharedPrivateLinkResourcesClient
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -50,5 +52,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		QueryKeysClient:                       queryKeysClient,
 		ServicesClient:                        servicesClient,
 		SearchSharedPrivateLinkResourceClient: searchSharedPrivateLinkResourceClient,
+
+		options: o,
 	}, nil
 }
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/adminkeys"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+)
+
+type DataPlaneOperation struct {
+	SupportsAadAuthentication      bool
+	SupportsAdminKeyAuthentication bool
+}
+
+func (Client) DataPlaneOperationSupportingAnyAuthMethod() DataPlaneOperation {
+	return DataPlaneOperation{
+		SupportsAadAuthentication:      true,
+		SupportsAdminKeyAuthentication: true,
+	}
+}
+
+func (Client) DataPlaneOperationSupportingOnlyAdminKeyAuth() DataPlaneOperation {
+	return DataPlaneOperation{
+		SupportsAadAuthentication:      false,
+		SupportsAdminKeyAuthentication: true,
+	}
+}
+
+// DataPlaneClient returns a client for the Data Plane API of the Search Service - Entra ID authentication is used when
+// local (admin key) authentication has been disabled for the Search Service, otherwise the primary admin key is used.
+func (c Client) DataPlaneClient(ctx context.Context, id services.SearchServiceId, operation DataPlaneOperation) (*dataplane.Client, error) {
+	resp, err := c.ServicesClient.Get(ctx, id, services.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return c.DataPlaneClientForSearchService(ctx, id, resp.Model, operation)
+}
+
+// DataPlaneClientForSearchService returns a client for the Data Plane API of the Search Service using the Search
+// Service which has already been retrieved, avoiding retrieving it again.
+func (c Client) DataPlaneClientForSearchService(ctx context.Context, id services.SearchServiceId, model *services.SearchService, operation DataPlaneOperation) (*dataplane.Client, error) {
+	const clientName = "Search Data Plane"
+
+	localAuthDisabled := false
+	if model != nil && model.Properties != nil {
+		localAuthDisabled = pointer.From(model.Properties.DisableLocalAuth)
+	}
+
+	domainSuffix, resourceIdentifier := searchEndpointsForEnvironment(c.options.Environment)
+	apiClient := dataplane.NewClientWithBaseUri(fmt.Sprintf("https://%s.%s", id.SearchServiceName, domainSuffix))
+	c.options.Configure(apiClient.Client, nil)
+
+	if operation.SupportsAadAuthentication && c.options.AuthConfig != nil && (localAuthDisabled || !operation.SupportsAdminKeyAuthentication) {
+		api := environments.NewApiEndpoint("AzureSearch", resourceIdentifier, nil).WithResourceIdentifier(resourceIdentifier)
+		searchAuth, err := auth.NewAuthorizerFromCredentials(ctx, *c.options.AuthConfig, api)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build authorizer for %s API: %+v", clientName, err)
+		}
+
+		apiClient.Client.SetAuthorizer(searchAuth)
+		return apiClient, nil
+	}
+
+	if operation.SupportsAdminKeyAuthentication && !localAuthDisabled {
+		adminKeysId := adminkeys.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName)
+		keys, err := c.AdminKeysClient.Get(ctx, adminKeysId, adminkeys.DefaultGetOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("retrieving Admin Keys for %s: %+v", id, err)
+		}
+		if keys.Model == nil || keys.Model.PrimaryKey == nil {
+			return nil, fmt.Errorf("retrieving Admin Keys for %s: `primaryKey` was nil", id)
+		}
+
+		adminKey := *keys.Model.PrimaryKey
+		apiClient.Client.AuthorizeRequest = func(_ context.Context, req *http.Request, _ auth.Authorizer) error {
+			req.Header.Set("api-key", adminKey)
+			return nil
+		}
+		return apiClient, nil
+	}
+
+	return nil, fmt.Errorf("building %s client: no configured authentication types are supported", clientName)
+}
+
+// searchEndpointsForEnvironment returns the domain suffix and the resource identifier for the Search Data Plane API,
+// since these aren't defined for the environments within the SDK
+func searchEndpointsForEnvironment(environment environments.Environment) (string, string) {
+	switch environment.Name {
+	case environments.AzureChinaCloud:
+		return "search.azure.cn", "https://search.azure.cn"
+	case environments.AzureUSGovernmentCloud, "USGovernmentL5":
+		return "search.windows.us", "https://search.azure.us"
+	}
+
+	return "search.windows.net", "https://search.azure.com"
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+)
+
+// NOTE: the Search Data Plane API isn't available in the SDK, as such the subset of the API used to manage
+// Data Sources, Indexes, Indexers, Skillsets and Synonym Maps is implemented here.
+
+const apiVersion = "2023-11-01"
+
+type Client struct {
+	Client *dataplane.Client
+}
+
+func NewClientWithBaseUri(baseUri string) *Client {
+	return &Client{
+		Client: dataplane.NewDataPlaneClient(baseUri, "search", apiVersion),
+	}
+}
+
+// execute sends the request to the Data Plane API, marshalling the `input` into the request body and
+// unmarshalling the response body into the `output` when these are specified
+func (c Client) execute(ctx context.Context, method string, path string, query url.Values, expectedStatusCodes []int, input interface{}, output interface{}) (*http.Response, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: expectedStatusCodes,
+		HttpMethod:          method,
+		Path:                path,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("api-version", apiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	if method == http.MethodPut {
+		req.Header.Set("Prefer", "return=representation")
+	}
+
+	if input != nil {
+		if err := req.Marshal(input); err != nil {
+			return nil, fmt.Errorf("marshaling request: %+v", err)
+		}
+	}
+
+	resp, err := req.Execute(ctx)
+	var httpResponse *http.Response
+	if resp != nil {
+		httpResponse = resp.Response
+	}
+	if err != nil {
+		return httpResponse, err
+	}
+
+	if output != nil && httpResponse != nil && httpResponse.StatusCode != http.StatusNoContent {
+		if err := resp.Unmarshal(output); err != nil {
+			return httpResponse, fmt.Errorf("unmarshaling response: %+v", err)
+		}
+	}
+
+	return httpResponse, nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type DataSourceResponse struct {
+	HttpResponse *http.Response
+	Model        *DataSource
+}
+
+// CreateOrUpdateDataSource creates or updates the Data Source
+func (c Client) CreateOrUpdateDataSource(ctx context.Context, input DataSource) (result DataSourceResponse, err error) {
+	var model DataSource
+	result.HttpResponse, err = c.execute(ctx, http.MethodPut, dataSourcePath(input.Name), nil, []int{http.StatusOK, http.StatusCreated}, input, &model)
+	if err != nil {
+		return
+	}
+	result.Model = &model
+
+	return
+}
+
+// GetDataSource retrieves the Data Source
+func (c Client) GetDataSource(ctx context.Context, name string) (result DataSourceResponse, err error) {
+	var model DataSource
+	result.HttpResponse, err = c.execute(ctx, http.MethodGet, dataSourcePath(name), nil, []int{http.StatusOK}, nil, &model)
+	if err != nil {
+		return
+	}
+	result.Model = &model
+
+	return
+}
+
+// DeleteDataSource deletes the Data Source
+func (c Client) DeleteDataSource(ctx context.Context, name string) (result DataSourceResponse, err error) {
+	result.HttpResponse, err = c.execute(ctx, http.MethodDelete, dataSourcePath(name), nil, []int{http.StatusNoContent, http.StatusNotFound}, nil, nil)
+	return
+}
+
+func dataSourcePath(name string) string {
+	return fmt.Sprintf("/datasources/%s", url.PathEscape(name))
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type IndexerResponse struct {
+	HttpResponse *http.Response
+	Model        *Indexer
+}
+
+// CreateOrUpdateIndexer creates or updates the Indexer
+func (c Client) CreateOrUpdateIndexer(ctx context.Context, input Indexer) (result IndexerResponse, err error) {
+	var model Indexer
+	result.HttpResponse, err = c.execute(ctx, http.MethodPut, indexerPath(input.Name), nil, []int{http.StatusOK, http.StatusCreated}, input, &model)
+	if err != nil {
+		return
+	}
+	result.Model = &model
+
+	return
+}
+
+// GetIndexer retrieves the Indexer
+func (c Client) GetIndexer(ctx context.Context, name string) (result IndexerResponse, err error) {
+	var model Indexer
+	result.HttpResponse, err = c.execute(ctx, http.MethodGet, indexerPath(name), nil, []int{http.StatusOK}, nil, &model)
+	if err != nil {
+		return
+	}
+	result.Model = &model
+
+	return
+}
+
+// DeleteIndexer deletes the Indexer
+func (c Client) DeleteIndexer(ctx context.Context, name string) (result IndexerResponse, err error) {
+	result.HttpResponse, err = c.execute(ctx, http.MethodDelete, indexerPath(name), nil, []int{http.StatusNoContent, http.StatusNotFound}, nil, nil)
+	return
+}
+
+func indexerPath(name string) string {
+	return fmt.Sprintf("/indexers/%s", url.PathEscape(name))
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type IndexResponse struct {
+	HttpResponse *http.Response
+	Model        *Index
+}
+
+// CreateOrUpdateIndex creates or updates the Index, `allowIndexDowntime` allows new analyzers, tokenizers,
+// token filters or char filters to be added to an existing Index by taking it offline for a few seconds
+func (c Client) CreateOrUpdateIndex(ctx context.Context, input Index, allowIndexDowntime bool) (result IndexResponse, err error) {
+	query := url.Values{}
+	if allowIndexDowntime {
+		query.Set("allowIndexDowntime", "true")
+	}
+
+	var model Index
+	result.HttpResponse, err = c.execute(ctx, http.MethodPut, indexPath(input.Name), query, []int{http.StatusOK, http.StatusCreated}, input, &model)
+	if err != nil {
+		return
+	}
+	result.Model = &model
+
+	return
+}
+
+// GetIndex retrieves the Index
+func (c Client) GetIndex(ctx context.Context, name string) (result IndexResponse, err error) {
+	var model Index
+	result.HttpResponse, err = c.execute(ctx, http.MethodGet, indexPath(name), nil, []int{http.StatusOK}, nil, &model)
+	if err != nil {
+		return
+	}
+	result.Model = &model
+
+	return
+}
+
+// DeleteIndex deletes the Index
+func (c Client) DeleteIndex(ctx context.Context, name string) (result IndexResponse, err error) {
+	result.HttpResponse, err = c.execute(ctx, http.MethodDelete, indexPath(name), nil, []int{http.StatusNoContent, http.StatusNotFound}, nil, nil)
+	return
+}
+
+func indexPath(name string) string {
+	return fmt.Sprintf("/indexes/%s", url.PathEscape(name))
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataplane
+
+const (
+	DataChangeDetectionPolicyHighWaterMark      = "#Microsoft.Azure.Search.HighWaterMarkChangeDetectionPolicy"
+	DataDeletionDetectionPolicySoftDeleteColumn = "#Microsoft.Azure.Search.SoftDeleteColumnDeletionDetectionPolicy"
+	SynonymMapFormatSolr                        = "solr"
+	SuggesterSearchModeAnalyzingInfixMatching   = "analyzingInfixMatching"
+)
+
+func PossibleValuesForDataSourceType() []string {
+	return []string{
+		"adlsgen2",
+		"azureblob",
+		"azuresql",
+		"azuretable",
+		"cosmosdb",
+		"mysql",
+	}
+}
+
+func PossibleValuesForFieldType() []string {
+	types := []string{
+		"Edm.Boolean",
+		"Edm.DateTimeOffset",
+		"Edm.Double",
+		"Edm.GeographyPoint",
+		"Edm.Int32",
+		"Edm.Int64",
+		"Edm.String",
+	}
+
+	result := make([]string, 0)
+	for _, v := range types {
+		result = append(result, v, "Collection("+v+")")
+	}
+	return result
+}
+
+type DataSource struct {
+	Name                        string                       `json:"name"`
+	Description                 *string                      `json:"description,omitempty"`
+	Type                        string                       `json:"type"`
+	Credentials                 DataSourceCredentials        `json:"credentials"`
+	Container                   DataContainer                `json:"container"`
+	DataChangeDetectionPolicy   *DataChangeDetectionPolicy   `json:"dataChangeDetectionPolicy,omitempty"`
+	DataDeletionDetectionPolicy *DataDeletionDetectionPolicy `json:"dataDeletionDetectionPolicy,omitempty"`
+	ETag                        *string                      `json:"@odata.etag,omitempty"`
+}
+
+type DataSourceCredentials struct {
+	ConnectionString *string `json:"connectionString"`
+}
+
+type DataContainer struct {
+	Name  string  `json:"name"`
+	Query *string `json:"query,omitempty"`
+}
+
+type DataChangeDetectionPolicy struct {
+	ODataType           string  `json:"@odata.type"`
+	HighWaterMarkColumn *string `json:"highWaterMarkColumnName,omitempty"`
+}
+
+type DataDeletionDetectionPolicy struct {
+	ODataType             string  `json:"@odata.type"`
+	SoftDeleteColumnName  *string `json:"softDeleteColumnName,omitempty"`
+	SoftDeleteMarkerValue *string `json:"softDeleteMarkerValue,omitempty"`
+}
+
+type Index struct {
+	Name                  string       `json:"name"`
+	Fields                []Field      `json:"fields"`
+	Suggesters            []Suggester  `json:"suggesters"`
+	CorsOptions           *CorsOptions `json:"corsOptions,omitempty"`
+	DefaultScoringProfile *string      `json:"defaultScoringProfile,omitempty"`
+	ETag                  *string      `json:"@odata.etag,omitempty"`
+}
+
+type Field struct {
+	Name           string    `json:"name"`
+	Type           string    `json:"type"`
+	Key            *bool     `json:"key,omitempty"`
+	Retrievable    *bool     `json:"retrievable,omitempty"`
+	Searchable     *bool     `json:"searchable,omitempty"`
+	Filterable     *bool     `json:"filterable,omitempty"`
+	Sortable       *bool     `json:"sortable,omitempty"`
+	Facetable      *bool     `json:"facetable,omitempty"`
+	Analyzer       *string   `json:"analyzer,omitempty"`
+	SearchAnalyzer *string   `json:"searchAnalyzer,omitempty"`
+	IndexAnalyzer  *string   `json:"indexAnalyzer,omitempty"`
+	SynonymMaps    *[]string `json:"synonymMaps,omitempty"`
+}
+
+type Suggester struct {
+	Name         string   `json:"name"`
+	SearchMode   string   `json:"searchMode"`
+	SourceFields []string `json:"sourceFields"`
+}
+
+type CorsOptions struct {
+	AllowedOrigins  []string `json:"allowedOrigins"`
+	MaxAgeInSeconds *int64   `json:"maxAgeInSeconds,omitempty"`
+}
+
+type Indexer struct {
+	Name                string              `json:"name"`
+	Description         *string             `json:"description,omitempty"`
+	DataSourceName      string              `json:"dataSourceName"`
+	SkillsetName        *string             `json:"skillsetName,omitempty"`
+	TargetIndexName     string              `json:"targetIndexName"`
+	Disabled            *bool               `json:"disabled,omitempty"`
+	Schedule            *IndexingSchedule   `json:"schedule,omitempty"`
+	Parameters          *IndexingParameters `json:"parameters,omitempty"`
+	FieldMappings       []FieldMapping      `json:"fieldMappings"`
+	OutputFieldMappings []FieldMapping      `json:"outputFieldMappings"`
+	ETag                *string             `json:"@odata.etag,omitempty"`
+}
+
+type IndexingSchedule struct {
+	Interval  string  `json:"interval"`
+	StartTime *string `json:"startTime,omitempty"`
+}
+
+type IndexingParameters struct {
+	BatchSize              *int64                 `json:"batchSize,omitempty"`
+	MaxFailedItems         *int64                 `json:"maxFailedItems,omitempty"`
+	MaxFailedItemsPerBatch *int64                 `json:"maxFailedItemsPerBatch,omitempty"`
+	Configuration          map[string]interface{} `json:"configuration,omitempty"`
+}
+
+type FieldMapping struct {
+	SourceFieldName string                `json:"sourceFieldName"`
+	TargetFieldName *string               `json:"targetFieldName,omitempty"`
+	MappingFunction *FieldMappingFunction `json:"mappingFunction,omitempty"`
+}
+
+type FieldMappingFunction struct {
+	Name string `json:"name"`
+}
+
+type Skillset struct {
+	Name              string        `json:"name"`
+	Description       *string       `json:"description,omitempty"`
+	Skills            []interface{} `json:"skills"`
+	CognitiveServices interface{}   `json:"cognitiveServices,omitempty"`
+	ETag              *string       `json:"@odata.etag,omitempty"`
+}
+
+type SynonymMap struct {
+	Name     string  `json:"name"`
+	Format   string  `json:"format"`
+	Synonyms string  `json:"synonyms"`
+	ETag     *string `json:"@odata.etag,omitempty"`
+}
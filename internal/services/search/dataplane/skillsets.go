@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type SkillsetResponse struct {
+	HttpResponse *http.Response
+	Model        *Skillset
+}
+
+// CreateOrUpdateSkillset creates or updates the Skillset
+func (c Client) CreateOrUpdateSkillset(ctx context.Context, input Skillset) (result SkillsetResponse, err error) {
+	var model Skillset
+	result.HttpResponse, err = c.execute(ctx, http.MethodPut, skillsetPath(input.Name), nil, []int{http.StatusOK, http.StatusCreated}, input, &model)
+	if err != nil {
+		return
+	}
+	result.Model = &model
+
+	return
+}
+
+// GetSkillset retrieves the Skillset
+func (c Client) GetSkillset(ctx context.Context, name string) (result SkillsetResponse, err error) {
+	var model Skillset
+	result.HttpResponse, err = c.execute(ctx, http.MethodGet, skillsetPath(name), nil, []int{http.StatusOK}, nil, &model)
+	if err != nil {
+		return
+	}
+	result.Model = &model
+
+	return
+}
+
+// DeleteSkillset deletes the Skillset
+func (c Client) DeleteSkillset(ctx context.Context, name string) (result SkillsetResponse, err error) {
+	result.HttpResponse, err = c.execute(ctx, http.MethodDelete, skillsetPath(name), nil, []int{http.StatusNoContent, http.StatusNotFound}, nil, nil)
+	return
+}
+
+func skillsetPath(name string) string {
+	return fmt.Sprintf("/skillsets/%s", url.PathEscape(name))
+}
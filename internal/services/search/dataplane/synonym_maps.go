@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type SynonymMapResponse struct {
+	HttpResponse *http.Response
+	Model        *SynonymMap
+}
+
+// CreateOrUpdateSynonymMap creates or updates the Synonym Map
+func (c Client) CreateOrUpdateSynonymMap(ctx context.Context, input SynonymMap) (result SynonymMapResponse, err error) {
+	var model SynonymMap
+	result.HttpResponse, err = c.execute(ctx, http.MethodPut, synonymMapPath(input.Name), nil, []int{http.StatusOK, http.StatusCreated}, input, &model)
+	if err != nil {
+		return
+	}
+	result.Model = &model
+
+	return
+}
+
+// GetSynonymMap retrieves the Synonym Map
+func (c Client) GetSynonymMap(ctx context.Context, name string) (result SynonymMapResponse, err error) {
+	var model SynonymMap
+	result.HttpResponse, err = c.execute(ctx, http.MethodGet, synonymMapPath(name), nil, []int{http.StatusOK}, nil, &model)
+	if err != nil {
+		return
+	}
+	result.Model = &model
+
+	return
+}
+
+// DeleteSynonymMap deletes the Synonym Map
+func (c Client) DeleteSynonymMap(ctx context.Context, name string) (result SynonymMapResponse, err error) {
+	result.HttpResponse, err = c.execute(ctx, http.MethodDelete, synonymMapPath(name), nil, []int{http.StatusNoContent, http.StatusNotFound}, nil, nil)
+	return
+}
+
+func synonymMapPath(name string) string {
+	return fmt.Sprintf("/synonymmaps/%s", url.PathEscape(name))
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SearchIndexerId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	SearchServiceName string
+	IndexerName       string
+}
+
+func NewSearchIndexerID(subscriptionId, resourceGroup, searchServiceName, indexerName string) SearchIndexerId {
+	return SearchIndexerId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		SearchServiceName: searchServiceName,
+		IndexerName:       indexerName,
+	}
+}
+
+func (id SearchIndexerId) String() string {
+	segments := []string{
+		fmt.Sprintf("Indexer Name %q", id.IndexerName),
+		fmt.Sprintf("Search Service Name %q", id.SearchServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Search Indexer", segmentsStr)
+}
+
+func (id SearchIndexerId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s/indexers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SearchServiceName, id.IndexerName)
+}
+
+// SearchIndexerID parses a SearchIndexer ID into an SearchIndexerId struct
+func SearchIndexerID(input string) (*SearchIndexerId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an SearchIndexer ID: %+v", input, err)
+	}
+
+	resourceId := SearchIndexerId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SearchServiceName, err = id.PopSegment("searchServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.IndexerName, err = id.PopSegment("indexers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
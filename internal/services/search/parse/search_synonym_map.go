@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SearchSynonymMapId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	SearchServiceName string
+	SynonymMapName    string
+}
+
+func NewSearchSynonymMapID(subscriptionId, resourceGroup, searchServiceName, synonymMapName string) SearchSynonymMapId {
+	return SearchSynonymMapId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		SearchServiceName: searchServiceName,
+		SynonymMapName:    synonymMapName,
+	}
+}
+
+func (id SearchSynonymMapId) String() string {
+	segments := []string{
+		fmt.Sprintf("Synonym Map Name %q", id.SynonymMapName),
+		fmt.Sprintf("Search Service Name %q", id.SearchServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Search Synonym Map", segmentsStr)
+}
+
+func (id SearchSynonymMapId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s/synonymMaps/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SearchServiceName, id.SynonymMapName)
+}
+
+// SearchSynonymMapID parses a SearchSynonymMap ID into an SearchSynonymMapId struct
+func SearchSynonymMapID(input string) (*SearchSynonymMapId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an SearchSynonymMap ID: %+v", input, err)
+	}
+
+	resourceId := SearchSynonymMapId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SearchServiceName, err = id.PopSegment("searchServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.SynonymMapName, err = id.PopSegment("synonymMaps"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
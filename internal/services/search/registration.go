@@ -33,7 +33,12 @@ func (r Registration) WebsiteCategories() []string {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		DataSourceResource{},
+		IndexResource{},
+		IndexerResource{},
 		SharedPrivateLinkServiceResource{},
+		SkillsetResource{},
+		SynonymMapResource{},
 	}
 }
 
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+)
+
+// searchDataPlaneClientForRead returns a Data Plane client for the Search Service, or nil when the Search Service
+// no longer exists - in which case all of the Data Plane objects within it are gone too
+func searchDataPlaneClientForRead(ctx context.Context, metadata sdk.ResourceMetaData, id services.SearchServiceId) (*dataplane.Client, error) {
+	existing, err := metadata.Client.Search.ServicesClient.Get(ctx, id, services.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return metadata.Client.Search.DataPlaneClientForSearchService(ctx, id, existing.Model, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+}
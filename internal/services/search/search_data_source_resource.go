@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DataSourceResource struct{}
+
+var (
+	_ sdk.Resource           = DataSourceResource{}
+	_ sdk.ResourceWithUpdate = DataSourceResource{}
+)
+
+type DataSourceModel struct {
+	Name                    string `tfschema:"name"`
+	SearchServiceId         string `tfschema:"search_service_id"`
+	Type                    string `tfschema:"type"`
+	ConnectionString        string `tfschema:"connection_string"`
+	ContainerName           string `tfschema:"container_name"`
+	ContainerQuery          string `tfschema:"container_query"`
+	Description             string `tfschema:"description"`
+	HighWaterMarkColumnName string `tfschema:"high_water_mark_column_name"`
+	SoftDeleteColumnName    string `tfschema:"soft_delete_column_name"`
+	SoftDeleteMarkerValue   string `tfschema:"soft_delete_marker_value"`
+}
+
+func (r DataSourceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SearchObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(dataplane.PossibleValuesForDataSourceType(), false),
+		},
+
+		"connection_string": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"container_query": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"high_water_mark_column_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"soft_delete_column_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"soft_delete_marker_value"},
+		},
+
+		"soft_delete_marker_value": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"soft_delete_column_name"},
+		},
+	}
+}
+
+func (r DataSourceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DataSourceResource) ResourceType() string {
+	return "azurerm_search_data_source"
+}
+
+func (r DataSourceResource) ModelObject() interface{} {
+	return &DataSourceModel{}
+}
+
+func (r DataSourceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SearchDataSourceID
+}
+
+func (r DataSourceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSearchDataSourceID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetDataSource(ctx, id.DataSourceName)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdateDataSource(ctx, expandSearchDataSource(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DataSourceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := searchDataPlaneClientForRead(ctx, metadata, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.GetDataSource(ctx, id.DataSourceName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			var config DataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := DataSourceModel{
+				Name:            id.DataSourceName,
+				SearchServiceId: searchServiceId.ID(),
+				// the connection string isn't returned by the API
+				ConnectionString: config.ConnectionString,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Type = model.Type
+				state.Description = pointer.From(model.Description)
+				state.ContainerName = model.Container.Name
+				state.ContainerQuery = pointer.From(model.Container.Query)
+
+				if policy := model.DataChangeDetectionPolicy; policy != nil && policy.ODataType == dataplane.DataChangeDetectionPolicyHighWaterMark {
+					state.HighWaterMarkColumnName = pointer.From(policy.HighWaterMarkColumn)
+				}
+
+				if policy := model.DataDeletionDetectionPolicy; policy != nil && policy.ODataType == dataplane.DataDeletionDetectionPolicySoftDeleteColumn {
+					state.SoftDeleteColumnName = pointer.From(policy.SoftDeleteColumnName)
+					state.SoftDeleteMarkerValue = pointer.From(policy.SoftDeleteMarkerValue)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DataSourceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			// the Data Source is replaced in its entirety, so the complete payload is sent
+			if _, err := client.CreateOrUpdateDataSource(ctx, expandSearchDataSource(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DataSourceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.DeleteDataSource(ctx, id.DataSourceName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSearchDataSource(model DataSourceModel) dataplane.DataSource {
+	payload := dataplane.DataSource{
+		Name: model.Name,
+		Type: model.Type,
+		Credentials: dataplane.DataSourceCredentials{
+			ConnectionString: pointer.To(model.ConnectionString),
+		},
+		Container: dataplane.DataContainer{
+			Name: model.ContainerName,
+		},
+	}
+
+	if model.Description != "" {
+		payload.Description = pointer.To(model.Description)
+	}
+
+	if model.ContainerQuery != "" {
+		payload.Container.Query = pointer.To(model.ContainerQuery)
+	}
+
+	if model.HighWaterMarkColumnName != "" {
+		payload.DataChangeDetectionPolicy = &dataplane.DataChangeDetectionPolicy{
+			ODataType:           dataplane.DataChangeDetectionPolicyHighWaterMark,
+			HighWaterMarkColumn: pointer.To(model.HighWaterMarkColumnName),
+		}
+	}
+
+	if model.SoftDeleteColumnName != "" {
+		payload.DataDeletionDetectionPolicy = &dataplane.DataDeletionDetectionPolicy{
+			ODataType:             dataplane.DataDeletionDetectionPolicySoftDeleteColumn,
+			SoftDeleteColumnName:  pointer.To(model.SoftDeleteColumnName),
+			SoftDeleteMarkerValue: pointer.To(model.SoftDeleteMarkerValue),
+		}
+	}
+
+	return payload
+}
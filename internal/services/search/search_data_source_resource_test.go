@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchDataSourceResource struct{}
+
+func TestAccSearchDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+	})
+}
+
+func TestAccSearchDataSource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchDataSource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+	})
+}
+
+func TestAccSearchDataSource_entraIdAuthentication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.entraIdAuthentication(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+	})
+}
+
+func (r SearchDataSourceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SearchDataSourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName), clients.Search.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetDataSource(ctx, id.DataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SearchDataSourceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "test" {
+  name              = "acctestdatasource%d"
+  search_service_id = azurerm_search_service.test.id
+  type              = "azureblob"
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchDataSourceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "import" {
+  name              = azurerm_search_data_source.test.name
+  search_service_id = azurerm_search_data_source.test.search_service_id
+  type              = azurerm_search_data_source.test.type
+  connection_string = azurerm_search_data_source.test.connection_string
+  container_name    = azurerm_search_data_source.test.container_name
+}
+`, r.basic(data))
+}
+
+func (r SearchDataSourceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "test" {
+  name                     = "acctestdatasource%d"
+  search_service_id        = azurerm_search_service.test.id
+  type                     = "azureblob"
+  connection_string        = azurerm_storage_account.test.primary_connection_string
+  container_name           = azurerm_storage_container.test.name
+  container_query          = "documents"
+  description              = "Documents uploaded by the acceptance tests"
+  soft_delete_column_name  = "IsDeleted"
+  soft_delete_marker_value = "true"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchDataSourceResource) entraIdAuthentication(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-search-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_search_service" "test" {
+  name                         = "acctestsearchservice%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  sku                          = "basic"
+  local_authentication_enabled = false
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_search_service.test.id
+  role_definition_name = "Search Service Contributor"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "documents"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_search_data_source" "test" {
+  name              = "acctestdatasource%[1]d"
+  search_service_id = azurerm_search_service.test.id
+  type              = "azureblob"
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (SearchDataSourceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "documents"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, SearchIndexResource{}.template(data), data.RandomString)
+}
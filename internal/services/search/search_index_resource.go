@@ -0,0 +1,537 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type IndexResource struct{}
+
+var (
+	_ sdk.Resource                  = IndexResource{}
+	_ sdk.ResourceWithUpdate        = IndexResource{}
+	_ sdk.ResourceWithCustomizeDiff = IndexResource{}
+)
+
+type IndexModel struct {
+	Name                string                `tfschema:"name"`
+	SearchServiceId     string                `tfschema:"search_service_id"`
+	Fields              []IndexFieldModel     `tfschema:"field"`
+	Suggesters          []IndexSuggesterModel `tfschema:"suggester"`
+	CorsAllowedOrigins  []string              `tfschema:"cors_allowed_origins"`
+	CorsMaxAgeInSeconds int64                 `tfschema:"cors_max_age_in_seconds"`
+	AllowIndexDowntime  bool                  `tfschema:"allow_index_downtime"`
+}
+
+type IndexFieldModel struct {
+	Name               string   `tfschema:"name"`
+	Type               string   `tfschema:"type"`
+	Key                bool     `tfschema:"key"`
+	Retrievable        bool     `tfschema:"retrievable"`
+	Searchable         bool     `tfschema:"searchable"`
+	Filterable         bool     `tfschema:"filterable"`
+	Sortable           bool     `tfschema:"sortable"`
+	Facetable          bool     `tfschema:"facetable"`
+	AnalyzerName       string   `tfschema:"analyzer_name"`
+	SearchAnalyzerName string   `tfschema:"search_analyzer_name"`
+	IndexAnalyzerName  string   `tfschema:"index_analyzer_name"`
+	SynonymMapNames    []string `tfschema:"synonym_map_names"`
+}
+
+type IndexSuggesterModel struct {
+	Name         string   `tfschema:"name"`
+	SourceFields []string `tfschema:"source_fields"`
+}
+
+func (r IndexResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SearchObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"field": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(dataplane.PossibleValuesForFieldType(), false),
+					},
+
+					"key": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"retrievable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"searchable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"filterable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"sortable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"facetable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"analyzer_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"search_analyzer_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"index_analyzer_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"synonym_map_names": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validate.SearchObjectName,
+						},
+					},
+				},
+			},
+		},
+
+		"suggester": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"source_fields": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"cors_allowed_origins": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"cors_max_age_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			RequiredWith: []string{"cors_allowed_origins"},
+		},
+
+		"allow_index_downtime": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r IndexResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r IndexResource) ResourceType() string {
+	return "azurerm_search_index"
+}
+
+func (r IndexResource) ModelObject() interface{} {
+	return &IndexModel{}
+}
+
+func (r IndexResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SearchIndexID
+}
+
+func (r IndexResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+			if rd.Id() == "" || !rd.HasChange("field") {
+				return nil
+			}
+
+			oldRaw, newRaw := rd.GetChange("field")
+			oldFields := searchIndexFieldsFromRaw(oldRaw.([]interface{}))
+			newFields := searchIndexFieldsFromRaw(newRaw.([]interface{}))
+
+			// fields can be added to an existing Index, but most changes to existing fields require the Index to be rebuilt
+			if requiresRecreation, reason := searchIndexFieldsRequireRecreation(oldFields, newFields); requiresRecreation {
+				metadata.Logger.Infof("[DEBUG] recreating the Search Index since %s", reason)
+				return rd.ForceNew("field")
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r IndexResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model IndexModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSearchIndexID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetIndex(ctx, id.IndexName)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdateIndex(ctx, expandSearchIndex(model), false); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r IndexResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := searchDataPlaneClientForRead(ctx, metadata, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.GetIndex(ctx, id.IndexName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := IndexModel{
+				Name:            id.IndexName,
+				SearchServiceId: searchServiceId.ID(),
+				// `allow_index_downtime` only affects updates and isn't returned by the API
+				AllowIndexDowntime: metadata.ResourceData.Get("allow_index_downtime").(bool),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Fields = flattenSearchIndexFields(model.Fields)
+				state.Suggesters = flattenSearchIndexSuggesters(model.Suggesters)
+
+				if cors := model.CorsOptions; cors != nil {
+					state.CorsAllowedOrigins = cors.AllowedOrigins
+					state.CorsMaxAgeInSeconds = pointer.From(cors.MaxAgeInSeconds)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r IndexResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model IndexModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdateIndex(ctx, expandSearchIndex(model), model.AllowIndexDowntime); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r IndexResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.DeleteIndex(ctx, id.IndexName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// searchIndexFieldsRequireRecreation returns whether the changes to the fields of an Index can only be applied by
+// rebuilding the Index, along with the reason why - only new fields can be added and only the `retrievable`,
+// `search_analyzer_name` and `synonym_map_names` properties of an existing field can be changed.
+func searchIndexFieldsRequireRecreation(oldFields, newFields []IndexFieldModel) (bool, string) {
+	updated := make(map[string]IndexFieldModel, len(newFields))
+	for _, field := range newFields {
+		updated[field.Name] = field
+	}
+
+	for _, existing := range oldFields {
+		field, ok := updated[existing.Name]
+		if !ok {
+			return true, fmt.Sprintf("the field %q was removed", existing.Name)
+		}
+
+		// blank out the properties which can be updated, so that any remaining differences require recreation
+		existing.Retrievable, field.Retrievable = false, false
+		existing.SearchAnalyzerName, field.SearchAnalyzerName = "", ""
+		existing.SynonymMapNames, field.SynonymMapNames = nil, nil
+
+		if !reflect.DeepEqual(existing, field) {
+			return true, fmt.Sprintf("the field %q was changed", existing.Name)
+		}
+	}
+
+	return false, ""
+}
+
+func searchIndexFieldsFromRaw(input []interface{}) []IndexFieldModel {
+	result := make([]IndexFieldModel, 0)
+	for _, item := range input {
+		raw, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		synonymMapNames := make([]string, 0)
+		for _, v := range raw["synonym_map_names"].([]interface{}) {
+			synonymMapNames = append(synonymMapNames, v.(string))
+		}
+
+		result = append(result, IndexFieldModel{
+			Name:               raw["name"].(string),
+			Type:               raw["type"].(string),
+			Key:                raw["key"].(bool),
+			Retrievable:        raw["retrievable"].(bool),
+			Searchable:         raw["searchable"].(bool),
+			Filterable:         raw["filterable"].(bool),
+			Sortable:           raw["sortable"].(bool),
+			Facetable:          raw["facetable"].(bool),
+			AnalyzerName:       raw["analyzer_name"].(string),
+			SearchAnalyzerName: raw["search_analyzer_name"].(string),
+			IndexAnalyzerName:  raw["index_analyzer_name"].(string),
+			SynonymMapNames:    synonymMapNames,
+		})
+	}
+
+	return result
+}
+
+func expandSearchIndex(model IndexModel) dataplane.Index {
+	fields := make([]dataplane.Field, 0)
+	for _, field := range model.Fields {
+		item := dataplane.Field{
+			Name:        field.Name,
+			Type:        field.Type,
+			Key:         pointer.To(field.Key),
+			Retrievable: pointer.To(field.Retrievable),
+			Searchable:  pointer.To(field.Searchable),
+			Filterable:  pointer.To(field.Filterable),
+			Sortable:    pointer.To(field.Sortable),
+			Facetable:   pointer.To(field.Facetable),
+			SynonymMaps: pointer.To(field.SynonymMapNames),
+		}
+
+		if field.AnalyzerName != "" {
+			item.Analyzer = pointer.To(field.AnalyzerName)
+		}
+		if field.SearchAnalyzerName != "" {
+			item.SearchAnalyzer = pointer.To(field.SearchAnalyzerName)
+		}
+		if field.IndexAnalyzerName != "" {
+			item.IndexAnalyzer = pointer.To(field.IndexAnalyzerName)
+		}
+
+		fields = append(fields, item)
+	}
+
+	suggesters := make([]dataplane.Suggester, 0)
+	for _, suggester := range model.Suggesters {
+		suggesters = append(suggesters, dataplane.Suggester{
+			Name:         suggester.Name,
+			SearchMode:   dataplane.SuggesterSearchModeAnalyzingInfixMatching,
+			SourceFields: suggester.SourceFields,
+		})
+	}
+
+	payload := dataplane.Index{
+		Name:       model.Name,
+		Fields:     fields,
+		Suggesters: suggesters,
+	}
+
+	if len(model.CorsAllowedOrigins) > 0 {
+		payload.CorsOptions = &dataplane.CorsOptions{
+			AllowedOrigins: model.CorsAllowedOrigins,
+		}
+		if model.CorsMaxAgeInSeconds > 0 {
+			payload.CorsOptions.MaxAgeInSeconds = pointer.To(model.CorsMaxAgeInSeconds)
+		}
+	}
+
+	return payload
+}
+
+func flattenSearchIndexFields(input []dataplane.Field) []IndexFieldModel {
+	result := make([]IndexFieldModel, 0)
+	for _, field := range input {
+		result = append(result, IndexFieldModel{
+			Name:               field.Name,
+			Type:               field.Type,
+			Key:                pointer.From(field.Key),
+			Retrievable:        pointer.From(field.Retrievable),
+			Searchable:         pointer.From(field.Searchable),
+			Filterable:         pointer.From(field.Filterable),
+			Sortable:           pointer.From(field.Sortable),
+			Facetable:          pointer.From(field.Facetable),
+			AnalyzerName:       pointer.From(field.Analyzer),
+			SearchAnalyzerName: pointer.From(field.SearchAnalyzer),
+			IndexAnalyzerName:  pointer.From(field.IndexAnalyzer),
+			SynonymMapNames:    pointer.From(field.SynonymMaps),
+		})
+	}
+	return result
+}
+
+func flattenSearchIndexSuggesters(input []dataplane.Suggester) []IndexSuggesterModel {
+	result := make([]IndexSuggesterModel, 0)
+	for _, suggester := range input {
+		result = append(result, IndexSuggesterModel{
+			Name:         suggester.Name,
+			SourceFields: suggester.SourceFields,
+		})
+	}
+	return result
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"testing"
+)
+
+func TestSearchIndexFieldsRequireRecreation(t *testing.T) {
+	existing := []IndexFieldModel{
+		{
+			Name:        "id",
+			Type:        "Edm.String",
+			Key:         true,
+			Retrievable: true,
+		},
+		{
+			Name:         "description",
+			Type:         "Edm.String",
+			Retrievable:  true,
+			Searchable:   true,
+			AnalyzerName: "en.microsoft",
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Updated  func(input []IndexFieldModel) []IndexFieldModel
+		Expected bool
+	}{
+		{
+			Name: "unchanged",
+			Updated: func(input []IndexFieldModel) []IndexFieldModel {
+				return input
+			},
+			Expected: false,
+		},
+		{
+			Name: "field added",
+			Updated: func(input []IndexFieldModel) []IndexFieldModel {
+				return append(input, IndexFieldModel{Name: "category", Type: "Edm.String", Filterable: true})
+			},
+			Expected: false,
+		},
+		{
+			Name: "fields reordered",
+			Updated: func(input []IndexFieldModel) []IndexFieldModel {
+				return []IndexFieldModel{input[1], input[0]}
+			},
+			Expected: false,
+		},
+		{
+			Name: "retrievable changed",
+			Updated: func(input []IndexFieldModel) []IndexFieldModel {
+				input[1].Retrievable = false
+				return input
+			},
+			Expected: false,
+		},
+		{
+			Name: "search analyzer and synonym maps changed",
+			Updated: func(input []IndexFieldModel) []IndexFieldModel {
+				input[1].SearchAnalyzerName = "standard.lucene"
+				input[1].SynonymMapNames = []string{"example"}
+				return input
+			},
+			Expected: false,
+		},
+		{
+			Name: "field removed",
+			Updated: func(input []IndexFieldModel) []IndexFieldModel {
+				return input[:1]
+			},
+			Expected: true,
+		},
+		{
+			Name: "type changed",
+			Updated: func(input []IndexFieldModel) []IndexFieldModel {
+				input[1].Type = "Collection(Edm.String)"
+				return input
+			},
+			Expected: true,
+		},
+		{
+			Name: "filterable changed",
+			Updated: func(input []IndexFieldModel) []IndexFieldModel {
+				input[1].Filterable = true
+				return input
+			},
+			Expected: true,
+		},
+		{
+			Name: "analyzer changed",
+			Updated: func(input []IndexFieldModel) []IndexFieldModel {
+				input[1].AnalyzerName = "standard.lucene"
+				return input
+			},
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		updated := v.Updated(append([]IndexFieldModel{}, existing...))
+		actual, reason := searchIndexFieldsRequireRecreation(existing, updated)
+		if actual != v.Expected {
+			t.Fatalf("expected %t but got %t (%s)", v.Expected, actual, reason)
+		}
+	}
+}
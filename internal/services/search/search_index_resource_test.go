@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchIndexResource struct{}
+
+func TestAccSearchIndex_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("allow_index_downtime"),
+	})
+}
+
+func TestAccSearchIndex_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchIndex_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("allow_index_downtime"),
+	})
+}
+
+func TestAccSearchIndex_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("allow_index_downtime"),
+		{
+			// adding fields, suggesters and CORS settings is possible in-place
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("allow_index_downtime"),
+		{
+			// removing fields requires the Index to be recreated
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("allow_index_downtime"),
+	})
+}
+
+func (r SearchIndexResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SearchIndexID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName), clients.Search.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetIndex(ctx, id.IndexName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SearchIndexResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "test" {
+  name              = "acctestindex%d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+
+  field {
+    name       = "description"
+    type       = "Edm.String"
+    searchable = true
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchIndexResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "import" {
+  name              = azurerm_search_index.test.name
+  search_service_id = azurerm_search_index.test.search_service_id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+
+  field {
+    name       = "description"
+    type       = "Edm.String"
+    searchable = true
+  }
+}
+`, r.basic(data))
+}
+
+func (r SearchIndexResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_synonym_map" "test" {
+  name              = "acctestsynonyms%d"
+  search_service_id = azurerm_search_service.test.id
+  synonyms          = "USA, United States, United States of America"
+}
+
+resource "azurerm_search_index" "test" {
+  name              = "acctestindex%d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+
+  field {
+    name              = "description"
+    type              = "Edm.String"
+    searchable        = true
+    synonym_map_names = [azurerm_search_synonym_map.test.name]
+  }
+
+  field {
+    name       = "category"
+    type       = "Edm.String"
+    filterable = true
+    facetable  = true
+    sortable   = true
+  }
+
+  field {
+    name          = "tags"
+    type          = "Collection(Edm.String)"
+    searchable    = true
+    filterable    = true
+    analyzer_name = "en.microsoft"
+  }
+
+  suggester {
+    name          = "sg"
+    source_fields = ["category"]
+  }
+
+  cors_allowed_origins    = ["https://www.example.com"]
+  cors_max_age_in_seconds = 300
+  allow_index_downtime    = true
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (SearchIndexResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-search-%d"
+  location = "%s"
+}
+
+resource "azurerm_search_service" "test" {
+  name                = "acctestsearchservice%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
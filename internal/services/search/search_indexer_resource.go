@@ -0,0 +1,450 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type IndexerResource struct{}
+
+var (
+	_ sdk.Resource           = IndexerResource{}
+	_ sdk.ResourceWithUpdate = IndexerResource{}
+)
+
+type IndexerModel struct {
+	Name                   string                     `tfschema:"name"`
+	SearchServiceId        string                     `tfschema:"search_service_id"`
+	DataSourceName         string                     `tfschema:"data_source_name"`
+	TargetIndexName        string                     `tfschema:"target_index_name"`
+	SkillsetName           string                     `tfschema:"skillset_name"`
+	Description            string                     `tfschema:"description"`
+	Enabled                bool                       `tfschema:"enabled"`
+	Schedule               []IndexerScheduleModel     `tfschema:"schedule"`
+	BatchSize              int64                      `tfschema:"batch_size"`
+	MaxFailedItems         int64                      `tfschema:"max_failed_items"`
+	MaxFailedItemsPerBatch int64                      `tfschema:"max_failed_items_per_batch"`
+	Configuration          map[string]string          `tfschema:"configuration"`
+	FieldMappings          []IndexerFieldMappingModel `tfschema:"field_mapping"`
+	OutputFieldMappings    []IndexerFieldMappingModel `tfschema:"output_field_mapping"`
+}
+
+type IndexerScheduleModel struct {
+	Interval  string `tfschema:"interval"`
+	StartTime string `tfschema:"start_time"`
+}
+
+type IndexerFieldMappingModel struct {
+	SourceFieldName     string `tfschema:"source_field_name"`
+	TargetFieldName     string `tfschema:"target_field_name"`
+	MappingFunctionName string `tfschema:"mapping_function_name"`
+}
+
+func (r IndexerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SearchObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"data_source_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.SearchObjectName,
+		},
+
+		"target_index_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.SearchObjectName,
+		},
+
+		"skillset_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.SearchObjectName,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"interval": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+
+					"start_time": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		},
+
+		"batch_size": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"max_failed_items": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(-1),
+		},
+
+		"max_failed_items_per_batch": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(-1),
+		},
+
+		"configuration": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"field_mapping": searchIndexerFieldMappingSchema(),
+
+		"output_field_mapping": searchIndexerFieldMappingSchema(),
+	}
+}
+
+func (r IndexerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r IndexerResource) ResourceType() string {
+	return "azurerm_search_indexer"
+}
+
+func (r IndexerResource) ModelObject() interface{} {
+	return &IndexerModel{}
+}
+
+func (r IndexerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SearchIndexerID
+}
+
+func (r IndexerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model IndexerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSearchIndexerID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetIndexer(ctx, id.IndexerName)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdateIndexer(ctx, expandSearchIndexer(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r IndexerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := searchDataPlaneClientForRead(ctx, metadata, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.GetIndexer(ctx, id.IndexerName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := IndexerModel{
+				Name:            id.IndexerName,
+				SearchServiceId: searchServiceId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.DataSourceName = model.DataSourceName
+				state.TargetIndexName = model.TargetIndexName
+				state.SkillsetName = pointer.From(model.SkillsetName)
+				state.Description = pointer.From(model.Description)
+				state.Enabled = !pointer.From(model.Disabled)
+				state.FieldMappings = flattenSearchIndexerFieldMappings(model.FieldMappings)
+				state.OutputFieldMappings = flattenSearchIndexerFieldMappings(model.OutputFieldMappings)
+
+				if schedule := model.Schedule; schedule != nil {
+					state.Schedule = []IndexerScheduleModel{
+						{
+							Interval:  schedule.Interval,
+							StartTime: pointer.From(schedule.StartTime),
+						},
+					}
+				}
+
+				if parameters := model.Parameters; parameters != nil {
+					state.BatchSize = pointer.From(parameters.BatchSize)
+					state.MaxFailedItems = pointer.From(parameters.MaxFailedItems)
+					state.MaxFailedItemsPerBatch = pointer.From(parameters.MaxFailedItemsPerBatch)
+
+					configuration := make(map[string]string)
+					for k, v := range parameters.Configuration {
+						configuration[k] = fmt.Sprint(v)
+					}
+					state.Configuration = configuration
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r IndexerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model IndexerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdateIndexer(ctx, expandSearchIndexer(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r IndexerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.DeleteIndexer(ctx, id.IndexerName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func searchIndexerFieldMappingSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"source_field_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"target_field_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"mapping_function_name": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"base64Decode",
+						"base64Encode",
+						"extractTokenAtPosition",
+						"jsonArrayToStringCollection",
+						"urlDecode",
+						"urlEncode",
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func expandSearchIndexer(model IndexerModel) dataplane.Indexer {
+	payload := dataplane.Indexer{
+		Name:                model.Name,
+		DataSourceName:      model.DataSourceName,
+		TargetIndexName:     model.TargetIndexName,
+		Disabled:            pointer.To(!model.Enabled),
+		FieldMappings:       expandSearchIndexerFieldMappings(model.FieldMappings),
+		OutputFieldMappings: expandSearchIndexerFieldMappings(model.OutputFieldMappings),
+	}
+
+	if model.SkillsetName != "" {
+		payload.SkillsetName = pointer.To(model.SkillsetName)
+	}
+
+	if model.Description != "" {
+		payload.Description = pointer.To(model.Description)
+	}
+
+	if len(model.Schedule) > 0 {
+		schedule := model.Schedule[0]
+		payload.Schedule = &dataplane.IndexingSchedule{
+			Interval: schedule.Interval,
+		}
+		if schedule.StartTime != "" {
+			payload.Schedule.StartTime = pointer.To(schedule.StartTime)
+		}
+	}
+
+	parameters := dataplane.IndexingParameters{}
+	if model.BatchSize != 0 {
+		parameters.BatchSize = pointer.To(model.BatchSize)
+	}
+	if model.MaxFailedItems != 0 {
+		parameters.MaxFailedItems = pointer.To(model.MaxFailedItems)
+	}
+	if model.MaxFailedItemsPerBatch != 0 {
+		parameters.MaxFailedItemsPerBatch = pointer.To(model.MaxFailedItemsPerBatch)
+	}
+	if len(model.Configuration) > 0 {
+		configuration := make(map[string]interface{})
+		for k, v := range model.Configuration {
+			configuration[k] = v
+		}
+		parameters.Configuration = configuration
+	}
+	payload.Parameters = &parameters
+
+	return payload
+}
+
+func expandSearchIndexerFieldMappings(input []IndexerFieldMappingModel) []dataplane.FieldMapping {
+	result := make([]dataplane.FieldMapping, 0)
+	for _, item := range input {
+		mapping := dataplane.FieldMapping{
+			SourceFieldName: item.SourceFieldName,
+		}
+		if item.TargetFieldName != "" {
+			mapping.TargetFieldName = pointer.To(item.TargetFieldName)
+		}
+		if item.MappingFunctionName != "" {
+			mapping.MappingFunction = &dataplane.FieldMappingFunction{
+				Name: item.MappingFunctionName,
+			}
+		}
+		result = append(result, mapping)
+	}
+	return result
+}
+
+func flattenSearchIndexerFieldMappings(input []dataplane.FieldMapping) []IndexerFieldMappingModel {
+	result := make([]IndexerFieldMappingModel, 0)
+	for _, item := range input {
+		mapping := IndexerFieldMappingModel{
+			SourceFieldName: item.SourceFieldName,
+			TargetFieldName: pointer.From(item.TargetFieldName),
+		}
+		if item.MappingFunction != nil {
+			mapping.MappingFunctionName = item.MappingFunction.Name
+		}
+		result = append(result, mapping)
+	}
+	return result
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchIndexerResource struct{}
+
+func TestAccSearchIndexer_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_indexer", "test")
+	r := SearchIndexerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchIndexer_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_indexer", "test")
+	r := SearchIndexerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchIndexer_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_indexer", "test")
+	r := SearchIndexerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SearchIndexerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SearchIndexerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName), clients.Search.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetIndexer(ctx, id.IndexerName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SearchIndexerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_indexer" "test" {
+  name              = "acctestindexer%d"
+  search_service_id = azurerm_search_service.test.id
+  data_source_name  = azurerm_search_data_source.test.name
+  target_index_name = azurerm_search_index.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchIndexerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_indexer" "import" {
+  name              = azurerm_search_indexer.test.name
+  search_service_id = azurerm_search_indexer.test.search_service_id
+  data_source_name  = azurerm_search_indexer.test.data_source_name
+  target_index_name = azurerm_search_indexer.test.target_index_name
+}
+`, r.basic(data))
+}
+
+func (r SearchIndexerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_indexer" "test" {
+  name              = "acctestindexer%d"
+  search_service_id = azurerm_search_service.test.id
+  data_source_name  = azurerm_search_data_source.test.name
+  target_index_name = azurerm_search_index.test.name
+  description       = "Indexes the documents container"
+  enabled           = false
+
+  schedule {
+    interval   = "PT2H"
+    start_time = "2030-01-01T00:00:00Z"
+  }
+
+  batch_size                 = 10
+  max_failed_items           = 5
+  max_failed_items_per_batch = 2
+
+  configuration = {
+    parsingMode   = "default"
+    dataToExtract = "contentAndMetadata"
+  }
+
+  field_mapping {
+    source_field_name     = "metadata_storage_path"
+    target_field_name     = "id"
+    mapping_function_name = "base64Encode"
+  }
+
+  field_mapping {
+    source_field_name = "content"
+    target_field_name = "description"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (SearchIndexerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "test" {
+  name              = "acctestdatasource%[2]d"
+  search_service_id = azurerm_search_service.test.id
+  type              = "azureblob"
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+}
+
+resource "azurerm_search_index" "test" {
+  name              = "acctestindex%[2]d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+
+  field {
+    name       = "description"
+    type       = "Edm.String"
+    searchable = true
+  }
+}
+`, SearchDataSourceResource{}.template(data), data.RandomInteger)
+}
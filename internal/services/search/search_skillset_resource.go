@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SkillsetResource struct{}
+
+var (
+	_ sdk.Resource           = SkillsetResource{}
+	_ sdk.ResourceWithUpdate = SkillsetResource{}
+)
+
+type SkillsetModel struct {
+	Name                  string `tfschema:"name"`
+	SearchServiceId       string `tfschema:"search_service_id"`
+	SkillsJson            string `tfschema:"skills_json"`
+	CognitiveServicesJson string `tfschema:"cognitive_services_json"`
+	Description           string `tfschema:"description"`
+}
+
+func (r SkillsetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SearchObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		// skills are polymorphic and each type of skill has its own set of properties, as such these are specified as JSON
+		"skills_json": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"cognitive_services_json": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r SkillsetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SkillsetResource) ResourceType() string {
+	return "azurerm_search_skillset"
+}
+
+func (r SkillsetResource) ModelObject() interface{} {
+	return &SkillsetModel{}
+}
+
+func (r SkillsetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SearchSkillsetID
+}
+
+func (r SkillsetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SkillsetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSearchSkillsetID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetSkillset(ctx, id.SkillsetName)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandSearchSkillset(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdateSkillset(ctx, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SkillsetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchSkillsetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := searchDataPlaneClientForRead(ctx, metadata, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.GetSkillset(ctx, id.SkillsetName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			var config SkillsetModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := SkillsetModel{
+				Name:            id.SkillsetName,
+				SearchServiceId: searchServiceId.ID(),
+				// the key used for the Cognitive Services account isn't returned by the API
+				CognitiveServicesJson: config.CognitiveServicesJson,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Description = pointer.From(model.Description)
+
+				skills, err := json.Marshal(model.Skills)
+				if err != nil {
+					return fmt.Errorf("marshaling `skills_json`: %+v", err)
+				}
+				state.SkillsJson = string(skills)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SkillsetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchSkillsetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SkillsetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			payload, err := expandSearchSkillset(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdateSkillset(ctx, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SkillsetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchSkillsetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.DeleteSkillset(ctx, id.SkillsetName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSearchSkillset(model SkillsetModel) (*dataplane.Skillset, error) {
+	payload := dataplane.Skillset{
+		Name: model.Name,
+	}
+
+	if err := json.Unmarshal([]byte(model.SkillsJson), &payload.Skills); err != nil {
+		return nil, fmt.Errorf("`skills_json` must be a JSON array of skills: %+v", err)
+	}
+
+	if model.CognitiveServicesJson != "" {
+		var cognitiveServices map[string]interface{}
+		if err := json.Unmarshal([]byte(model.CognitiveServicesJson), &cognitiveServices); err != nil {
+			return nil, fmt.Errorf("`cognitive_services_json` must be a JSON object: %+v", err)
+		}
+		payload.CognitiveServices = cognitiveServices
+	}
+
+	if model.Description != "" {
+		payload.Description = pointer.To(model.Description)
+	}
+
+	return &payload, nil
+}
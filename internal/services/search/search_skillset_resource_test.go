@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchSkillsetResource struct{}
+
+func TestAccSearchSkillset_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_skillset", "test")
+	r := SearchSkillsetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchSkillset_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_skillset", "test")
+	r := SearchSkillsetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchSkillset_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_skillset", "test")
+	r := SearchSkillsetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("cognitive_services_json"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SearchSkillsetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SearchSkillsetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName), clients.Search.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSkillset(ctx, id.SkillsetName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SearchSkillsetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_skillset" "test" {
+  name              = "acctestskillset%d"
+  search_service_id = azurerm_search_service.test.id
+
+  skills_json = jsonencode([
+    {
+      "@odata.type" = "#Microsoft.Skills.Text.SplitSkill"
+      context       = "/document"
+      textSplitMode = "pages"
+      inputs = [
+        {
+          name   = "text"
+          source = "/document/content"
+        }
+      ]
+      outputs = [
+        {
+          name       = "textItems"
+          targetName = "pages"
+        }
+      ]
+    }
+  ])
+}
+`, SearchIndexResource{}.template(data), data.RandomInteger)
+}
+
+func (r SearchSkillsetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_skillset" "import" {
+  name              = azurerm_search_skillset.test.name
+  search_service_id = azurerm_search_skillset.test.search_service_id
+  skills_json       = azurerm_search_skillset.test.skills_json
+}
+`, r.basic(data))
+}
+
+func (r SearchSkillsetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account" "test" {
+  name                = "acctestcogacc-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  kind                = "CognitiveServices"
+  sku_name            = "S0"
+}
+
+resource "azurerm_search_skillset" "test" {
+  name              = "acctestskillset%d"
+  search_service_id = azurerm_search_service.test.id
+  description       = "Splits and translates documents"
+
+  skills_json = jsonencode([
+    {
+      "@odata.type" = "#Microsoft.Skills.Text.SplitSkill"
+      context       = "/document"
+      textSplitMode = "pages"
+      inputs = [
+        {
+          name   = "text"
+          source = "/document/content"
+        }
+      ]
+      outputs = [
+        {
+          name       = "textItems"
+          targetName = "pages"
+        }
+      ]
+    },
+    {
+      "@odata.type"         = "#Microsoft.Skills.Text.TranslationSkill"
+      context               = "/document/pages/*"
+      defaultToLanguageCode = "fr"
+      inputs = [
+        {
+          name   = "text"
+          source = "/document/pages/*"
+        }
+      ]
+      outputs = [
+        {
+          name       = "translatedText"
+          targetName = "translated"
+        }
+      ]
+    }
+  ])
+
+  cognitive_services_json = jsonencode({
+    "@odata.type" = "#Microsoft.Azure.Search.CognitiveServicesByKey"
+    key           = azurerm_cognitive_account.test.primary_access_key
+  })
+}
+`, SearchIndexResource{}.template(data), data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SynonymMapResource struct{}
+
+var (
+	_ sdk.Resource           = SynonymMapResource{}
+	_ sdk.ResourceWithUpdate = SynonymMapResource{}
+)
+
+type SynonymMapModel struct {
+	Name            string `tfschema:"name"`
+	SearchServiceId string `tfschema:"search_service_id"`
+	Synonyms        string `tfschema:"synonyms"`
+}
+
+func (r SynonymMapResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SearchObjectName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"synonyms": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r SynonymMapResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SynonymMapResource) ResourceType() string {
+	return "azurerm_search_synonym_map"
+}
+
+func (r SynonymMapResource) ModelObject() interface{} {
+	return &SynonymMapModel{}
+}
+
+func (r SynonymMapResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SearchSynonymMapID
+}
+
+func (r SynonymMapResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SynonymMapModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSearchSynonymMapID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetSynonymMap(ctx, id.SynonymMapName)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := dataplane.SynonymMap{
+				Name:     model.Name,
+				Format:   dataplane.SynonymMapFormatSolr,
+				Synonyms: model.Synonyms,
+			}
+
+			if _, err := client.CreateOrUpdateSynonymMap(ctx, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SynonymMapResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchSynonymMapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := searchDataPlaneClientForRead(ctx, metadata, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.GetSynonymMap(ctx, id.SynonymMapName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := SynonymMapModel{
+				Name:            id.SynonymMapName,
+				SearchServiceId: searchServiceId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Synonyms = model.Synonyms
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SynonymMapResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchSynonymMapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SynonymMapModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			payload := dataplane.SynonymMap{
+				Name:     id.SynonymMapName,
+				Format:   dataplane.SynonymMapFormatSolr,
+				Synonyms: model.Synonyms,
+			}
+
+			if _, err := client.CreateOrUpdateSynonymMap(ctx, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SynonymMapResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchSynonymMapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, searchServiceId, metadata.Client.Search.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.DeleteSynonymMap(ctx, id.SynonymMapName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
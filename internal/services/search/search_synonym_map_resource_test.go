@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchSynonymMapResource struct{}
+
+func TestAccSearchSynonymMap_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_synonym_map", "test")
+	r := SearchSynonymMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "USA, United States, United States of America"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchSynonymMap_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_synonym_map", "test")
+	r := SearchSynonymMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "USA, United States, United States of America"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchSynonymMap_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_synonym_map", "test")
+	r := SearchSynonymMapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "USA, United States, United States of America"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "USA, United States, United States of America\nWashington, Wash. => WA"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SearchSynonymMapResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SearchSynonymMapID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName), clients.Search.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSynonymMap(ctx, id.SynonymMapName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SearchSynonymMapResource) basic(data acceptance.TestData, synonyms string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_synonym_map" "test" {
+  name              = "acctestsynonyms%d"
+  search_service_id = azurerm_search_service.test.id
+  synonyms          = %q
+}
+`, SearchIndexResource{}.template(data), data.RandomInteger, synonyms)
+}
+
+func (r SearchSynonymMapResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_synonym_map" "import" {
+  name              = azurerm_search_synonym_map.test.name
+  search_service_id = azurerm_search_synonym_map.test.search_service_id
+  synonyms          = azurerm_search_synonym_map.test.synonyms
+}
+`, r.basic(data, "USA, United States, United States of America"))
+}
@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// SearchObjectName validates the name of a Data Source, Index, Indexer, Skillset or Synonym Map within a Search Service
+func SearchObjectName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if matched := regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,126}[a-z0-9])?$`).MatchString(value); !matched {
+		errors = append(errors, fmt.Errorf("%q may only contain lowercase letters, numbers and dashes, must start and end with a letter or number and must be between 1-128 chars", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSearchObjectName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hotels-index",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hotels2",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-hotels",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hotels-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Hotels",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hotels_index",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 128),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 129),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := SearchObjectName(tc.Value, "name")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Search Object Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
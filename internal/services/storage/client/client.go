@@ -39,6 +39,7 @@ type Client struct {
 	FileServicesClient *storage.FileServicesClient
 
 	authConfigForAzureAD *auth.Credentials
+	readOnly             bool
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -110,6 +111,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		SyncGroupsClient:           syncGroupsClient,
 
 		StorageDomainSuffix: *storageSuffix,
+
+		readOnly: o.ReadOnly,
 	}
 
 	if o.StorageUseAzureAD {
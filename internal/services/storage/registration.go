@@ -4,18 +4,31 @@
 package storage
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Registration struct{}
 
-var _ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+var (
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel       = Registration{}
+	_ sdk.UntypedServiceRegistrationWithEphemeralResources = Registration{}
+)
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/storage"
 }
 
+// EphemeralResources returns a list of Ephemeral Resources supported by this Service
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewStorageAccountBlobContainerSasEphemeralResource,
+		NewStorageAccountBlobSasEphemeralResource,
+		NewStorageAccountSasEphemeralResource,
+	}
+}
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "Storage"
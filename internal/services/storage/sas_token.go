@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+
+	"github.com/hashicorp/go-azure-helpers/storage"
+)
+
+// blobSasSignedVersion is the version of the Service SAS used for Blob SAS Tokens, which matches the
+// version used by `storage.ComputeContainerSASToken` for Container SAS Tokens
+const blobSasSignedVersion = "2018-11-09"
+
+// sasResponseHeaders are the (optional) Response Headers which can be overridden using a Service SAS
+type sasResponseHeaders struct {
+	CacheControl       string
+	ContentDisposition string
+	ContentEncoding    string
+	ContentLanguage    string
+	ContentType        string
+}
+
+func sasSignedProtocol(httpsOnly bool) string {
+	if httpsOnly {
+		return "https"
+	}
+	return "https,http"
+}
+
+// computeAccountSasToken computes an Account SAS Token using the Access Key within the Connection String:
+// https://docs.microsoft.com/en-us/rest/api/storageservices/Constructing-an-Account-SAS
+func computeAccountSasToken(connString string, httpsOnly bool, ipAddresses, signedVersion, resourceTypes, services, permissions, start, expiry string) (string, error) {
+	kvp, err := storage.ParseAccountSASConnectionString(connString)
+	if err != nil {
+		return "", err
+	}
+
+	accountName := kvp[connStringAccountNameKey]
+	accountKey := kvp[connStringAccountKeyKey]
+
+	// TODO: implement support for signedEncryptionScope
+	signedEncryptionScope := ""
+
+	return storage.ComputeAccountSASToken(accountName, accountKey, permissions, services, resourceTypes,
+		start, expiry, sasSignedProtocol(httpsOnly), ipAddresses, signedVersion, signedEncryptionScope)
+}
+
+// computeContainerSasToken computes a Service SAS Token for a Blob Container using the Access Key within the Connection String
+func computeContainerSasToken(connString, containerName string, httpsOnly bool, ip, permissions, start, expiry string, headers sasResponseHeaders) (string, error) {
+	kvp, err := storage.ParseAccountSASConnectionString(connString)
+	if err != nil {
+		return "", err
+	}
+
+	accountName := kvp[connStringAccountNameKey]
+	accountKey := kvp[connStringAccountKeyKey]
+	signedIdentifier := ""
+	signedSnapshotTime := ""
+
+	return storage.ComputeContainerSASToken(permissions, start, expiry, accountName, accountKey,
+		containerName, signedIdentifier, ip, sasSignedProtocol(httpsOnly), signedSnapshotTime, headers.CacheControl,
+		headers.ContentDisposition, headers.ContentEncoding, headers.ContentLanguage, headers.ContentType)
+}
+
+// computeBlobSasToken computes a Service SAS Token for a Blob using the Access Key within the Connection String. This
+// mirrors `storage.ComputeContainerSASToken`, with the Signed Resource and Canonicalized Resource scoped to the Blob:
+// https://docs.microsoft.com/en-us/rest/api/storageservices/create-service-sas
+func computeBlobSasToken(connString, containerName, blobName string, httpsOnly bool, ip, permissions, start, expiry string, headers sasResponseHeaders) (string, error) {
+	kvp, err := storage.ParseAccountSASConnectionString(connString)
+	if err != nil {
+		return "", err
+	}
+
+	accountName := kvp[connStringAccountNameKey]
+	accountKey := kvp[connStringAccountKeyKey]
+	signedProtocol := sasSignedProtocol(httpsOnly)
+
+	canonicalizedResource := "/blob/" + accountName + "/" + containerName + "/" + blobName
+	signedResource := "b" // b for blob
+
+	stringToSign := permissions + "\n"
+	stringToSign += start + "\n"
+	stringToSign += expiry + "\n"
+	stringToSign += canonicalizedResource + "\n"
+	stringToSign += "\n" // signedIdentifier
+	stringToSign += ip + "\n"
+	stringToSign += signedProtocol + "\n"
+	stringToSign += blobSasSignedVersion + "\n"
+	stringToSign += signedResource + "\n"
+	stringToSign += "\n" // signedSnapshotTime
+	stringToSign += headers.CacheControl + "\n"
+	stringToSign += headers.ContentDisposition + "\n"
+	stringToSign += headers.ContentEncoding + "\n"
+	stringToSign += headers.ContentLanguage + "\n"
+	stringToSign += headers.ContentType
+
+	binaryKey, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return "", err
+	}
+	hasher := hmac.New(sha256.New, binaryKey)
+	hasher.Write([]byte(stringToSign))
+	signature := hasher.Sum(nil)
+
+	sasToken := "?sv=" + blobSasSignedVersion
+	sasToken += "&sr=" + signedResource
+	sasToken += "&st=" + start
+	sasToken += "&se=" + expiry
+	sasToken += "&sp=" + permissions
+
+	if ip != "" {
+		sasToken += "&sip=" + ip
+	}
+
+	sasToken += "&spr=" + signedProtocol
+
+	if headers.CacheControl != "" {
+		sasToken += "&rscc=" + url.QueryEscape(headers.CacheControl)
+	}
+	if headers.ContentDisposition != "" {
+		sasToken += "&rscd=" + url.QueryEscape(headers.ContentDisposition)
+	}
+	if headers.ContentEncoding != "" {
+		sasToken += "&rsce=" + url.QueryEscape(headers.ContentEncoding)
+	}
+	if headers.ContentLanguage != "" {
+		sasToken += "&rscl=" + url.QueryEscape(headers.ContentLanguage)
+	}
+	if headers.ContentType != "" {
+		sasToken += "&rsct=" + url.QueryEscape(headers.ContentType)
+	}
+
+	sasToken += "&sig=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature))
+
+	return sasToken, nil
+}
@@ -8,7 +8,6 @@ import (
 	"encoding/hex"
 	"time"
 
-	"github.com/hashicorp/go-azure-helpers/storage"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -152,26 +151,14 @@ func dataSourceStorageContainerSasRead(d *pluginsdk.ResourceData, _ interface{})
 
 	permissions := BuildContainerPermissionsString(permissionsIface[0].(map[string]interface{}))
 
-	// Parse the connection string
-	kvp, err := storage.ParseAccountSASConnectionString(connString)
-	if err != nil {
-		return err
+	headers := sasResponseHeaders{
+		CacheControl:       cacheControl,
+		ContentDisposition: contentDisposition,
+		ContentEncoding:    contentEncoding,
+		ContentLanguage:    contentLanguage,
+		ContentType:        contentType,
 	}
-
-	// Create the string to sign with the key...
-	accountName := kvp[connStringAccountNameKey]
-	accountKey := kvp[connStringAccountKeyKey]
-	signedProtocol := "https,http"
-	if httpsOnly {
-		signedProtocol = "https"
-	}
-	signedIp := ip
-	signedIdentifier := ""
-	signedSnapshotTime := ""
-
-	sasToken, err := storage.ComputeContainerSASToken(permissions, start, expiry, accountName, accountKey,
-		containerName, signedIdentifier, signedIp, signedProtocol, signedSnapshotTime, cacheControl,
-		contentDisposition, contentEncoding, contentLanguage, contentType)
+	sasToken, err := computeContainerSasToken(connString, containerName, httpsOnly, ip, permissions, start, expiry, headers)
 	if err != nil {
 		return err
 	}
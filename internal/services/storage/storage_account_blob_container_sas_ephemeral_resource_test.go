@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageAccountBlobContainerSasEphemeralResource struct{}
+
+func TestAccEphemeralStorageAccountBlobContainerSas_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_storage_account_blob_container_sas", "test")
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	data.EphemeralResourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageAccountBlobContainerSasEphemeralResource{}.basic(data, startDate, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("echo.test").Key("data.ip_address").HasValue("168.1.5.65"),
+				check.That("echo.test").Key("data.permissions.read").HasValue("true"),
+				check.That("echo.test").Key("data.permissions.write").HasValue("false"),
+				check.That("echo.test").Key("data.content_type").HasValue("application/json"),
+				check.That("echo.test").Key("data.sas").Exists(),
+			),
+		},
+	})
+}
+
+func (StorageAccountBlobContainerSasEphemeralResource) basic(data acceptance.TestData, startDate string, endDate string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestsaes%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "sas-test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+ephemeral "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  https_only        = true
+
+  ip_address = "168.1.5.65"
+
+  start  = "%s"
+  expiry = "%s"
+
+  permissions {
+    read   = true
+    add    = true
+    create = false
+    write  = false
+    delete = true
+    list   = true
+  }
+
+  cache_control       = "max-age=5"
+  content_disposition = "inline"
+  content_encoding    = "deflate"
+  content_language    = "en-US"
+  content_type        = "application/json"
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_storage_account_blob_container_sas.test
+}
+
+resource "echo" "test" {}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, startDate, endDate)
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageAccountBlobSasEphemeralResource struct{}
+
+var _ ephemeral.EphemeralResource = &StorageAccountBlobSasEphemeralResource{}
+
+func NewStorageAccountBlobSasEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountBlobSasEphemeralResource{}
+}
+
+type StorageAccountBlobSasEphemeralResourceModel struct {
+	ConnectionString   types.String                           `tfsdk:"connection_string"`
+	ContainerName      types.String                           `tfsdk:"container_name"`
+	BlobName           types.String                           `tfsdk:"blob_name"`
+	HttpsOnly          types.Bool                             `tfsdk:"https_only"`
+	IPAddress          types.String                           `tfsdk:"ip_address"`
+	Start              types.String                           `tfsdk:"start"`
+	Expiry             types.String                           `tfsdk:"expiry"`
+	Permissions        *StorageAccountBlobSasPermissionsModel `tfsdk:"permissions"`
+	CacheControl       types.String                           `tfsdk:"cache_control"`
+	ContentDisposition types.String                           `tfsdk:"content_disposition"`
+	ContentEncoding    types.String                           `tfsdk:"content_encoding"`
+	ContentLanguage    types.String                           `tfsdk:"content_language"`
+	ContentType        types.String                           `tfsdk:"content_type"`
+	Sas                types.String                           `tfsdk:"sas"`
+}
+
+type StorageAccountBlobSasPermissionsModel struct {
+	Read   types.Bool `tfsdk:"read"`
+	Add    types.Bool `tfsdk:"add"`
+	Create types.Bool `tfsdk:"create"`
+	Write  types.Bool `tfsdk:"write"`
+	Delete types.Bool `tfsdk:"delete"`
+}
+
+func (r *StorageAccountBlobSasEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_account_blob_sas"
+}
+
+func (r *StorageAccountBlobSasEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connection_string": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					sdk.StringValidator(validation.StringIsNotEmpty),
+				},
+			},
+
+			"container_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sdk.StringValidator(validation.StringIsNotEmpty),
+				},
+			},
+
+			"blob_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sdk.StringValidator(validation.StringIsNotEmpty),
+				},
+			},
+
+			// defaults to `true` when omitted
+			"https_only": schema.BoolAttribute{
+				Optional: true,
+			},
+
+			"ip_address": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					sdk.StringValidator(storageValidate.SharedAccessSignatureIP),
+				},
+			},
+
+			"start": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sdk.StringValidator(validate.ISO8601DateTime),
+				},
+			},
+
+			"expiry": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sdk.StringValidator(validate.ISO8601DateTime),
+				},
+			},
+
+			"cache_control": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"content_disposition": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"content_encoding": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"content_language": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"content_type": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"sas": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"permissions": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"read": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"add": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"create": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"write": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"delete": schema.BoolAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *StorageAccountBlobSasEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data StorageAccountBlobSasEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the block can't be marked as Required within the schema, so is checked here
+	if data.Permissions == nil {
+		resp.Diagnostics.AddError("Missing Configuration Block", "the `permissions` block must be specified")
+		return
+	}
+
+	httpsOnly := true
+	if !data.HttpsOnly.IsNull() {
+		httpsOnly = data.HttpsOnly.ValueBool()
+	}
+
+	permissions := BuildContainerPermissionsString(map[string]interface{}{
+		"read":   data.Permissions.Read.ValueBool(),
+		"add":    data.Permissions.Add.ValueBool(),
+		"create": data.Permissions.Create.ValueBool(),
+		"write":  data.Permissions.Write.ValueBool(),
+		"delete": data.Permissions.Delete.ValueBool(),
+	})
+	headers := sasResponseHeaders{
+		CacheControl:       data.CacheControl.ValueString(),
+		ContentDisposition: data.ContentDisposition.ValueString(),
+		ContentEncoding:    data.ContentEncoding.ValueString(),
+		ContentLanguage:    data.ContentLanguage.ValueString(),
+		ContentType:        data.ContentType.ValueString(),
+	}
+
+	// the permissions for a Blob are a subset of those for a Blob Container, in the same order
+	sasToken, err := computeBlobSasToken(data.ConnectionString.ValueString(), data.ContainerName.ValueString(), data.BlobName.ValueString(), httpsOnly, data.IPAddress.ValueString(), permissions, data.Start.ValueString(), data.Expiry.ValueString(), headers)
+	if err != nil {
+		resp.Diagnostics.AddError("Computing Blob SAS Token", err.Error())
+		return
+	}
+
+	data.Sas = types.StringValue(sasToken)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
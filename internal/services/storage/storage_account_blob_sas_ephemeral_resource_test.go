@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageAccountBlobSasEphemeralResource struct{}
+
+func TestAccEphemeralStorageAccountBlobSas_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_storage_account_blob_sas", "test")
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	data.EphemeralResourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageAccountBlobSasEphemeralResource{}.basic(data, startDate, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("echo.test").Key("data.blob_name").HasValue("example.txt"),
+				check.That("echo.test").Key("data.permissions.read").HasValue("true"),
+				check.That("echo.test").Key("data.permissions.delete").HasValue("false"),
+				check.That("echo.test").Key("data.sas").Exists(),
+			),
+		},
+	})
+}
+
+func (StorageAccountBlobSasEphemeralResource) basic(data acceptance.TestData, startDate string, endDate string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestsaes%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "sas-test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "Hello World"
+}
+
+ephemeral "azurerm_storage_account_blob_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  blob_name         = azurerm_storage_blob.test.name
+
+  start  = "%s"
+  expiry = "%s"
+
+  permissions {
+    read   = true
+    add    = false
+    create = false
+    write  = false
+    delete = false
+  }
+
+  content_type = "text/plain"
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_storage_account_blob_sas.test
+}
+
+resource "echo" "test" {}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, startDate, endDate)
+}
@@ -8,7 +8,6 @@ import (
 	"encoding/hex"
 	"time"
 
-	"github.com/hashicorp/go-azure-helpers/storage"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -208,28 +207,9 @@ func dataSourceStorageAccountSasRead(d *pluginsdk.ResourceData, _ interface{}) e
 	services := BuildServicesString(servicesIface[0].(map[string]interface{}))
 	permissions := BuildPermissionsString(permissionsIface[0].(map[string]interface{}))
 
-	// Parse the connection string
-	kvp, err := storage.ParseAccountSASConnectionString(connString)
-	if err != nil {
-		return err
-	}
-
-	// Create the string to sign with the key...
-
 	// Details on how to do this are here:
 	// https://docs.microsoft.com/en-us/rest/api/storageservices/Constructing-an-Account-SAS
-	accountName := kvp[connStringAccountNameKey]
-	accountKey := kvp[connStringAccountKeyKey]
-	signedProtocol := "https,http"
-	if httpsOnly {
-		signedProtocol = "https"
-	}
-
-	// TODO: implement support for signedEncryptionScope
-	signedEncryptionScope := ""
-
-	sasToken, err := storage.ComputeAccountSASToken(accountName, accountKey, permissions, services, resourceTypes,
-		start, expiry, signedProtocol, ipAddresses, signedVersion, signedEncryptionScope)
+	sasToken, err := computeAccountSasToken(connString, httpsOnly, ipAddresses, signedVersion, resourceTypes, services, permissions, start, expiry)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// StorageAccountSasEphemeralResource generates an ACCOUNT SAS : https://docs.microsoft.com/en-us/rest/api/storageservices/Constructing-an-Account-SAS
+// without persisting the token in the plan or state
+type StorageAccountSasEphemeralResource struct{}
+
+var _ ephemeral.EphemeralResource = &StorageAccountSasEphemeralResource{}
+
+func NewStorageAccountSasEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountSasEphemeralResource{}
+}
+
+type StorageAccountSasEphemeralResourceModel struct {
+	ConnectionString types.String                         `tfsdk:"connection_string"`
+	HttpsOnly        types.Bool                           `tfsdk:"https_only"`
+	IPAddresses      types.String                         `tfsdk:"ip_addresses"`
+	SignedVersion    types.String                         `tfsdk:"signed_version"`
+	ResourceTypes    *StorageAccountSasResourceTypesModel `tfsdk:"resource_types"`
+	Services         *StorageAccountSasServicesModel      `tfsdk:"services"`
+	Start            types.String                         `tfsdk:"start"`
+	Expiry           types.String                         `tfsdk:"expiry"`
+	Permissions      *StorageAccountSasPermissionsModel   `tfsdk:"permissions"`
+	Sas              types.String                         `tfsdk:"sas"`
+}
+
+type StorageAccountSasResourceTypesModel struct {
+	Service   types.Bool `tfsdk:"service"`
+	Container types.Bool `tfsdk:"container"`
+	Object    types.Bool `tfsdk:"object"`
+}
+
+type StorageAccountSasServicesModel struct {
+	Blob  types.Bool `tfsdk:"blob"`
+	Queue types.Bool `tfsdk:"queue"`
+	Table types.Bool `tfsdk:"table"`
+	File  types.Bool `tfsdk:"file"`
+}
+
+type StorageAccountSasPermissionsModel struct {
+	Read    types.Bool `tfsdk:"read"`
+	Write   types.Bool `tfsdk:"write"`
+	Delete  types.Bool `tfsdk:"delete"`
+	List    types.Bool `tfsdk:"list"`
+	Add     types.Bool `tfsdk:"add"`
+	Create  types.Bool `tfsdk:"create"`
+	Update  types.Bool `tfsdk:"update"`
+	Process types.Bool `tfsdk:"process"`
+	Tag     types.Bool `tfsdk:"tag"`
+	Filter  types.Bool `tfsdk:"filter"`
+}
+
+func (r *StorageAccountSasEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_account_sas"
+}
+
+func (r *StorageAccountSasEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connection_string": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+
+			// defaults to `true` when omitted
+			"https_only": schema.BoolAttribute{
+				Optional: true,
+			},
+
+			"ip_addresses": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					sdk.StringValidator(validation.Any(
+						validation.IsIPv4Address,
+						validation.IsIPv4Range,
+					)),
+				},
+			},
+
+			// defaults to `2017-07-29` when omitted
+			"signed_version": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Always in UTC and must be ISO-8601 format
+			"start": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sdk.StringValidator(validate.ISO8601DateTime),
+				},
+			},
+
+			// Always in UTC and must be ISO-8601 format
+			"expiry": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					sdk.StringValidator(validate.ISO8601DateTime),
+				},
+			},
+
+			"sas": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"resource_types": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"service": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"container": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"object": schema.BoolAttribute{
+						Required: true,
+					},
+				},
+			},
+
+			"services": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"blob": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"queue": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"table": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"file": schema.BoolAttribute{
+						Required: true,
+					},
+				},
+			},
+
+			"permissions": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"read": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"write": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"delete": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"list": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"add": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"create": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"update": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"process": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"tag": schema.BoolAttribute{
+						Required: true,
+					},
+
+					"filter": schema.BoolAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *StorageAccountSasEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data StorageAccountSasEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the blocks can't be marked as Required within the schema, so are checked here
+	if data.ResourceTypes == nil || data.Services == nil || data.Permissions == nil {
+		resp.Diagnostics.AddError("Missing Configuration Block", "the `resource_types`, `services` and `permissions` blocks must be specified")
+		return
+	}
+
+	httpsOnly := true
+	if !data.HttpsOnly.IsNull() {
+		httpsOnly = data.HttpsOnly.ValueBool()
+	}
+	signedVersion := sasSignedVersion
+	if !data.SignedVersion.IsNull() {
+		signedVersion = data.SignedVersion.ValueString()
+	}
+
+	resourceTypes := BuildResourceTypesString(map[string]interface{}{
+		"service":   data.ResourceTypes.Service.ValueBool(),
+		"container": data.ResourceTypes.Container.ValueBool(),
+		"object":    data.ResourceTypes.Object.ValueBool(),
+	})
+	services := BuildServicesString(map[string]interface{}{
+		"blob":  data.Services.Blob.ValueBool(),
+		"queue": data.Services.Queue.ValueBool(),
+		"table": data.Services.Table.ValueBool(),
+		"file":  data.Services.File.ValueBool(),
+	})
+	permissions := BuildPermissionsString(map[string]interface{}{
+		"read":    data.Permissions.Read.ValueBool(),
+		"write":   data.Permissions.Write.ValueBool(),
+		"delete":  data.Permissions.Delete.ValueBool(),
+		"list":    data.Permissions.List.ValueBool(),
+		"add":     data.Permissions.Add.ValueBool(),
+		"create":  data.Permissions.Create.ValueBool(),
+		"update":  data.Permissions.Update.ValueBool(),
+		"process": data.Permissions.Process.ValueBool(),
+		"tag":     data.Permissions.Tag.ValueBool(),
+		"filter":  data.Permissions.Filter.ValueBool(),
+	})
+
+	sasToken, err := computeAccountSasToken(data.ConnectionString.ValueString(), httpsOnly, data.IPAddresses.ValueString(), signedVersion, resourceTypes, services, permissions, data.Start.ValueString(), data.Expiry.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Computing Account SAS Token", err.Error())
+		return
+	}
+
+	data.Sas = types.StringValue(sasToken)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageAccountSasEphemeralResource struct{}
+
+func TestAccEphemeralStorageAccountSas_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_storage_account_sas", "test")
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	data.EphemeralResourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageAccountSasEphemeralResource{}.basic(data, startDate, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("echo.test").Key("data.https_only").HasValue("true"),
+				check.That("echo.test").Key("data.signed_version").HasValue("2019-10-10"),
+				check.That("echo.test").Key("data.start").HasValue(startDate),
+				check.That("echo.test").Key("data.expiry").HasValue(endDate),
+				check.That("echo.test").Key("data.sas").Exists(),
+			),
+		},
+	})
+}
+
+func (StorageAccountSasEphemeralResource) basic(data acceptance.TestData, startDate string, endDate string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "acctestsaes%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+ephemeral "azurerm_storage_account_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  https_only        = true
+  signed_version    = "2019-10-10"
+
+  resource_types {
+    service   = true
+    container = false
+    object    = false
+  }
+
+  services {
+    blob  = true
+    queue = false
+    table = false
+    file  = false
+  }
+
+  start  = "%s"
+  expiry = "%s"
+
+  permissions {
+    read    = true
+    write   = true
+    delete  = false
+    list    = false
+    add     = true
+    create  = true
+    update  = false
+    process = false
+    tag     = false
+    filter  = false
+  }
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_storage_account_sas.test
+}
+
+resource "echo" "test" {}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, startDate, endDate)
+}
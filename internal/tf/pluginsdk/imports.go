@@ -6,6 +6,7 @@ package pluginsdk
 import (
 	"context"
 	"log"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -14,6 +15,10 @@ type IDValidationFunc func(id string) error
 
 type ImporterFunc = func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error)
 
+// importerIDValidationFuncs is a map of the Importers built using ImporterValidatingResourceIdThen to the
+// IDValidationFunc used by each, so that the ID can be validated without running the Importer
+var importerIDValidationFuncs sync.Map
+
 // ImporterValidatingResourceId validates the ID provided at import time is valid
 // using the validateFunc.
 func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.ResourceImporter {
@@ -26,7 +31,7 @@ func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.Resourc
 // ImporterValidatingResourceIdThen validates the ID provided at import time is valid
 // using the validateFunc then runs the 'thenFunc', allowing the import to be customised.
 func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc ImporterFunc) *schema.ResourceImporter {
-	return &schema.ResourceImporter{
+	importer := &schema.ResourceImporter{
 		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
 			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
 
@@ -44,4 +49,18 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 			return thenFunc(ctx, d, meta)
 		},
 	}
+	importerIDValidationFuncs.Store(importer, validateFunc)
+
+	return importer
+}
+
+// IDValidationFuncForImporter returns the IDValidationFunc used by an Importer built using ImporterValidatingResourceId
+// or ImporterValidatingResourceIdThen, or false if the Importer was built in another way
+func IDValidationFuncForImporter(importer *schema.ResourceImporter) (IDValidationFunc, bool) {
+	v, ok := importerIDValidationFuncs.Load(importer)
+	if !ok {
+		return nil, false
+	}
+
+	return v.(IDValidationFunc), true
 }
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// Write-Only arguments (available in Terraform 1.11 and later) are sent to the provider during Create/Update but are
+// never persisted into the Plan or State - meaning that they can be sourced from an Ephemeral Resource.
+//
+// Since Terraform only sends a Write-Only argument to the provider (and doesn't track changes to it), each Write-Only
+// argument `{name}_wo` is paired with an (Optional) `{name}_wo_version` argument, which should be incremented to
+// trigger an update to the value:
+//
+//	"administrator_login_password_wo": {
+//		Type:          pluginsdk.TypeString,
+//		Optional:      true,
+//		WriteOnly:     true,
+//		Sensitive:     true,
+//		ConflictsWith: []string{"administrator_login_password"},
+//	},
+//
+//	"administrator_login_password_wo_version": {
+//		Type:         pluginsdk.TypeInt,
+//		Optional:     true,
+//		RequiredWith: []string{"administrator_login_password_wo"},
+//	},
+//
+// Within Create the value should be retrieved using GetWriteOnlyString, and within Update the value should only be
+// sent when HasWriteOnlyChange returns true.
+
+// WriteOnlyVersionSuffix is the suffix of the argument used to trigger an update to a Write-Only argument
+const WriteOnlyVersionSuffix = "_version"
+
+// GetWriteOnly returns the value for the Write-Only argument `key` from the raw configuration, since Write-Only
+// arguments are never available via `d.Get`. The `key` is in the same format used for `d.Get`, for example
+// `service_principal.0.client_secret_wo`.
+func GetWriteOnly(d *ResourceData, key string, attributeType cty.Type) (*cty.Value, error) {
+	path, err := writeOnlyPathFromKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	value, diags := d.GetRawConfigAt(path)
+	if diags.HasError() {
+		return nil, fmt.Errorf("retrieving the Write-Only argument %q from the configuration", key)
+	}
+
+	if !value.Type().Equals(attributeType) {
+		return nil, fmt.Errorf("the Write-Only argument %q was expected to be of type %s but got %s", key, attributeType.FriendlyName(), value.Type().FriendlyName())
+	}
+
+	return &value, nil
+}
+
+// GetWriteOnlyString returns the value for the Write-Only string argument `key`, or an empty string if the argument
+// isn't specified (or is unknown).
+func GetWriteOnlyString(d *ResourceData, key string) (string, error) {
+	value, err := GetWriteOnly(d, key, cty.String)
+	if err != nil {
+		return "", err
+	}
+
+	if value.IsNull() || !value.IsKnown() {
+		return "", nil
+	}
+
+	return value.AsString(), nil
+}
+
+// HasWriteOnlyChange returns whether the `{key}_version` argument paired with the Write-Only argument `key` has
+// changed, and therefore whether the Write-Only value should be sent to the API.
+func HasWriteOnlyChange(d *ResourceData, key string) bool {
+	return d.HasChange(key + WriteOnlyVersionSuffix)
+}
+
+func writeOnlyPathFromKey(key string) (cty.Path, error) {
+	path := cty.Path{}
+	for _, segment := range strings.Split(key, ".") {
+		if segment == "" {
+			return nil, fmt.Errorf("parsing the key %q: segments cannot be empty", key)
+		}
+
+		if index, err := strconv.Atoi(segment); err == nil {
+			path = path.IndexInt(index)
+			continue
+		}
+
+		path = path.GetAttr(segment)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"path/filepath"
+	"strings"
+)
+
+// SnapshotsDirectoryName is the name of the directory (within each Service's `migration` package) where the Schema
+// Snapshots are stored, e.g. `internal/services/resource/migration/schema-snapshots/azurerm_resource_group.json`
+const SnapshotsDirectoryName = "schema-snapshots"
+
+// DiffWithCurrentSchema returns the incompatible changes between the Snapshot and the current Schema (and Resource ID
+// format) for the Resource
+func DiffWithCurrentSchema(base Snapshot) ([]Change, error) {
+	current, err := NewSnapshot(base.ResourceType, "")
+	if err != nil {
+		return nil, err
+	}
+
+	changes := Diff(base, *current)
+
+	if base.ExampleId != "" {
+		valid, err := ValidateResourceId(base.ResourceType, base.ExampleId)
+		if err != nil {
+			return nil, fmt.Errorf("validating the Resource ID %q for %q: %+v", base.ExampleId, base.ResourceType, err)
+		}
+		if !valid {
+			changes = append(changes, Change{
+				Type:        ChangeTypeIdFormatChanged,
+				Path:        "id",
+				Description: fmt.Sprintf("the Resource ID %q is no longer valid for %q", base.ExampleId, base.ResourceType),
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+// Check returns a violation for each Snapshot found under `path` where the Schema for the Resource has changed
+// incompatibly but the Schema Version hasn't been incremented (and a State Migration added)
+func Check(path string) ([]string, error) {
+	violations := make([]string, 0)
+
+	err := filepath.WalkDir(path, func(fileName string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || filepath.Base(filepath.Dir(fileName)) != SnapshotsDirectoryName || !strings.HasSuffix(fileName, ".json") {
+			return nil
+		}
+
+		base, err := LoadSnapshot(fileName)
+		if err != nil {
+			return err
+		}
+
+		current, err := NewSnapshot(base.ResourceType, "")
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("%s: %+v", fileName, err))
+			return nil
+		}
+
+		if current.SchemaVersion > base.SchemaVersion {
+			log.Printf("[DEBUG] %s: the Schema Version for %q has been incremented from %d to %d - the snapshot should be updated", fileName, base.ResourceType, base.SchemaVersion, current.SchemaVersion)
+			return nil
+		}
+
+		changes, err := DiffWithCurrentSchema(*base)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			violations = append(violations, fmt.Sprintf("%s: %s - the `SchemaVersion` must be incremented to %d and a State Migration added", fileName, change, base.SchemaVersion+1))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("checking the snapshots within %q: %+v", path, err)
+	}
+
+	return violations, nil
+}
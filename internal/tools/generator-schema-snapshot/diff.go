@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+type ChangeType string
+
+const (
+	ChangeTypeIdFormatChanged ChangeType = "IdFormatChanged"
+	ChangeTypePropertyRemoved ChangeType = "PropertyRemoved"
+	ChangeTypePropertyRenamed ChangeType = "PropertyRenamed"
+	ChangeTypeTypeChanged     ChangeType = "TypeChanged"
+)
+
+// Change is a difference between the Snapshot and the current Schema which is incompatible with existing State, and
+// so requires a State Migration
+type Change struct {
+	Type ChangeType
+
+	// Path is the path to the Property within the Snapshot, for example `network_rules.0.ip_rules`
+	Path string
+
+	// NewPath is the path to the Property within the current Schema, when the Property has been renamed
+	NewPath string
+
+	Description string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s", c.Type, c.Description)
+}
+
+// Diff returns the incompatible changes between the `base` Snapshot and the `current` Snapshot.
+//
+// A Property which has been removed from a block at the same time as a Property with an identical schema has been
+// added to it is assumed to have been renamed - which should be verified when implementing the State Migration.
+func Diff(base, current Snapshot) []Change {
+	changes := diffProperties(base.Schema, current.Schema, "")
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+func diffProperties(base, current map[string]Property, prefix string) []Change {
+	changes := make([]Change, 0)
+
+	removed := make([]string, 0)
+	for name, baseProp := range base {
+		path := prefix + name
+
+		currentProp, ok := current[name]
+		if !ok {
+			removed = append(removed, name)
+			continue
+		}
+
+		if baseProp.Type != currentProp.Type || baseProp.ElemType != currentProp.ElemType || (baseProp.Block == nil) != (currentProp.Block == nil) {
+			changes = append(changes, Change{
+				Type:        ChangeTypeTypeChanged,
+				Path:        path,
+				Description: fmt.Sprintf("the type of %q has changed from %s to %s", path, baseProp.typeDescription(), currentProp.typeDescription()),
+			})
+			continue
+		}
+
+		if baseProp.Block != nil {
+			changes = append(changes, diffProperties(baseProp.Block, currentProp.Block, path+".0.")...)
+		}
+	}
+
+	added := make([]string, 0)
+	for name := range current {
+		if _, ok := base[name]; !ok {
+			added = append(added, name)
+		}
+	}
+	sort.Strings(removed)
+	sort.Strings(added)
+
+	for _, name := range removed {
+		path := prefix + name
+		if newName := findRename(base[name], current, added); newName != "" {
+			added = removeString(added, newName)
+			changes = append(changes, Change{
+				Type:        ChangeTypePropertyRenamed,
+				Path:        path,
+				NewPath:     prefix + newName,
+				Description: fmt.Sprintf("%q appears to have been renamed to %q", path, prefix+newName),
+			})
+			continue
+		}
+
+		changes = append(changes, Change{
+			Type:        ChangeTypePropertyRemoved,
+			Path:        path,
+			Description: fmt.Sprintf("%q has been removed", path),
+		})
+	}
+
+	return changes
+}
+
+// findRename returns the name of the added Property which has an identical schema to the removed Property, if
+// there's exactly one candidate
+func findRename(removed Property, current map[string]Property, added []string) string {
+	candidates := make([]string, 0)
+	for _, name := range added {
+		if reflect.DeepEqual(removed, current[name]) {
+			candidates = append(candidates, name)
+		}
+	}
+
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+
+	return ""
+}
+
+func (p Property) typeDescription() string {
+	switch {
+	case p.Block != nil:
+		return fmt.Sprintf("%s (block)", p.Type)
+	case p.ElemType != "":
+		return fmt.Sprintf("%s of %s", p.Type, p.ElemType)
+	}
+
+	return p.Type
+}
+
+func removeString(input []string, value string) []string {
+	out := make([]string, 0)
+	for _, v := range input {
+		if v != value {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+var diffBaseSnapshot = Snapshot{
+	ResourceType:  "azurerm_example_resource",
+	SchemaVersion: 0,
+	ExampleId:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+	Schema: map[string]Property{
+		"name": {
+			Type:     "String",
+			Required: true,
+			ForceNew: true,
+		},
+		"enabled": {
+			Type:     "Bool",
+			Optional: true,
+		},
+		"size": {
+			Type:     "Int",
+			Optional: true,
+		},
+		"legacy_setting": {
+			Type:     "String",
+			Optional: true,
+			Computed: true,
+		},
+		"rule": {
+			Type:     "List",
+			Optional: true,
+			Block: map[string]Property{
+				"ip_range": {
+					Type:     "String",
+					Required: true,
+				},
+				"ports": {
+					Type:     "Set",
+					Optional: true,
+					ElemType: "String",
+				},
+			},
+		},
+	},
+}
+
+func TestDiff_NoChanges(t *testing.T) {
+	if changes := Diff(diffBaseSnapshot, diffBaseSnapshot); len(changes) > 0 {
+		t.Fatalf("expected no changes but got: %+v", changes)
+	}
+}
+
+func TestDiff_NewPropertiesAreCompatible(t *testing.T) {
+	current := copySnapshot(diffBaseSnapshot)
+	current.Schema["tags"] = Property{
+		Type:     "Map",
+		Optional: true,
+		ElemType: "String",
+	}
+
+	if changes := Diff(diffBaseSnapshot, current); len(changes) > 0 {
+		t.Fatalf("expected no changes but got: %+v", changes)
+	}
+}
+
+func TestDiff_IncompatibleChanges(t *testing.T) {
+	current := copySnapshot(diffBaseSnapshot)
+
+	// renamed
+	current.Schema["enabled_v2"] = current.Schema["enabled"]
+	delete(current.Schema, "enabled")
+
+	// removed
+	delete(current.Schema, "legacy_setting")
+
+	// type changed
+	current.Schema["size"] = Property{
+		Type:     "String",
+		Optional: true,
+	}
+
+	// nested type changed
+	rule := current.Schema["rule"]
+	rule.Block["ports"] = Property{
+		Type:     "List",
+		Optional: true,
+		ElemType: "Int",
+	}
+	current.Schema["rule"] = rule
+
+	expected := []string{
+		"PropertyRenamed:enabled",
+		"PropertyRemoved:legacy_setting",
+		"TypeChanged:rule.0.ports",
+		"TypeChanged:size",
+	}
+
+	changes := Diff(diffBaseSnapshot, current)
+	actual := make([]string, 0)
+	for _, change := range changes {
+		actual = append(actual, fmt.Sprintf("%s:%s", change.Type, change.Path))
+	}
+
+	if strings.Join(actual, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected the changes %+v but got %+v", expected, actual)
+	}
+	if changes[0].NewPath != "enabled_v2" {
+		t.Fatalf("expected the NewPath to be %q but got %q", "enabled_v2", changes[0].NewPath)
+	}
+}
+
+func TestDiff_AmbiguousRenameIsRemoval(t *testing.T) {
+	current := copySnapshot(diffBaseSnapshot)
+
+	// two candidates with the same schema, so this can't be determined to be a rename
+	current.Schema["enabled_one"] = current.Schema["enabled"]
+	current.Schema["enabled_two"] = current.Schema["enabled"]
+	delete(current.Schema, "enabled")
+
+	changes := Diff(diffBaseSnapshot, current)
+	if len(changes) != 1 || changes[0].Type != ChangeTypePropertyRemoved {
+		t.Fatalf("expected a single PropertyRemoved change but got %+v", changes)
+	}
+}
+
+func TestMigrationName(t *testing.T) {
+	if actual := MigrationName("azurerm_storage_blob_inventory_policy", 0); actual != "StorageBlobInventoryPolicyV0ToV1" {
+		t.Fatalf("expected %q but got %q", "StorageBlobInventoryPolicyV0ToV1", actual)
+	}
+
+	if actual := MigrationFileName("azurerm_storage_blob_inventory_policy", 2); actual != "storage_blob_inventory_policy_v2_to_v3" {
+		t.Fatalf("expected %q but got %q", "storage_blob_inventory_policy_v2_to_v3", actual)
+	}
+}
+
+func TestGenerateMigration(t *testing.T) {
+	changes := []Change{
+		{
+			Type:    ChangeTypePropertyRenamed,
+			Path:    "enabled",
+			NewPath: "enabled_v2",
+		},
+		{
+			Type: ChangeTypePropertyRemoved,
+			Path: "legacy_setting",
+		},
+		{
+			Type: ChangeTypeIdFormatChanged,
+			Path: "id",
+		},
+	}
+
+	migration := fmt.Sprintf("%#v", migrationFile(diffBaseSnapshot, changes))
+	for _, expected := range []string{
+		"package migration",
+		"type ExampleResourceV0ToV1 struct{}",
+		"func (ExampleResourceV0ToV1) Schema() map[string]*pluginsdk.Schema",
+		`rawState["enabled_v2"] = v`,
+		`delete(rawState, "legacy_setting")`,
+		`rawState["id"] = newIdRaw`,
+	} {
+		if !strings.Contains(migration, expected) {
+			t.Fatalf("expected the generated migration to contain %q:\n%s", expected, migration)
+		}
+	}
+
+	test := fmt.Sprintf("%#v", migrationTestFile(diffBaseSnapshot, changes))
+	for _, expected := range []string{
+		"func TestExampleResourceV0ToV1(t *testing.T)",
+		diffBaseSnapshot.ExampleId,
+	} {
+		if !strings.Contains(test, expected) {
+			t.Fatalf("expected the generated test to contain %q:\n%s", expected, test)
+		}
+	}
+}
+
+func copySnapshot(input Snapshot) Snapshot {
+	output := input
+	output.Schema = copyProperties(input.Schema)
+	return output
+}
+
+func copyProperties(input map[string]Property) map[string]Property {
+	output := make(map[string]Property)
+	for k, v := range input {
+		if v.Block != nil {
+			v.Block = copyProperties(v.Block)
+		}
+		output[k] = v
+	}
+	return output
+}
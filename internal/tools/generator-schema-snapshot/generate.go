@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/dave/jennifer/jen"
+)
+
+const (
+	MigrationPackageName = "migration"
+	ContextPath          = "context"
+)
+
+// MigrationName returns the name of the State Migration, for example `ResourceGroupV0ToV1` for `azurerm_resource_group`
+func MigrationName(resourceType string, fromVersion int) string {
+	name := ""
+	for _, segment := range strings.Split(strings.TrimPrefix(resourceType, "azurerm_"), "_") {
+		if segment == "" {
+			continue
+		}
+		name += strings.ToUpper(segment[:1]) + segment[1:]
+	}
+
+	return fmt.Sprintf("%sV%dToV%d", name, fromVersion, fromVersion+1)
+}
+
+// MigrationFileName returns the file name of the State Migration, for example `resource_group_v0_to_v1`
+func MigrationFileName(resourceType string, fromVersion int) string {
+	return fmt.Sprintf("%s_v%d_to_v%d", strings.TrimPrefix(resourceType, "azurerm_"), fromVersion, fromVersion+1)
+}
+
+// GenerateMigration writes a skeleton State Migration (and tests) from the Schema Version in the Snapshot to the next
+// Schema Version into `outputDirectory` (the `migration` package for the Service), which should then be completed.
+func GenerateMigration(base Snapshot, changes []Change, outputDirectory string) ([]string, error) {
+	fileName := MigrationFileName(base.ResourceType, base.SchemaVersion)
+	files := map[string]*File{
+		filepath.Join(outputDirectory, fileName+".go"):      migrationFile(base, changes),
+		filepath.Join(outputDirectory, fileName+"_test.go"): migrationTestFile(base, changes),
+	}
+
+	written := make([]string, 0)
+	for path, file := range files {
+		if _, err := os.Stat(path); err == nil {
+			return written, fmt.Errorf("the file %q already exists", path)
+		}
+
+		if err := os.MkdirAll(outputDirectory, 0o755); err != nil {
+			return written, fmt.Errorf("creating directory %q: %+v", outputDirectory, err)
+		}
+
+		if err := file.Save(path); err != nil {
+			return written, fmt.Errorf("writing %q: %+v", path, err)
+		}
+		written = append(written, path)
+	}
+
+	return written, nil
+}
+
+func migrationFile(base Snapshot, changes []Change) *File {
+	name := MigrationName(base.ResourceType, base.SchemaVersion)
+
+	f := NewFile(MigrationPackageName)
+	f.HeaderComment("Copyright (c) HashiCorp, Inc.\nSPDX-License-Identifier: MPL-2.0")
+	f.ImportName(SchemaPath, "pluginsdk")
+
+	f.Var().Id("_").Qual(SchemaPath, "StateUpgrade").Op("=").Id(name).Values()
+	f.Line()
+
+	f.Type().Id(name).Struct()
+	f.Line()
+
+	f.Func().Params(Id(name)).Id("Schema").Params().Map(String()).Op("*").Qual(SchemaPath, "Schema").Block(
+		Return(SchemaMap(base.PluginSdkSchema())),
+	)
+	f.Line()
+
+	body := make([]Code, 0)
+	for _, change := range changes {
+		body = append(body, upgradeCodeForChange(change)...)
+		body = append(body, Line())
+	}
+	body = append(body, Return(Id("rawState"), Nil()))
+
+	f.Func().Params(Id(name)).Id("UpgradeFunc").Params().Qual(SchemaPath, "StateUpgraderFunc").Block(
+		Return(Func().Params(
+			Id("ctx").Qual(ContextPath, "Context"),
+			Id("rawState").Map(String()).Interface(),
+			Id("meta").Interface(),
+		).Params(Map(String()).Interface(), Error()).Block(body...)),
+	)
+
+	return f
+}
+
+func upgradeCodeForChange(change Change) []Code {
+	nested := strings.Contains(change.Path, ".")
+
+	switch change.Type {
+	case ChangeTypeIdFormatChanged:
+		return []Code{
+			Comment(fmt.Sprintf("%s: %s", change.Type, change.Description)),
+			Comment("TODO: parse the existing Resource ID and convert it to the new format"),
+			Id("oldIdRaw").Op(":=").Id("rawState").Index(Lit("id")).Assert(String()),
+			Id("newIdRaw").Op(":=").Id("oldIdRaw"),
+			Qual("log", "Printf").Call(Lit("[DEBUG] Updating the Resource ID from %q to %q"), Id("oldIdRaw"), Id("newIdRaw")),
+			Id("rawState").Index(Lit("id")).Op("=").Id("newIdRaw"),
+		}
+
+	case ChangeTypePropertyRenamed:
+		if nested {
+			return []Code{
+				Comment(fmt.Sprintf("%s: %s", change.Type, change.Description)),
+				Comment(fmt.Sprintf("TODO: move the value from %q to %q within each item of the block", change.Path, change.NewPath)),
+			}
+		}
+		return []Code{
+			Comment(fmt.Sprintf("%s: %s", change.Type, change.Description)),
+			If(List(Id("v"), Id("ok")).Op(":=").Id("rawState").Index(Lit(change.Path)), Id("ok")).Block(
+				Id("rawState").Index(Lit(change.NewPath)).Op("=").Id("v"),
+				Delete(Id("rawState"), Lit(change.Path)),
+			),
+		}
+
+	case ChangeTypePropertyRemoved:
+		if nested {
+			return []Code{
+				Comment(fmt.Sprintf("%s: %s", change.Type, change.Description)),
+				Comment(fmt.Sprintf("TODO: remove %q from each item of the block", change.Path)),
+			}
+		}
+		return []Code{
+			Comment(fmt.Sprintf("%s: %s", change.Type, change.Description)),
+			Delete(Id("rawState"), Lit(change.Path)),
+		}
+	}
+
+	return []Code{
+		Comment(fmt.Sprintf("%s: %s", change.Type, change.Description)),
+		Comment(fmt.Sprintf("TODO: convert the existing value for %q to the new type", change.Path)),
+	}
+}
+
+func migrationTestFile(base Snapshot, changes []Change) *File {
+	name := MigrationName(base.ResourceType, base.SchemaVersion)
+
+	f := NewFile(MigrationPackageName)
+	f.HeaderComment("Copyright (c) HashiCorp, Inc.\nSPDX-License-Identifier: MPL-2.0")
+
+	exampleId := base.ExampleId
+	if exampleId == "" {
+		exampleId = "TODO"
+	}
+
+	input := Dict{
+		Lit("id"): Lit(exampleId),
+	}
+	checks := make([]Code, 0)
+	for _, change := range changes {
+		if change.Type == ChangeTypeIdFormatChanged {
+			checks = append(checks,
+				If(Id("result").Index(Lit("id")).Op("==").Lit(exampleId)).Block(
+					Id("t").Dot("Fatalf").Call(Lit("expected the Resource ID to be updated but got %q"), Id("result").Index(Lit("id"))),
+				),
+			)
+			continue
+		}
+
+		if strings.Contains(change.Path, ".") {
+			continue
+		}
+
+		prop := base.Schema[change.Path]
+		input[Lit(change.Path)] = exampleValue(prop)
+
+		if change.Type == ChangeTypePropertyRenamed || change.Type == ChangeTypePropertyRemoved {
+			checks = append(checks,
+				If(List(Id("_"), Id("ok")).Op(":=").Id("result").Index(Lit(change.Path)), Id("ok")).Block(
+					Id("t").Dot("Fatalf").Call(Lit(fmt.Sprintf("expected %q to be removed from the state", change.Path))),
+				),
+			)
+		}
+		if change.Type == ChangeTypePropertyRenamed {
+			checks = append(checks,
+				If(List(Id("_"), Id("ok")).Op(":=").Id("result").Index(Lit(change.NewPath)), Op("!").Id("ok")).Block(
+					Id("t").Dot("Fatalf").Call(Lit(fmt.Sprintf("expected %q to be set in the state", change.NewPath))),
+				),
+			)
+		}
+	}
+
+	body := []Code{
+		Id("input").Op(":=").Map(String()).Interface().Values(input),
+		Line(),
+		List(Id("result"), Err()).Op(":=").Id(name).Values().Dot("UpgradeFunc").Call().Call(Qual(ContextPath, "TODO").Call(), Id("input"), Nil()),
+		If(Err().Op("!=").Nil()).Block(
+			Id("t").Dot("Fatalf").Call(Lit("unexpected error: %+v"), Err()),
+		),
+		Line(),
+	}
+	body = append(body, checks...)
+	body = append(body, Comment("TODO: add assertions for the remaining changes"))
+
+	f.Func().Id("Test" + name).Params(Id("t").Op("*").Qual("testing", "T")).Block(body...)
+
+	return f
+}
+
+func exampleValue(prop Property) Code {
+	switch prop.Type {
+	case "Bool":
+		return True()
+	case "Int":
+		return Lit(1)
+	case "Float":
+		return Lit(1.5)
+	case "String":
+		return Lit("example")
+	case "Map":
+		return Map(String()).Interface().Values()
+	}
+
+	return Index().Interface().Values()
+}
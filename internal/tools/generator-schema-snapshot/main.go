@@ -4,9 +4,11 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	. "github.com/dave/jennifer/jen"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
@@ -18,10 +20,85 @@ const (
 )
 
 func main() {
-	if len(os.Args) != 2 {
-		log.Fatal("Usage: generator-schema-snapshot <reource_type>")
+	// the original usage (printing the Schema for the Resource) is retained for backwards compatibility
+	if len(os.Args) == 2 && !strings.HasPrefix(os.Args[1], "-") {
+		printSchema(os.Args[1])
+		return
 	}
-	rt := os.Args[1]
+
+	f := flag.NewFlagSet("generator-schema-snapshot", flag.ExitOnError)
+
+	resourceType := f.String("resource-type", "", "the resource type, e.g. `azurerm_resource_group`")
+	save := f.String("save", "", "save a snapshot of the current schema for the resource type to the given path/filename")
+	exampleId := f.String("example-id", "", "an example Resource ID to store in the snapshot, used to detect changes to the ID format")
+	diff := f.String("diff", "", "compare the snapshot at the given path/filename to the current schema")
+	generate := f.String("generate", "", "used with -diff, generate a skeleton state migration (and tests) into the given `migration` package directory")
+	check := f.String("check", "", "check all of the snapshots within `schema-snapshots` directories under the given path, failing if the schema has changed incompatibly without the `SchemaVersion` being incremented")
+
+	if err := f.Parse(os.Args[1:]); err != nil {
+		log.Fatalf("parsing arguments: %+v", err)
+	}
+
+	switch {
+	case *save != "":
+		if *resourceType == "" {
+			log.Fatal("`-resource-type` must be specified when using `-save`")
+		}
+		snapshot, err := NewSnapshot(*resourceType, *exampleId)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := snapshot.Save(*save); err != nil {
+			log.Fatalf("saving the snapshot for %q: %+v", *resourceType, err)
+		}
+		log.Printf("saved the snapshot for %q (Schema Version %d) to %q", *resourceType, snapshot.SchemaVersion, *save)
+
+	case *diff != "":
+		base, err := LoadSnapshot(*diff)
+		if err != nil {
+			log.Fatal(err)
+		}
+		changes, err := DiffWithCurrentSchema(*base)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(changes) == 0 {
+			log.Printf("no incompatible changes were detected for %q", base.ResourceType)
+			return
+		}
+		for _, change := range changes {
+			log.Println(change)
+		}
+
+		if *generate != "" {
+			files, err := GenerateMigration(*base, changes, *generate)
+			if err != nil {
+				log.Fatalf("generating the state migration for %q: %+v", base.ResourceType, err)
+			}
+			for _, file := range files {
+				log.Printf("generated %q", file)
+			}
+			log.Printf("complete the TODOs, then set the `SchemaVersion` to %d and register %s{} for version %d within the StateUpgraders", base.SchemaVersion+1, MigrationName(base.ResourceType, base.SchemaVersion), base.SchemaVersion)
+		}
+
+	case *check != "":
+		violations, err := Check(*check)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, v := range violations {
+			log.Println(v)
+		}
+		if len(violations) > 0 {
+			os.Exit(1)
+		}
+
+	default:
+		log.Fatal("Usage: generator-schema-snapshot <resource_type> | -resource-type <resource_type> -save <file> [-example-id <id>] | -diff <file> [-generate <migration_dir>] | -check <path>")
+	}
+}
+
+func printSchema(rt string) {
 	res, ok := provider.AzureProvider().ResourcesMap[rt]
 	if !ok {
 		log.Fatalf("unknown resource type %q", rt)
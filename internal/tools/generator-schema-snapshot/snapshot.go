@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Snapshot is a point-in-time representation of the Schema for a Resource, containing only the information which
+// affects the shape of the State (and therefore whether a State Migration is required)
+type Snapshot struct {
+	ResourceType  string `json:"resourceType"`
+	SchemaVersion int    `json:"schemaVersion"`
+
+	// ExampleId is an example of the Resource ID at this Schema Version, used to detect changes to the ID format
+	ExampleId string `json:"exampleId,omitempty"`
+
+	Schema map[string]Property `json:"schema"`
+}
+
+type Property struct {
+	Type     string `json:"type"`
+	Required bool   `json:"required,omitempty"`
+	Optional bool   `json:"optional,omitempty"`
+	Computed bool   `json:"computed,omitempty"`
+	ForceNew bool   `json:"forceNew,omitempty"`
+	MaxItems int    `json:"maxItems,omitempty"`
+
+	// ElemType is the type of each item when this is a List/Map/Set of a primitive type
+	ElemType string `json:"elemType,omitempty"`
+
+	// Block is the nested Schema when this is a List/Set of a Resource (a block)
+	Block map[string]Property `json:"block,omitempty"`
+}
+
+// NewSnapshot builds a Snapshot from the current Schema for the Resource
+func NewSnapshot(resourceType string, exampleId string) (*Snapshot, error) {
+	res, ok := provider.AzureProvider().ResourcesMap[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type %q", resourceType)
+	}
+
+	return &Snapshot{
+		ResourceType:  resourceType,
+		SchemaVersion: res.SchemaVersion,
+		ExampleId:     exampleId,
+		Schema:        propertiesFromSchema(res.Schema),
+	}, nil
+}
+
+func LoadSnapshot(fileName string) (*Snapshot, error) {
+	contents, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", fileName, err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(contents, &snapshot); err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", fileName, err)
+	}
+
+	return &snapshot, nil
+}
+
+func (s Snapshot) Save(fileName string) error {
+	contents, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing the snapshot: %+v", err)
+	}
+
+	return os.WriteFile(fileName, append(contents, '\n'), 0o644)
+}
+
+// PluginSdkSchema returns the Schema from the Snapshot as a Plugin SDK Schema, for use in a State Migration
+func (s Snapshot) PluginSdkSchema() map[string]*pluginsdk.Schema {
+	return schemaFromProperties(s.Schema)
+}
+
+func propertiesFromSchema(input map[string]*pluginsdk.Schema) map[string]Property {
+	out := make(map[string]Property)
+	for k, v := range input {
+		prop := Property{
+			Type:     typeName(v.Type),
+			Required: v.Required,
+			Optional: v.Optional,
+			Computed: v.Computed,
+			ForceNew: v.ForceNew,
+			MaxItems: v.MaxItems,
+		}
+
+		switch elem := v.Elem.(type) {
+		case *pluginsdk.Schema:
+			prop.ElemType = typeName(elem.Type)
+		case *pluginsdk.Resource:
+			prop.Block = propertiesFromSchema(elem.Schema)
+		}
+
+		out[k] = prop
+	}
+
+	return out
+}
+
+func schemaFromProperties(input map[string]Property) map[string]*pluginsdk.Schema {
+	out := make(map[string]*pluginsdk.Schema)
+	for k, v := range input {
+		item := &pluginsdk.Schema{
+			Type:     valueType(v.Type),
+			Required: v.Required,
+			Optional: v.Optional,
+			Computed: v.Computed,
+			ForceNew: v.ForceNew,
+			MaxItems: v.MaxItems,
+		}
+
+		if v.ElemType != "" {
+			item.Elem = &pluginsdk.Schema{
+				Type: valueType(v.ElemType),
+			}
+		}
+		if v.Block != nil {
+			item.Elem = &pluginsdk.Resource{
+				Schema: schemaFromProperties(v.Block),
+			}
+		}
+
+		out[k] = item
+	}
+
+	return out
+}
+
+func typeName(input pluginsdk.ValueType) string {
+	return strings.TrimPrefix(input.String(), "Type")
+}
+
+func valueType(input string) pluginsdk.ValueType {
+	for _, v := range []pluginsdk.ValueType{
+		pluginsdk.TypeBool,
+		pluginsdk.TypeInt,
+		pluginsdk.TypeFloat,
+		pluginsdk.TypeString,
+		pluginsdk.TypeList,
+		pluginsdk.TypeMap,
+		pluginsdk.TypeSet,
+	} {
+		if typeName(v) == input {
+			return v
+		}
+	}
+
+	return pluginsdk.TypeInvalid
+}
+
+// ValidateResourceId returns whether the Resource ID is valid for the current version of the Resource, using the
+// ID Validation Function for Typed Resources, and the ID Parser used by the Importer for Untyped Resources.
+func ValidateResourceId(resourceType string, id string) (bool, error) {
+	for _, service := range provider.SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			if r.ResourceType() != resourceType {
+				continue
+			}
+
+			_, errs := r.IDValidationFunc()(id, "id")
+			return len(errs) == 0, nil
+		}
+	}
+
+	res, ok := provider.AzureProvider().ResourcesMap[resourceType]
+	if !ok {
+		return false, fmt.Errorf("unknown resource type %q", resourceType)
+	}
+	if res.Importer == nil {
+		return false, fmt.Errorf("the resource type %q doesn't support import, so the Resource ID can't be validated", resourceType)
+	}
+
+	validateFunc, ok := pluginsdk.IDValidationFuncForImporter(res.Importer)
+	if !ok {
+		return false, fmt.Errorf("the resource type %q doesn't use `pluginsdk.ImporterValidatingResourceId`, so the Resource ID can't be validated", resourceType)
+	}
+
+	return validateFunc(id) == nil, nil
+}
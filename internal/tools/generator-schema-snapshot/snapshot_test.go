@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"testing"
+)
+
+func TestValidateResourceId(t *testing.T) {
+	testData := []struct {
+		Name         string
+		ResourceType string
+		Id           string
+		Valid        bool
+		Error        bool
+	}{
+		{
+			Name:         "Typed Resource with a valid ID",
+			ResourceType: "azurerm_resource_provider_registration",
+			Id:           "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Example",
+			Valid:        true,
+		},
+		{
+			Name:         "Typed Resource with an invalid ID",
+			ResourceType: "azurerm_resource_provider_registration",
+			Id:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			Valid:        false,
+		},
+		{
+			Name:         "Untyped Resource with a valid ID",
+			ResourceType: "azurerm_resource_group",
+			Id:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			Valid:        true,
+		},
+		{
+			Name:         "Untyped Resource with an invalid ID",
+			ResourceType: "azurerm_resource_group",
+			Id:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example",
+			Valid:        false,
+		},
+		{
+			Name:         "Untyped Resource with a nested ID",
+			ResourceType: "azurerm_virtual_network_peering",
+			Id:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/virtualNetworkPeerings/example",
+			Valid:        true,
+		},
+		{
+			Name:         "Unknown Resource",
+			ResourceType: "azurerm_example_resource",
+			Id:           "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+			Error:        true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		valid, err := ValidateResourceId(v.ResourceType, v.Id)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if valid != v.Valid {
+			t.Fatalf("expected valid to be %t but got %t", v.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type findingType string
+
+const (
+	findingTypeEnumValueAdded   findingType = "Enum Value Added"
+	findingTypeEnumValueRemoved findingType = "Enum Value Removed"
+	findingTypeFieldRemoved     findingType = "Field Removed"
+	findingTypeFieldTypeChanged findingType = "Field Type Changed"
+	findingTypeNewRequiredField findingType = "New Required Field"
+	findingTypeOperationChanged findingType = "Operation Changed"
+	findingTypeOperationRemoved findingType = "Operation Removed"
+	findingTypePackageRemoved   findingType = "Package Removed"
+	findingTypeSymbolRemoved    findingType = "Symbol Removed"
+	findingTypeFieldNowRequired findingType = "Field Now Required"
+)
+
+type finding struct {
+	Type        findingType
+	PackageName string
+	Description string
+}
+
+type resourceReport struct {
+	// Name is the Resource Type (e.g. `azurerm_resource_group`) when this can be determined, otherwise the File Name
+	Name     string
+	FileName string
+	Findings []finding
+}
+
+var resourceTypeRegex = regexp.MustCompile(`^azurerm_[a-z0-9_]+$`)
+
+// analyze reports the semantic differences between the old and new API versions for each Resource (file) within the
+// Service which uses the old API version, without modifying any files
+func analyze(serviceName string, oldApiVersion string, newApiVersion string, workingDirectory string) error {
+	serviceDirectory := path.Join(workingDirectory, "services", serviceName)
+	vendorDirectory := path.Join(workingDirectory, "..", "vendor")
+
+	directories := []string{serviceDirectory}
+	entries, err := os.ReadDir(serviceDirectory)
+	if err != nil {
+		return fmt.Errorf("opening the working directory at %q: %+v", serviceDirectory, err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			directories = append(directories, filepath.Join(serviceDirectory, entry.Name()))
+		}
+	}
+
+	a := analyzer{
+		serviceName:     serviceName,
+		oldApiVersion:   oldApiVersion,
+		newApiVersion:   newApiVersion,
+		vendorDirectory: vendorDirectory,
+		packages:        make(map[string]*sdkPackage),
+	}
+
+	reports := make([]resourceReport, 0)
+	for _, directory := range directories {
+		logger.Debug(fmt.Sprintf("Analyzing the files within %q..", directory))
+		fileSet := token.NewFileSet()
+		packages, err := parser.ParseDir(fileSet, directory, func(info fs.FileInfo) bool {
+			return !strings.HasSuffix(info.Name(), "_test.go")
+		}, 0)
+		if err != nil {
+			return fmt.Errorf("parsing files within %q: %+v", directory, err)
+		}
+
+		for _, pkg := range packages {
+			for fileName, file := range pkg.Files {
+				report, err := a.analyzeFile(fileName, file)
+				if err != nil {
+					return fmt.Errorf("analyzing %q: %+v", fileName, err)
+				}
+				if report != nil {
+					reports = append(reports, *report)
+				}
+			}
+		}
+	}
+
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Name < reports[j].Name
+	})
+	fmt.Print(formatReports(reports, serviceName, oldApiVersion, newApiVersion))
+	return nil
+}
+
+type analyzer struct {
+	serviceName     string
+	oldApiVersion   string
+	newApiVersion   string
+	vendorDirectory string
+
+	// packages is a cache of the parsed SDK packages, keyed by `{apiVersion}/{packageName}`
+	packages map[string]*sdkPackage
+}
+
+// loadPackage returns the parsed SDK package, or nil when the package doesn't exist in the specified API version
+func (a analyzer) loadPackage(apiVersion, packageName string) (*sdkPackage, error) {
+	key := fmt.Sprintf("%s/%s", apiVersion, packageName)
+	if existing, ok := a.packages[key]; ok {
+		return existing, nil
+	}
+
+	directory, err := sdkPackageDirectory(a.vendorDirectory, a.serviceName, apiVersion, packageName)
+	if err != nil {
+		return nil, err
+	}
+	if directory == "" {
+		a.packages[key] = nil
+		return nil, nil
+	}
+
+	pkg, err := loadSdkPackage(directory)
+	if err != nil {
+		return nil, err
+	}
+	a.packages[key] = pkg
+	return pkg, nil
+}
+
+// analyzeFile returns a report for the file when it imports any packages from the old API version
+func (a analyzer) analyzeFile(fileName string, file *ast.File) (*resourceReport, error) {
+	importPrefix := fmt.Sprintf("%s/%s/%s/", resourceManagerModule, a.serviceName, a.oldApiVersion)
+
+	// the import alias for each package from the old API version
+	aliases := make(map[string]string)
+	for _, item := range file.Imports {
+		importPath, err := strconv.Unquote(item.Path.Value)
+		if err != nil || !strings.HasPrefix(importPath, importPrefix) {
+			continue
+		}
+
+		packageName := strings.TrimPrefix(importPath, importPrefix)
+		alias := path.Base(importPath)
+		if item.Name != nil {
+			alias = item.Name.Name
+		}
+		aliases[alias] = packageName
+	}
+	if len(aliases) == 0 {
+		return nil, nil
+	}
+
+	// the symbols referenced from each package, and the names of all methods called within the file
+	usedSymbols := make(map[string]map[string]struct{})
+	usedMethods := make(map[string]struct{})
+	report := resourceReport{
+		Name:     strings.TrimSuffix(filepath.Base(fileName), ".go"),
+		FileName: fileName,
+	}
+	resourceTypeFound := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.SelectorExpr:
+			if ident, ok := v.X.(*ast.Ident); ok {
+				if packageName, ok := aliases[ident.Name]; ok {
+					if _, ok := usedSymbols[packageName]; !ok {
+						usedSymbols[packageName] = make(map[string]struct{})
+					}
+					usedSymbols[packageName][v.Sel.Name] = struct{}{}
+					return true
+				}
+			}
+			usedMethods[v.Sel.Name] = struct{}{}
+
+		case *ast.BasicLit:
+			if resourceTypeFound || v.Kind != token.STRING {
+				return true
+			}
+			if value, err := strconv.Unquote(v.Value); err == nil && resourceTypeRegex.MatchString(value) {
+				report.Name = value
+				resourceTypeFound = true
+			}
+		}
+		return true
+	})
+
+	packageNames := make([]string, 0)
+	for _, packageName := range aliases {
+		packageNames = append(packageNames, packageName)
+	}
+	sort.Strings(packageNames)
+
+	for _, packageName := range packageNames {
+		oldPackage, err := a.loadPackage(a.oldApiVersion, packageName)
+		if err != nil {
+			return nil, err
+		}
+		if oldPackage == nil {
+			return nil, fmt.Errorf("the package %q was not found for API version %q", packageName, a.oldApiVersion)
+		}
+
+		newPackage, err := a.loadPackage(a.newApiVersion, packageName)
+		if err != nil {
+			return nil, err
+		}
+		if newPackage == nil {
+			report.Findings = append(report.Findings, finding{
+				Type:        findingTypePackageRemoved,
+				PackageName: packageName,
+				Description: fmt.Sprintf("the package %q is not available in API version %q", packageName, a.newApiVersion),
+			})
+			continue
+		}
+
+		report.Findings = append(report.Findings, comparePackages(packageName, *oldPackage, *newPackage, usedSymbols[packageName], usedMethods)...)
+	}
+
+	return &report, nil
+}
+
+// comparePackages returns the differences between the old and new versions of the package, limited to the symbols
+// and methods used within the file (and any Models/Enums referenced by them)
+func comparePackages(packageName string, oldPackage, newPackage sdkPackage, usedSymbols, usedMethods map[string]struct{}) []finding {
+	findings := make([]finding, 0)
+	add := func(t findingType, format string, args ...interface{}) {
+		findings = append(findings, finding{
+			Type:        t,
+			PackageName: packageName,
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+
+	models := make(map[string]struct{})
+	enums := make(map[string]struct{})
+	for _, symbol := range sortedKeys(usedSymbols) {
+		if !newPackage.hasSymbol(symbol) {
+			add(findingTypeSymbolRemoved, "%s.%s is no longer available", packageName, symbol)
+		}
+
+		if _, ok := oldPackage.models[symbol]; ok {
+			models[symbol] = struct{}{}
+		}
+		if _, ok := oldPackage.enums[symbol]; ok {
+			enums[symbol] = struct{}{}
+		}
+		if enumType, ok := oldPackage.constants[symbol]; ok {
+			enums[enumType] = struct{}{}
+		}
+	}
+
+	// include any Models and Enums which are (transitively) referenced by the fields within the Models used
+	queue := sortedKeys(models)
+	for len(queue) > 0 {
+		modelName := queue[0]
+		queue = queue[1:]
+		for _, field := range oldPackage.models[modelName] {
+			for _, typeName := range referencedTypeNames(field.Type) {
+				if _, ok := oldPackage.models[typeName]; ok {
+					if _, seen := models[typeName]; !seen {
+						models[typeName] = struct{}{}
+						queue = append(queue, typeName)
+					}
+				}
+				if _, ok := oldPackage.enums[typeName]; ok {
+					enums[typeName] = struct{}{}
+				}
+			}
+		}
+	}
+
+	for _, modelName := range sortedKeys(models) {
+		oldFields := oldPackage.models[modelName]
+		newFields, ok := newPackage.models[modelName]
+		if !ok {
+			continue
+		}
+
+		for _, fieldName := range sortedKeys(oldFields) {
+			oldField := oldFields[fieldName]
+			newField, ok := newFields[fieldName]
+			if !ok {
+				add(findingTypeFieldRemoved, "%s.%s (%s) has been removed", modelName, fieldName, oldField.Type)
+				continue
+			}
+			if oldField.Type != newField.Type {
+				add(findingTypeFieldTypeChanged, "%s.%s has changed from %s to %s", modelName, fieldName, oldField.Type, newField.Type)
+			}
+			if !oldField.Required && newField.Required {
+				add(findingTypeFieldNowRequired, "%s.%s (%s) is now Required", modelName, fieldName, newField.Type)
+			}
+		}
+		for _, fieldName := range sortedKeys(newFields) {
+			newField := newFields[fieldName]
+			if _, ok := oldFields[fieldName]; !ok && newField.Required {
+				add(findingTypeNewRequiredField, "%s.%s (%s) has been added and is Required", modelName, fieldName, newField.Type)
+			}
+		}
+	}
+
+	for _, enumName := range sortedKeys(enums) {
+		newValues, ok := newPackage.enums[enumName]
+		if !ok {
+			continue
+		}
+		oldValues := oldPackage.enums[enumName]
+
+		for _, value := range sortedKeys(newValues) {
+			if _, ok := oldValues[value]; !ok {
+				add(findingTypeEnumValueAdded, "%s has a new value %q", enumName, value)
+			}
+		}
+		for _, value := range sortedKeys(oldValues) {
+			if _, ok := newValues[value]; !ok {
+				add(findingTypeEnumValueRemoved, "%s no longer supports the value %q", enumName, value)
+			}
+		}
+	}
+
+	for _, operation := range sortedKeys(oldPackage.operations) {
+		if i := strings.LastIndex(operation, "."); i != -1 {
+			// methods on a Client are called on the Client instance (e.g. `client.Get`) rather than the package alias
+			if _, ok := usedMethods[operation[i+1:]]; !ok {
+				continue
+			}
+		} else if _, ok := usedSymbols[operation]; !ok {
+			continue
+		}
+
+		newSignature, ok := newPackage.operations[operation]
+		if !ok {
+			if !strings.Contains(operation, ".") {
+				// functions are referenced via the package alias, so have already been reported above
+				continue
+			}
+			add(findingTypeOperationRemoved, "%s is no longer available", operation)
+			continue
+		}
+		if oldSignature := oldPackage.operations[operation]; oldSignature != newSignature {
+			add(findingTypeOperationChanged, "%s has changed from `%s` to `%s`", operation, oldSignature, newSignature)
+		}
+	}
+
+	return findings
+}
+
+func formatReports(reports []resourceReport, serviceName, oldApiVersion, newApiVersion string) string {
+	out := fmt.Sprintf("API Version Impact Analysis for %q: %q -> %q\n\n", serviceName, oldApiVersion, newApiVersion)
+
+	withoutFindings := make([]string, 0)
+	for _, report := range reports {
+		if len(report.Findings) == 0 {
+			withoutFindings = append(withoutFindings, report.Name)
+			continue
+		}
+
+		out += fmt.Sprintf("%s (%s)\n", report.Name, report.FileName)
+		for _, f := range report.Findings {
+			out += fmt.Sprintf("  - [%s] %s: %s\n", f.Type, f.PackageName, f.Description)
+		}
+		out += "\n"
+	}
+
+	if len(withoutFindings) > 0 {
+		out += fmt.Sprintf("No changes were detected for: %s\n", strings.Join(withoutFindings, ", "))
+	}
+
+	return out
+}
+
+func sortedKeys[T any](input map[string]T) []string {
+	out := make([]string, 0, len(input))
+	for k := range input {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
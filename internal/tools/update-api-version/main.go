@@ -34,6 +34,7 @@ func main() {
 	serviceName := f.String("service", "", "-service=compute")
 	oldApiVersion := f.String("old-api-version", "", "-old-api-version=2019-01-01")
 	newApiVersion := f.String("new-api-version", "", "-new-api-version=2023-06-01")
+	analyzeOnly := f.Bool("analyze", false, "-analyze (reports the impact of the new API version on each resource, without updating any files)")
 	if len(os.Args) == 1 { // 0 is the app name
 		log.Fatalf("expected multiple arguments but didn't get any")
 	}
@@ -51,6 +52,13 @@ func main() {
 	}
 
 	workingDirectory := "../.." // path to the `internal` folder
+	if *analyzeOnly {
+		if err := analyze(*serviceName, *oldApiVersion, *newApiVersion, workingDirectory); err != nil {
+			log.Fatalf("error: %+v", err)
+		}
+		return
+	}
+
 	if err := run(*serviceName, *oldApiVersion, *newApiVersion, workingDirectory); err != nil {
 		log.Fatalf("error: %+v", err)
 	}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const resourceManagerModule = "github.com/hashicorp/go-azure-sdk/resource-manager"
+
+// sdkPackage is the public API surface of a single package (e.g. `virtualmachines`) within a specific API version of
+// `hashicorp/go-azure-sdk`, obtained by parsing (rather than type-checking) the source code
+type sdkPackage struct {
+	// constants is a map of the Constant name to the name of the (Enum) type it belongs to
+	constants map[string]string
+
+	// enums is a map of the Enum type name to the possible values for it
+	enums map[string]map[string]struct{}
+
+	// models is a map of the Model name to the fields within it
+	models map[string]map[string]sdkField
+
+	// operations is a map of `{Client}.{Method}` (or `{Function}` for functions, such as Resource ID parsers) to the
+	// signature for that method/function
+	operations map[string]string
+}
+
+type sdkField struct {
+	Type     string
+	Required bool
+}
+
+func (p sdkPackage) hasSymbol(name string) bool {
+	if _, ok := p.constants[name]; ok {
+		return true
+	}
+	if _, ok := p.enums[name]; ok {
+		return true
+	}
+	if _, ok := p.models[name]; ok {
+		return true
+	}
+	for key := range p.operations {
+		if key == name || strings.HasPrefix(key, name+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// sdkPackageDirectory returns the directory containing the source code for the specified package, using the vendor
+// directory when available, and otherwise the Go module cache (since new API versions won't have been vendored yet).
+// An empty string is returned when the package doesn't exist in this API version.
+func sdkPackageDirectory(vendorDirectory, serviceName, apiVersion, packageName string) (string, error) {
+	relativePath := filepath.Join(serviceName, apiVersion, packageName)
+
+	vendored := filepath.Join(vendorDirectory, filepath.FromSlash(resourceManagerModule), relativePath)
+	if info, err := os.Stat(vendored); err == nil && info.IsDir() {
+		return vendored, nil
+	}
+
+	cmd := exec.Command("go", "list", "-mod=mod", "-m", "-f", "{{.Dir}}", resourceManagerModule)
+	cmd.Dir = filepath.Dir(vendorDirectory)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("locating the module %q: %+v", resourceManagerModule, err)
+	}
+	moduleDirectory := strings.TrimSpace(string(output))
+	if moduleDirectory == "" {
+		return "", fmt.Errorf("the module %q was not found in the module cache - run `go mod download`", resourceManagerModule)
+	}
+
+	fromModule := filepath.Join(moduleDirectory, relativePath)
+	if info, err := os.Stat(fromModule); err == nil && info.IsDir() {
+		return fromModule, nil
+	}
+
+	return "", nil
+}
+
+func loadSdkPackage(directory string) (*sdkPackage, error) {
+	fileSet := token.NewFileSet()
+	packages, err := parser.ParseDir(fileSet, directory, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the files within %q: %+v", directory, err)
+	}
+
+	out := sdkPackage{
+		constants:  make(map[string]string),
+		enums:      make(map[string]map[string]struct{}),
+		models:     make(map[string]map[string]sdkField),
+		operations: make(map[string]string),
+	}
+	for _, pkg := range packages {
+		for _, file := range pkg.Files {
+			parseSdkFile(file, &out)
+		}
+	}
+
+	return &out, nil
+}
+
+func parseSdkFile(file *ast.File, out *sdkPackage) {
+	for _, decl := range file.Decls {
+		switch v := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range v.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					if !s.Name.IsExported() {
+						continue
+					}
+					switch t := s.Type.(type) {
+					case *ast.StructType:
+						out.models[s.Name.Name] = parseModelFields(t)
+					case *ast.Ident:
+						// Enums are defined as a string/number type with constants for each value
+						if _, ok := out.enums[s.Name.Name]; !ok {
+							out.enums[s.Name.Name] = make(map[string]struct{})
+						}
+					}
+
+				case *ast.ValueSpec:
+					if v.Tok != token.CONST || s.Type == nil {
+						continue
+					}
+					typeName := types.ExprString(s.Type)
+					for i, name := range s.Names {
+						out.constants[name.Name] = typeName
+						if i >= len(s.Values) {
+							continue
+						}
+						if lit, ok := s.Values[i].(*ast.BasicLit); ok {
+							if _, ok := out.enums[typeName]; !ok {
+								out.enums[typeName] = make(map[string]struct{})
+							}
+							value, err := strconv.Unquote(lit.Value)
+							if err != nil {
+								value = lit.Value
+							}
+							out.enums[typeName][value] = struct{}{}
+						}
+					}
+				}
+			}
+
+		case *ast.FuncDecl:
+			if !v.Name.IsExported() {
+				continue
+			}
+			if v.Recv == nil || len(v.Recv.List) == 0 {
+				out.operations[v.Name.Name] = types.ExprString(v.Type)
+				continue
+			}
+			receiver := strings.TrimPrefix(types.ExprString(v.Recv.List[0].Type), "*")
+			if !strings.HasSuffix(receiver, "Client") {
+				continue
+			}
+			out.operations[fmt.Sprintf("%s.%s", receiver, v.Name.Name)] = types.ExprString(v.Type)
+		}
+	}
+}
+
+func parseModelFields(input *ast.StructType) map[string]sdkField {
+	fields := make(map[string]sdkField)
+	for _, field := range input.Fields.List {
+		required := false
+		if field.Tag != nil {
+			if tag, err := strconv.Unquote(field.Tag.Value); err == nil {
+				jsonTag, ok := reflect.StructTag(tag).Lookup("json")
+				required = ok && jsonTag != "-" && !strings.Contains(jsonTag, "omitempty")
+			}
+		}
+
+		fieldType := types.ExprString(field.Type)
+		for _, name := range field.Names {
+			fields[name.Name] = sdkField{
+				Type:     fieldType,
+				Required: required,
+			}
+		}
+	}
+	return fields
+}
+
+// referencedTypeNames returns the names of the types referenced by a field type, e.g. `Foo` and `Bar` for
+// `*map[string][]Foo` or `map[Foo]Bar`
+func referencedTypeNames(fieldType string) []string {
+	out := make([]string, 0)
+	for _, segment := range strings.FieldsFunc(fieldType, func(r rune) bool {
+		return r == '*' || r == '[' || r == ']' || r == ' ' || r == ','
+	}) {
+		if strings.HasPrefix(segment, "map") {
+			segment = strings.TrimPrefix(segment, "map")
+		}
+		if segment == "" || strings.Contains(segment, ".") {
+			continue
+		}
+		out = append(out, segment)
+	}
+	return out
+}
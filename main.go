@@ -8,8 +8,9 @@ import (
 	"flag"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 )
 
 func main() {
@@ -21,18 +22,18 @@ func main() {
 	flag.BoolVar(&debugMode, "debuggable", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	ctx := context.Background()
+	serverFactory, err := framework.ProtoV5ProviderServerFactory(ctx, provider.AzureProvider())
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	var serveOpts []tf5server.ServeOpt
 	if debugMode {
-		//nolint:staticcheck
-		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/azurerm",
-			&plugin.ServeOpts{
-				ProviderFunc: provider.AzureProvider,
-			})
-		if err != nil {
-			log.Println(err.Error())
-		}
-	} else {
-		plugin.Serve(&plugin.ServeOpts{
-			ProviderFunc: provider.AzureProvider,
-		})
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	if err := tf5server.Serve("registry.terraform.io/hashicorp/azurerm", serverFactory, serveOpts...); err != nil {
+		log.Fatal(err.Error())
 	}
 }